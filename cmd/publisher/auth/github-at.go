@@ -31,7 +31,9 @@ type AccessTokenResponse struct {
 	AccessToken string `json:"access_token"`
 	TokenType   string `json:"token_type"`
 	Scope       string `json:"scope"`
-	Error       string `json:"error,omitempty"`
+	// RefreshToken is only issued by GitHub Apps whose user tokens expire
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Error        string `json:"error,omitempty"`
 }
 
 // RegistryTokenResponse represents the response from registry's token exchange endpoint
@@ -46,6 +48,8 @@ type GitHubATProvider struct {
 	registryURL   string
 	providedToken string // Token provided via --token flag or MCP_GITHUB_TOKEN env var
 	githubToken   string // In-memory GitHub token set by Login()
+	refreshToken  string // GitHub refresh token from the device flow, if one was issued
+	expiresAt     int64  // Expiry of the registry token returned by GetToken, in Unix seconds
 }
 
 // ServerHealthResponse represents the response from the health endpoint
@@ -74,16 +78,34 @@ func (g *GitHubATProvider) GetToken(ctx context.Context) (string, error) {
 	}
 
 	// Exchange GitHub token for registry token
-	registryToken, _, err := g.exchangeTokenForRegistry(ctx, g.githubToken)
+	registryToken, expiresAt, err := g.exchangeTokenForRegistry(ctx, g.githubToken)
 	// Clear the GitHub token from memory after exchange
 	g.githubToken = ""
 	if err != nil {
 		return "", fmt.Errorf("failed to exchange token: %w", err)
 	}
+	g.expiresAt = expiresAt
 
 	return registryToken, nil
 }
 
+// TokenExpiry returns when the token returned by the last GetToken call expires
+func (g *GitHubATProvider) TokenExpiry() time.Time {
+	if g.expiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(g.expiresAt, 0)
+}
+
+// RefreshGrant returns the refresh token issued by the device flow, or nil if GitHub did
+// not issue one or a token was provided instead
+func (g *GitHubATProvider) RefreshGrant() *RefreshGrant {
+	if g.refreshToken == "" {
+		return nil
+	}
+	return &RefreshGrant{TokenURL: GitHubAccessTokenURL, ClientID: g.clientID, RefreshToken: g.refreshToken}
+}
+
 // Login performs the GitHub device flow authentication
 func (g *GitHubATProvider) Login(ctx context.Context) error {
 	// If a token was provided via --token or MCP_GITHUB_TOKEN, store it in memory and skip device flow
@@ -240,6 +262,7 @@ func (g *GitHubATProvider) pollForToken(ctx context.Context, deviceCode string)
 		}
 
 		if tokenResp.AccessToken != "" {
+			g.refreshToken = tokenResp.RefreshToken
 			return tokenResp.AccessToken, nil
 		}
 
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RefreshGrant is an OAuth refresh token and the token endpoint of the client it was
+// issued to
+type RefreshGrant struct {
+	TokenURL     string
+	ClientID     string
+	RefreshToken string
+}
+
+// Refresher is implemented by providers whose registry token can be renewed later
+// without user interaction
+type Refresher interface {
+	// TokenExpiry returns when the token returned by GetToken expires, or the zero time
+	// if it is not known
+	TokenExpiry() time.Time
+
+	// RefreshGrant returns the grant that renews the token, or nil if there is none
+	RefreshGrant() *RefreshGrant
+}
+
+// RefreshRegistryToken redeems grant for a new GitHub access token and exchanges that for a
+// token of the registry at registryURL. It returns the registry token, its expiry and the
+// grant to use next time, as GitHub replaces the refresh token on every use.
+func RefreshRegistryToken(ctx context.Context, registryURL string, grant RefreshGrant) (string, time.Time, *RefreshGrant, error) {
+	tokenURL := grant.TokenURL
+	if tokenURL == "" {
+		tokenURL = GitHubAccessTokenURL
+	}
+
+	payload := map[string]string{
+		"client_id":     grant.ClientID,
+		"grant_type":    "refresh_token",
+		"refresh_token": grant.RefreshToken,
+	}
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, nil, fmt.Errorf("token refresh failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var tokenResp AccessTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", time.Time{}, nil, err
+	}
+	// GitHub reports a refresh token that is expired or revoked as bad_refresh_token
+	if tokenResp.Error != "" {
+		return "", time.Time{}, nil, fmt.Errorf("token refresh failed: %s", tokenResp.Error)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", time.Time{}, nil, fmt.Errorf("token refresh returned no access token")
+	}
+
+	provider := &GitHubATProvider{registryURL: registryURL}
+	registryToken, expiresAt, err := provider.exchangeTokenForRegistry(ctx, tokenResp.AccessToken)
+	if err != nil {
+		return "", time.Time{}, nil, fmt.Errorf("failed to exchange token: %w", err)
+	}
+
+	next := grant
+	if tokenResp.RefreshToken != "" {
+		next.RefreshToken = tokenResp.RefreshToken
+	}
+	var expiry time.Time
+	if expiresAt != 0 {
+		expiry = time.Unix(expiresAt, 0)
+	}
+	return registryToken, expiry, &next, nil
+}
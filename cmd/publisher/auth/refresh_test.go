@@ -0,0 +1,85 @@
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockRefreshServer creates an httptest.Server that mocks GitHub's token endpoint for
+// the refresh_token grant. It answers with response when the refresh token is
+// expectedRefreshToken and with bad_refresh_token otherwise.
+func newMockRefreshServer(t *testing.T, expectedRefreshToken string, response map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, "refresh_token", body["grant_type"])
+		assert.Equal(t, "test-client-id", body["client_id"])
+		w.Header().Set("Content-Type", "application/json")
+		if body["refresh_token"] != expectedRefreshToken {
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_refresh_token"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRefreshRegistryToken(t *testing.T) {
+	registry := newMockExchangeServer(t, "new-github-token")
+
+	t.Run("success", func(t *testing.T) {
+		github := newMockRefreshServer(t, "refresh-1", map[string]string{"access_token": "new-github-token", "refresh_token": "refresh-2"})
+		grant := auth.RefreshGrant{TokenURL: github.URL, ClientID: "test-client-id", RefreshToken: "refresh-1"}
+
+		token, expiresAt, next, err := auth.RefreshRegistryToken(context.Background(), registry.URL, grant)
+		require.NoError(t, err)
+		assert.Equal(t, "registry-jwt", token)
+		assert.Equal(t, time.Unix(9999999999, 0), expiresAt)
+		require.NotNil(t, next)
+		assert.Equal(t, auth.RefreshGrant{TokenURL: github.URL, ClientID: "test-client-id", RefreshToken: "refresh-2"}, *next, "GitHub rotates the refresh token")
+	})
+
+	t.Run("refresh token kept when not rotated", func(t *testing.T) {
+		github := newMockRefreshServer(t, "refresh-1", map[string]string{"access_token": "new-github-token"})
+		grant := auth.RefreshGrant{TokenURL: github.URL, ClientID: "test-client-id", RefreshToken: "refresh-1"}
+
+		_, _, next, err := auth.RefreshRegistryToken(context.Background(), registry.URL, grant)
+		require.NoError(t, err)
+		assert.Equal(t, grant, *next)
+	})
+
+	t.Run("refresh token expired", func(t *testing.T) {
+		github := newMockRefreshServer(t, "refresh-2", map[string]string{"access_token": "new-github-token"})
+		grant := auth.RefreshGrant{TokenURL: github.URL, ClientID: "test-client-id", RefreshToken: "refresh-1"}
+
+		_, _, _, err := auth.RefreshRegistryToken(context.Background(), registry.URL, grant)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bad_refresh_token")
+	})
+}
+
+func TestGitHubATProvider_TokenExpiry(t *testing.T) {
+	registry := newMockExchangeServer(t, "my-token")
+	p := auth.NewGitHubATProvider(registry.URL, "my-token")
+	refresher, ok := p.(auth.Refresher)
+	require.True(t, ok)
+
+	require.NoError(t, p.Login(context.Background()))
+	_, err := p.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(9999999999, 0), refresher.TokenExpiry())
+	assert.Nil(t, refresher.RefreshGrant(), "a provided token has no refresh token")
+}
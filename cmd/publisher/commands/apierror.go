@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// registryErrorBody is the structured JSON error body returned by the registry. Errors
+// holds the per-field details of problem+json responses.
+type registryErrorBody struct {
+	Message string `json:"message"`
+	Detail  string `json:"detail"`
+	Code    string `json:"code"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// registryError is a non-success response from the registry API
+type registryError struct {
+	Operation  string
+	StatusCode int
+	Code       string
+	Message    string
+	Body       []byte
+	// RetryAfter is the Retry-After header of the response, where the caller may retry
+	RetryAfter string
+}
+
+// newRegistryError builds a registryError for the given operation, extracting the message
+// and code from a structured error body when the registry sent one
+func newRegistryError(operation string, statusCode int, body []byte) *registryError {
+	e := &registryError{Operation: operation, StatusCode: statusCode, Body: body}
+
+	var parsed registryErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return e
+	}
+
+	e.Code = parsed.Code
+	switch {
+	case parsed.Message != "" && parsed.Detail != "" && parsed.Message != parsed.Detail:
+		e.Message = parsed.Message + ": " + parsed.Detail
+	case parsed.Message != "":
+		e.Message = parsed.Message
+	default:
+		e.Message = parsed.Detail
+	}
+
+	var details []string
+	for _, detail := range parsed.Errors {
+		if detail.Message != "" {
+			details = append(details, detail.Message)
+		}
+	}
+	if e.Message != "" && len(details) > 0 {
+		e.Message += ": " + strings.Join(details, "; ")
+	}
+	return e
+}
+
+func (e *registryError) Error() string {
+	switch {
+	case e.Message == "":
+		return fmt.Sprintf("%s failed: server returned status %d: %s", e.Operation, e.StatusCode, e.Body)
+	case e.Code != "":
+		return fmt.Sprintf("%s failed (code=%s): %s", e.Operation, e.Code, e.Message)
+	default:
+		return fmt.Sprintf("%s failed (status %d): %s", e.Operation, e.StatusCode, e.Message)
+	}
+}
+
+// operationFailed prefixes err with "<operation> failed" unless it is a registry error,
+// which already names the failed operation
+func operationFailed(operation string, err error) error {
+	var regErr *registryError
+	if errors.As(err, &regErr) {
+		return err
+	}
+	return fmt.Errorf("%s failed: %w", operation, err)
+}
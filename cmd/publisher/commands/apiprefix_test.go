@@ -0,0 +1,111 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPathRecordingServer starts a registry mock that answers publish, validate and
+// server lookups under any path and records the paths of the requests it receives
+func setupPathRecordingServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			if strings.HasSuffix(r.URL.Path, "/validate") {
+				_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+				Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(apiv0.ServerListResponse{})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), paths...)
+	}
+}
+
+func TestPublishCommand_APIPrefix(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		config       *commands.PublisherConfig
+		expectedPath string
+	}{
+		{"default", nil, nil, "/v0/publish"},
+		{"flag", []string{"--api-prefix", "api/v0"}, nil, "/api/v0/publish"},
+		{"flag with slashes", []string{"--api-prefix", "/api/v0/"}, nil, "/api/v0/publish"},
+		{"root", []string{"--api-prefix", "/"}, nil, "/publish"},
+		{"config", nil, &commands.PublisherConfig{APIPrefix: "registry/v0"}, "/registry/v0/publish"},
+		{"flag overrides config", []string{"--api-prefix", "api/v0"}, &commands.PublisherConfig{APIPrefix: "registry/v0"}, "/api/v0/publish"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, paths := setupPathRecordingServer(t)
+			SetupTestToken(t, server.URL, "test-token")
+			if tt.config != nil {
+				SetupTestConfig(t, *tt.config)
+			}
+			CreateTestServerJSON(t, testServerJSON())
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", "server.json"))
+			})
+			require.NoError(t, err)
+
+			// The casing check searches servers under the same prefix before publishing
+			prefix := strings.TrimSuffix(tt.expectedPath, "publish")
+			assert.Equal(t, []string{"GET " + prefix + "servers", "POST " + tt.expectedPath}, paths())
+		})
+	}
+
+	t.Run("invalid prefix", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.PublishCommand([]string{"--api-prefix", "https://example.com/v0", "server.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid API prefix "https://example.com/v0"`)
+	})
+}
+
+func TestValidateCommand_APIPrefix(t *testing.T) {
+	server, paths := setupPathRecordingServer(t)
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	_ = CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--api-prefix", "api/v0"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /api/v0/validate"}, paths())
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// defaultArchiveEntry is the archive member read as server.json without --entry
+const defaultArchiveEntry = "server.json"
+
+// archiveExtensions mark a server.json source as a gzip-compressed tarball
+var archiveExtensions = []string{".tar.gz", ".tgz"}
+
+// serverArchive holds the --entry flag, the member of a .tar.gz or .tgz source that is
+// read as server.json. Server metadata is often distributed alongside assets this way.
+type serverArchive struct {
+	entry string
+}
+
+// register adds the archive flag to fs
+func (a *serverArchive) register(fs *flag.FlagSet) {
+	fs.StringVar(&a.entry, "entry", "", "Read server.json from this member of a .tar.gz or .tgz file (default: server.json)")
+}
+
+// isArchive reports whether filename is a gzip-compressed tarball to read server.json from
+func isArchive(filename string) bool {
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(strings.ToLower(filename), ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// check rejects --entry for a source that is not an archive, where it would be ignored
+func (a *serverArchive) check(filename string) error {
+	if a.entry != "" && !isArchive(filename) {
+		return fmt.Errorf("--entry requires a .tar.gz or .tgz file, got %s", filename)
+	}
+	return nil
+}
+
+// read returns the contents of the server.json member of the archive filename. Nothing is
+// extracted to disk, but archives with members outside the archive root (absolute paths or
+// ..) are rejected, as is a server.json that is not a regular file or larger than
+// maxServerJSONSize.
+func (a *serverArchive) read(filename string) ([]byte, error) {
+	entry := defaultArchiveEntry
+	if a.entry != "" {
+		entry = path.Clean(a.entry)
+		if !localArchivePath(entry) || entry == "." {
+			return nil, fmt.Errorf("invalid --entry %q: must be a relative path inside the archive", a.entry)
+		}
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s not found, please check the file path", filename)
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive %s: %w", filename, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s has no %s entry; use --entry to name the server.json inside the archive", filename, entry)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive %s: %w", filename, err)
+		}
+
+		name := path.Clean(header.Name)
+		if !localArchivePath(name) {
+			return nil, fmt.Errorf("invalid archive %s: member %q is outside the archive root", filename, header.Name)
+		}
+		if name != entry {
+			continue
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("%s in %s is not a regular file", entry, filename)
+		}
+		if header.Size > maxServerJSONSize {
+			return nil, fmt.Errorf("%s in %s is %d bytes, more than the limit of %d bytes", entry, filename, header.Size, maxServerJSONSize)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxServerJSONSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from %s: %w", entry, filename, err)
+		}
+		return data, nil
+	}
+}
+
+// localArchivePath reports whether the cleaned member name stays inside the archive root
+func localArchivePath(name string) bool {
+	return !path.IsAbs(name) && name != ".." && !strings.HasPrefix(name, "../")
+}
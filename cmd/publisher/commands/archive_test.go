@@ -0,0 +1,177 @@
+package commands_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// archiveMember is a file written to a test archive
+type archiveMember struct {
+	name     string
+	data     string
+	typeflag byte
+}
+
+// createArchive writes members to a .tar.gz file in a temporary directory and returns its path
+func createArchive(t *testing.T, members ...archiveMember) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "server.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for _, member := range members {
+		header := &tar.Header{Name: member.name, Mode: 0600, Size: int64(len(member.data)), Typeflag: member.typeflag}
+		if member.typeflag == tar.TypeSymlink {
+			header.Linkname = "/etc/passwd"
+			header.Size = 0
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		_, err := io.WriteString(tw, member.data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return path
+}
+
+// serverJSONMember returns an archive member holding serverJSON
+func serverJSONMember(t *testing.T, name string, serverJSON apiv0.ServerJSON) archiveMember {
+	t.Helper()
+	data, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	return archiveMember{name: name, data: string(data), typeflag: tar.TypeReg}
+}
+
+func TestValidateCommand_Archive(t *testing.T) {
+	readme := archiveMember{name: "README.md", data: "# Test server", typeflag: tar.TypeReg}
+
+	t.Run("default entry", func(t *testing.T) {
+		archive := createArchive(t, readme, serverJSONMember(t, "./server.json", testServerJSON()))
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", archive})
+		})
+		require.NoError(t, err, out)
+		assert.Contains(t, out, "is valid")
+	})
+
+	t.Run("custom entry", func(t *testing.T) {
+		invalid := testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" })
+		archive := createArchive(t,
+			serverJSONMember(t, "server.json", invalid),
+			serverJSONMember(t, "dist/server.json", testServerJSON()),
+		)
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--entry", "dist/server.json", archive})
+		})
+		require.NoError(t, err, "the entry, not the top-level server.json, should be validated: %s", out)
+	})
+
+	tests := []struct {
+		name          string
+		members       []archiveMember
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "missing entry",
+			members:       []archiveMember{readme},
+			expectedError: "has no server.json entry; use --entry",
+		},
+		{
+			name:          "missing custom entry",
+			members:       []archiveMember{readme, serverJSONMember(t, "server.json", testServerJSON())},
+			args:          []string{"--entry", "dist/server.json"},
+			expectedError: "has no dist/server.json entry",
+		},
+		{
+			name:          "member outside the archive root",
+			members:       []archiveMember{{name: "../../etc/server.json", data: "{}", typeflag: tar.TypeReg}},
+			expectedError: `member "../../etc/server.json" is outside the archive root`,
+		},
+		{
+			name:          "absolute member",
+			members:       []archiveMember{{name: "/server.json", data: "{}", typeflag: tar.TypeReg}},
+			expectedError: "is outside the archive root",
+		},
+		{
+			name:          "entry outside the archive root",
+			members:       []archiveMember{readme},
+			args:          []string{"--entry", "../server.json"},
+			expectedError: `invalid --entry "../server.json"`,
+		},
+		{
+			name:          "symlink entry",
+			members:       []archiveMember{{name: "server.json", typeflag: tar.TypeSymlink}},
+			expectedError: "is not a regular file",
+		},
+		{
+			name:          "oversized entry",
+			members:       []archiveMember{{name: "server.json", data: strings.Repeat(" ", 2<<20), typeflag: tar.TypeReg}},
+			expectedError: "more than the limit of 1048576 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := createArchive(t, tt.members...)
+			err := commands.ValidateCommand(append(append([]string{"--offline"}, tt.args...), archive))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+
+	t.Run("entry without an archive", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+		err := commands.ValidateCommand([]string{"--offline", "--entry", "server.json", "server.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--entry requires a .tar.gz or .tgz file")
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.tgz")
+		require.NoError(t, os.WriteFile(path, []byte("{}"), 0600))
+		err := commands.ValidateCommand([]string{"--offline", path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid archive")
+	})
+}
+
+func TestPublishCommand_Archive(t *testing.T) {
+	var published apiv0.PublishRequest
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&published))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+
+	serverJSON := testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "2.0.0" })
+	archive := createArchive(t, serverJSONMember(t, "package/server.json", serverJSON))
+
+	var err error
+	CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--entry", "package/server.json", archive})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", published.Version)
+
+	err = commands.PublishCommand([]string{archive})
+	require.Error(t, err, "the archive has no top-level server.json")
+	assert.Contains(t, err.Error(), "has no server.json entry")
+}
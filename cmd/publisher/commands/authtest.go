@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// tokenIdentity is the response of the registry's token inspection endpoint
+type tokenIdentity struct {
+	AuthMethod        string            `json:"authMethod"`
+	AuthMethodSubject string            `json:"authMethodSubject"`
+	Permissions       []tokenPermission `json:"permissions"`
+	ExpiresAt         *time.Time        `json:"expiresAt"`
+}
+
+// tokenPermission is an action a token grants on the servers matching a resource pattern
+type tokenPermission struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+}
+
+func AuthCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: mcp-publisher auth test [--proxy URL]")
+	}
+
+	switch args[0] {
+	case "test":
+		return authTestCommand(args[1:])
+	default:
+		return fmt.Errorf("unknown auth subcommand: %s (available: test)", args[0])
+	}
+}
+
+// authTestCommand checks the saved token against the registry without changing anything
+func authTestCommand(args []string) error {
+	fs := flag.NewFlagSet("auth test", flag.ExitOnError)
+	proxy := fs.String("proxy", "", "Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+	var tokens tokenSource
+	tokens.register(fs)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	token, registryURL, err := tokens.load()
+	if err != nil {
+		return err
+	}
+
+	client, err := newHTTPClient(*proxy)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Registry: %s\n", registryURL)
+	identity, err := fetchTokenIdentity(client, registryURL, token)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "✓ Token is valid")
+	printTokenIdentity(identity)
+	return nil
+}
+
+// printTokenIdentity prints how a token was obtained, when it expires and what it grants
+func printTokenIdentity(identity *tokenIdentity) {
+	_, _ = fmt.Fprintf(os.Stdout, "  Authenticated via: %s (%s)\n", identity.AuthMethod, identity.AuthMethodSubject)
+	if identity.ExpiresAt != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "  Expires: %s (in %s)\n", identity.ExpiresAt.Format(time.RFC3339), time.Until(*identity.ExpiresAt).Round(time.Second))
+	}
+	_, _ = fmt.Fprintln(os.Stdout, "  Permissions:")
+	if len(identity.Permissions) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "    (none)")
+	}
+	for _, permission := range identity.Permissions {
+		_, _ = fmt.Fprintf(os.Stdout, "    %-8s %s\n", permission.Action, permission.Resource)
+	}
+}
+
+// fetchTokenIdentity asks the registry to verify token and describe what it grants
+func fetchTokenIdentity(client *http.Client, registryURL, token string) (*tokenIdentity, error) {
+	whoamiURL := strings.TrimSuffix(registryURL, "/") + "/v0/auth/whoami"
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, whoamiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not reach registry %s: %w", registryURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("%w. Run 'mcp-publisher login' to get a new token", newRegistryError("token check", resp.StatusCode, body))
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("registry %s does not support token verification", registryURL)
+	default:
+		return nil, newRegistryError("token check", resp.StatusCode, body)
+	}
+
+	var identity tokenIdentity
+	if err := json.Unmarshal(body, &identity); err != nil {
+		return nil, fmt.Errorf("invalid response from registry: %w", err)
+	}
+	return &identity, nil
+}
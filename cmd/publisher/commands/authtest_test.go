@@ -0,0 +1,103 @@
+package commands_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWhoAmIServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v0/auth/whoami", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAuthCommand_Test(t *testing.T) {
+	t.Run("valid token", func(t *testing.T) {
+		var auth string
+		server := setupWhoAmIServer(t, func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"authMethod":"github-at","authMethodSubject":"octocat","permissions":[{"action":"publish","resource":"io.github.octocat/*"}],"expiresAt":"2099-01-01T00:00:00Z"}`))
+		})
+		SetupTestToken(t, server.URL, "test-token")
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.AuthCommand([]string{"test"})
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Bearer test-token", auth)
+		assert.Contains(t, output, "Registry: "+server.URL)
+		assert.Contains(t, output, "✓ Token is valid")
+		assert.Contains(t, output, "Authenticated via: github-at (octocat)")
+		assert.Contains(t, output, "Expires: 2099-01-01T00:00:00Z")
+		assert.Contains(t, output, "publish  io.github.octocat/*")
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		server := setupWhoAmIServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"title":"Unauthorized","status":401,"detail":"Invalid or expired Registry JWT token"}`))
+		})
+		SetupTestToken(t, server.URL, "expired-token")
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.AuthCommand([]string{"test"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid or expired Registry JWT token")
+		assert.Contains(t, err.Error(), "mcp-publisher login")
+		assert.NotContains(t, output, "Token is valid")
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		server := setupWhoAmIServer(t, func(http.ResponseWriter, *http.Request) {})
+		server.Close()
+		SetupTestToken(t, server.URL, "test-token")
+
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.AuthCommand([]string{"test"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not reach registry "+server.URL)
+	})
+
+	t.Run("unsupported registry", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(server.Close)
+		SetupTestToken(t, server.URL, "test-token")
+
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.AuthCommand([]string{"test"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not support token verification")
+	})
+
+	t.Run("not logged in", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv("USERPROFILE", t.TempDir())
+
+		err := commands.AuthCommand([]string{"test"})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown subcommand", func(t *testing.T) {
+		err := commands.AuthCommand([]string{"rotate"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown auth subcommand")
+	})
+}
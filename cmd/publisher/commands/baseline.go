@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// baselineKey identifies an issue across validation runs. Messages are deliberately
+// excluded so that rewording a message does not turn an existing issue into a new one.
+// Paths are compared as JSON pointers so that baselines written before issue paths became
+// pointers (e.g. packages[0].version) still match.
+func baselineKey(issue validators.ValidationIssue) string {
+	return issue.Reference + "\x00" + validators.ConvertBracketNotationToJSONPointer(issue.Path)
+}
+
+// loadBaseline reads a previously written validation result from path
+func loadBaseline(path string) (*validators.ValidationResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline %s: %w", path, err)
+	}
+
+	var baseline validators.ValidationResult
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("invalid baseline %s: %w", path, err)
+	}
+
+	return &baseline, nil
+}
+
+// writeBaseline saves result to path so later runs can be compared against it
+func writeBaseline(path string, result *validators.ValidationResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write baseline %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// diffBaseline compares the issues in result with those in baseline by reference and path.
+// It returns the issues only present in result, the issues only present in baseline,
+// and the number of issues present in both.
+func diffBaseline(result, baseline *validators.ValidationResult) (added, resolved []validators.ValidationIssue, unchanged int) {
+	current := make(map[string]bool, len(result.Issues))
+	for _, issue := range result.Issues {
+		current[baselineKey(issue)] = true
+	}
+
+	previous := make(map[string]bool, len(baseline.Issues))
+	for _, issue := range baseline.Issues {
+		previous[baselineKey(issue)] = true
+		if !current[baselineKey(issue)] {
+			resolved = append(resolved, issue)
+		}
+	}
+
+	for _, issue := range result.Issues {
+		if previous[baselineKey(issue)] {
+			unchanged++
+		} else {
+			added = append(added, issue)
+		}
+	}
+
+	return added, resolved, unchanged
+}
+
+// applyBaseline reduces result to the issues not present in the baseline at path and
+// prints a summary of resolved and tolerated issues to progress. The returned result is only invalid
+// if one of the new issues is an error.
+func applyBaseline(result *validators.ValidationResult, path string, progress io.Writer) (*validators.ValidationResult, error) {
+	baseline, err := loadBaseline(path)
+	if err != nil {
+		return nil, err
+	}
+
+	added, resolved, unchanged := diffBaseline(result, baseline)
+
+	_, _ = fmt.Fprintf(progress, "Compared with baseline %s: %d new, %d resolved, %d unchanged issue(s)\n", path, len(added), len(resolved), unchanged)
+	for _, issue := range resolved {
+		_, _ = fmt.Fprintf(progress, "   resolved: %s (%s)\n", issue.Path, issue.Reference)
+	}
+	_, _ = fmt.Fprintln(progress)
+
+	filtered := &validators.ValidationResult{Valid: true, Issues: added}
+	for _, issue := range added {
+		if issue.Severity == validators.ValidationIssueSeverityError {
+			filtered.Valid = false
+		}
+	}
+	if filtered.Issues == nil {
+		filtered.Issues = []validators.ValidationIssue{}
+	}
+
+	return filtered, nil
+}
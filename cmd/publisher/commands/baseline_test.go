@@ -0,0 +1,124 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommand_Baseline(t *testing.T) {
+	rangeVersion := func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" }
+	httpWebsite := func(s *apiv0.ServerJSON) { s.WebsiteURL = "http://example.com" }
+
+	tests := []struct {
+		name             string
+		baselineMutators []func(s *apiv0.ServerJSON)
+		currentMutators  []func(s *apiv0.ServerJSON)
+		expectError      bool
+		expectedOutput   []string
+		unexpectedOutput []string
+	}{
+		{
+			name:             "unchanged issues are tolerated",
+			baselineMutators: []func(s *apiv0.ServerJSON){rangeVersion},
+			currentMutators:  []func(s *apiv0.ServerJSON){rangeVersion},
+			expectedOutput:   []string{"0 new, 0 resolved, 1 unchanged", "✅ server.json is valid"},
+			unexpectedOutput: []string{"version-looks-like-range"},
+		},
+		{
+			name:             "new issues fail",
+			baselineMutators: []func(s *apiv0.ServerJSON){rangeVersion},
+			currentMutators:  []func(s *apiv0.ServerJSON){rangeVersion, httpWebsite},
+			expectError:      true,
+			expectedOutput:   []string{"1 new, 0 resolved, 1 unchanged", "website-url-invalid-scheme"},
+			unexpectedOutput: []string{"version-looks-like-range"},
+		},
+		{
+			name:             "resolved issues are reported",
+			baselineMutators: []func(s *apiv0.ServerJSON){rangeVersion, httpWebsite},
+			currentMutators:  []func(s *apiv0.ServerJSON){rangeVersion},
+			expectedOutput:   []string{"0 new, 1 resolved, 1 unchanged", "resolved: /websiteUrl (website-url-invalid-scheme)", "✅ server.json is valid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+			CreateTestServerJSON(t, testServerJSON(tt.baselineMutators...))
+			require.NoError(t, commands.ValidateCommand([]string{"--offline", "--baseline", baselinePath, "--update-baseline"}))
+
+			CreateTestServerJSON(t, testServerJSON(tt.currentMutators...))
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--offline", "--baseline", baselinePath})
+			})
+
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tt.expectedOutput {
+				assert.Contains(t, output, expected)
+			}
+			for _, unexpected := range tt.unexpectedOutput {
+				assert.NotContains(t, output, unexpected)
+			}
+		})
+	}
+}
+
+func TestValidateCommand_UpdateBaseline(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" }))
+
+	require.NoError(t, commands.ValidateCommand([]string{"--offline", "--baseline", baselinePath, "--update-baseline"}))
+
+	data, err := os.ReadFile(baselinePath)
+	require.NoError(t, err)
+	var baseline validators.ValidationResult
+	require.NoError(t, json.Unmarshal(data, &baseline))
+	assert.False(t, baseline.Valid)
+	require.Len(t, baseline.Issues, 1)
+	assert.Equal(t, "version-looks-like-range", baseline.Issues[0].Reference)
+	assert.Equal(t, "/version", baseline.Issues[0].Path)
+}
+
+func TestValidateCommand_LegacyBaselinePaths(t *testing.T) {
+	// Baselines written before issue paths became JSON pointers still match
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+	legacy := `{"valid": false, "issues": [{"type": "semantic", "path": "packages[0].identifier", "message": "old message", "severity": "warning", "reference": "repository-package-mismatch"}]}`
+	require.NoError(t, os.WriteFile(baselinePath, []byte(legacy), 0600))
+	CreateTestServerJSON(t, testServerJSON(withMismatchedPackage))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--baseline", baselinePath})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "0 new, 0 resolved, 1 unchanged")
+}
+
+func TestValidateCommand_BaselineErrors(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+
+	t.Run("update without path", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "--update-baseline"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--update-baseline requires --baseline")
+	})
+
+	t.Run("missing baseline file", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "--baseline", filepath.Join(t.TempDir(), "missing.json")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read baseline")
+	})
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// cachedResponse is an on-disk cache entry for a registry GET
+type cachedResponse struct {
+	URL  string `json:"url"`
+	ETag string `json:"etag"`
+	Body []byte `json:"body"`
+}
+
+// responseCachePath returns the cache file for rawURL
+// (<user cache dir>/mcp-publisher/http/<sha256 of URL>.json).
+func responseCachePath(rawURL string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(cacheDir, "mcp-publisher", "http", hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// loadCachedResponse returns the cached entry for rawURL, or nil if there is none
+func loadCachedResponse(rawURL string) *cachedResponse {
+	path, err := responseCachePath(rawURL)
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entry cachedResponse
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != rawURL || entry.ETag == "" {
+		return nil
+	}
+	return &entry
+}
+
+// saveCachedResponse stores body and etag for rawURL. Failures are ignored since the
+// cache is only an optimization.
+func saveCachedResponse(rawURL, etag string, body []byte) {
+	path, err := responseCachePath(rawURL)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(cachedResponse{URL: rawURL, ETag: etag, Body: body})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0600)
+}
+
+// registryGet performs a GET against the registry, revalidating previously cached
+// responses with If-None-Match. A 304 response is served from the cache and reported
+// as 200. The token is sent as a bearer token if non-empty.
+func registryGet(client *http.Client, rawURL, token string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error creating request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	cached := loadCachedResponse(rawURL)
+	if cached != nil {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return cached.Body, http.StatusOK, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("error reading response: %w", err)
+	}
+
+	if etag := resp.Header.Get("ETag"); resp.StatusCode == http.StatusOK && etag != "" {
+		saveCachedResponse(rawURL, etag, body)
+	}
+
+	return body, resp.StatusCode, nil
+}
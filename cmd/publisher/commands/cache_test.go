@@ -0,0 +1,56 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishCommand_RegistryGetUsesETagCache(t *testing.T) {
+	const etag = `"servers-v1"`
+	var ifNoneMatch []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/servers", func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerListResponse{
+			Servers: []apiv0.ServerResponse{
+				{Server: apiv0.ServerJSON{Name: "Com.Example/Test-Server", Version: "0.9.0"}},
+			},
+		})
+	})
+	mux.HandleFunc("/v0/publish", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+			Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	for i := range 2 {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Com.Example/Test-Server", "run %d should see the (cached) search result", i+1)
+	}
+
+	assert.Equal(t, []string{"", etag}, ifNoneMatch, "second request should revalidate with the cached ETag")
+}
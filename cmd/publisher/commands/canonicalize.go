@@ -0,0 +1,239 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// CanonicalizeCommand validates a server.json locally and writes it in the RFC 8785 JSON
+// Canonicalization Scheme (JCS), so that signers and verifiers hash identical bytes
+func CanonicalizeCommand(args []string) error {
+	fs := flag.NewFlagSet("canonicalize", flag.ExitOnError)
+	output := fs.String("output", "", "Write the canonical JSON to this file instead of stdout")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	serverFile := "server.json"
+	if len(positional) > 0 {
+		serverFile = positional[0]
+	}
+
+	data, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("server.json not found at %s", serverFile)
+		}
+		return fmt.Errorf("failed to read server.json: %w", err)
+	}
+	if data, err = prepareJSONInput(serverFile, data); err != nil {
+		return err
+	}
+
+	var serverJSON apiv0.ServerJSON
+	if err := json.Unmarshal(data, &serverJSON); err != nil {
+		return fmt.Errorf("invalid server.json: %w", err)
+	}
+	if result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll); !result.Valid {
+		printValidationErrors(os.Stderr, result)
+		return validationFailure("%s failed validation, so it was not canonicalized; run 'mcp-publisher validate %s' for details", serverFile, serverFile)
+	}
+
+	canonical, err := canonicalJSON(data)
+	if err != nil {
+		return fmt.Errorf("failed to canonicalize %s: %w", serverFile, err)
+	}
+
+	// The bytes are written as is, without a trailing newline, so that they can be hashed
+	if *output != "" {
+		if err := os.WriteFile(*output, canonical, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", *output, err)
+		}
+		return nil
+	}
+	_, _ = os.Stdout.Write(canonical)
+	return nil
+}
+
+// printValidationErrors prints the error-severity issues of result to w
+func printValidationErrors(w io.Writer, result *validators.ValidationResult) {
+	for _, issue := range result.Issues {
+		if issue.Severity == validators.ValidationIssueSeverityError {
+			_, _ = fmt.Fprintf(w, "[error] %s: %s\n", issue.Path, issue.Message)
+		}
+	}
+}
+
+// canonicalJSON returns the RFC 8785 canonical form of the JSON document data: no
+// insignificant whitespace, object members sorted by the UTF-16 code units of their
+// names, strings with only the mandatory escapes, and numbers formatted like ECMAScript's
+// Number.prototype.toString. Duplicate member names are rejected.
+func canonicalJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	if err := writeCanonicalValue(&buf, dec); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return buf.Bytes(), nil
+}
+
+// writeCanonicalValue reads the next JSON value from dec and writes its canonical form
+func writeCanonicalValue(buf *bytes.Buffer, dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch v := tok.(type) {
+	case json.Delim:
+		if v == '{' {
+			return writeCanonicalObject(buf, dec)
+		}
+		return writeCanonicalArray(buf, dec)
+	case string:
+		writeCanonicalString(buf, v)
+	case json.Number:
+		number, err := canonicalNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(number)
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case nil:
+		buf.WriteString("null")
+	}
+	return nil
+}
+
+// writeCanonicalObject writes the members of the object whose opening brace was just read
+// from dec, sorted by name
+func writeCanonicalObject(buf *bytes.Buffer, dec *json.Decoder) error {
+	members := map[string][]byte{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, _ := tok.(string)
+		if _, ok := members[name]; ok {
+			return fmt.Errorf("duplicate member name %q", name)
+		}
+		var value bytes.Buffer
+		if err := writeCanonicalValue(&value, dec); err != nil {
+			return err
+		}
+		members[name] = value.Bytes()
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+	})
+
+	buf.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeCanonicalString(buf, name)
+		buf.WriteByte(':')
+		buf.Write(members[name])
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeCanonicalArray writes the elements of the array whose opening bracket was just read
+// from dec, in order
+func writeCanonicalArray(buf *bytes.Buffer, dec *json.Decoder) error {
+	buf.WriteByte('[')
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeCanonicalValue(buf, dec); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	_, err := dec.Token()
+	return err
+}
+
+// writeCanonicalString writes s as a JSON string, escaping only quotes, backslashes and
+// control characters. Control characters with a short escape use it; the others are
+// written as \u00xx with lowercase hex digits.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				_, _ = fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// canonicalNumber formats n as an IEEE 754 double the way ECMAScript does: the shortest
+// digits that round-trip, in plain notation from 1e-6 up to 1e21 and in exponential
+// notation such as 1e+21 or 1.5e-7 outside that range. Negative zero is written as 0.
+func canonicalNumber(n json.Number) (string, error) {
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return "", fmt.Errorf("number %s cannot be represented as an IEEE 754 double", n)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	if abs := math.Abs(f); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	// Go writes exponents with at least two digits (1e+07); ECMAScript uses as few as needed
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	return mantissa + "e" + exponent[:1] + strings.TrimLeft(exponent[1:], "0"), nil
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			// RFC 8785 section 3.2.2
+			name: "rfc 8785 example",
+			input: `{
+				"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+				"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+				"literals": [null, true, false]
+			}`,
+			expected: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		{
+			// RFC 8785 section 3.2.3: names are sorted by UTF-16 code units, so the emoji
+			// (a surrogate pair starting 0xD83D) sorts before U+FB33
+			name:     "member order",
+			input:    `{"\u20ac": 5, "\r": 2, "\ufb33": 7, "1": 1, "\ud83d\ude00": 6, "\u0080": 3, "\u00f6": 4}`,
+			expected: "{\"\\r\":2,\"1\":1,\"\u0080\":3,\"ö\":4,\"€\":5,\"😀\":6,\"\ufb33\":7}",
+		},
+		{
+			name:     "nested values",
+			input:    "{ \"b\" : [ { \"d\" : 1 , \"c\" : [ ] } ] ,\n\t\"a\" : { } }",
+			expected: `{"a":{},"b":[{"c":[],"d":1}]}`,
+		},
+		{
+			name:     "html characters are not escaped",
+			input:    `{"a": "<b> & \u2028"}`,
+			expected: "{\"a\":\"<b> & \u2028\"}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := canonicalJSON([]byte(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(canonical))
+
+			again, err := canonicalJSON(canonical)
+			require.NoError(t, err)
+			assert.Equal(t, string(canonical), string(again), "canonical JSON should be a fixed point")
+		})
+	}
+}
+
+func TestCanonicalJSON_Errors(t *testing.T) {
+	_, err := canonicalJSON([]byte(`{"a": 1, "a": 2}`))
+	require.EqualError(t, err, `duplicate member name "a"`)
+
+	_, err = canonicalJSON([]byte(`{"a": 1e400}`))
+	require.EqualError(t, err, "number 1e400 cannot be represented as an IEEE 754 double")
+}
+
+func TestCanonicalNumber(t *testing.T) {
+	// Number serialization samples from RFC 8785 appendix B
+	tests := map[string]string{
+		"0":                       "0",
+		"-0":                      "0",
+		"1":                       "1",
+		"-1.5":                    "-1.5",
+		"0.000001":                "0.000001",
+		"0.0000001":               "1e-7",
+		"-5e-7":                   "-5e-7",
+		"1e20":                    "100000000000000000000",
+		"1e21":                    "1e+21",
+		"1e+23":                   "1e+23",
+		"9007199254740992":        "9007199254740992",
+		"295147905179352830000":   "295147905179352830000",
+		"5e-324":                  "5e-324",
+		"1.7976931348623157e308":  "1.7976931348623157e+308",
+		"-1.7976931348623157e308": "-1.7976931348623157e+308",
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			number, err := canonicalNumber(json.Number(input))
+			require.NoError(t, err)
+			assert.Equal(t, expected, number)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeCommand(t *testing.T) {
+	serverJSON := testServerJSON()
+	CreateTestServerJSON(t, serverJSON)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.CanonicalizeCommand(nil)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"$schema":"`+serverJSON.Schema+`","description":"A test server",`+
+		`"name":"com.example/test-server","remotes":[{"type":"streamable-http","url":"https://example.com/test-server/mcp"}],`+
+		`"repository":{"source":"github","url":"https://github.com/example/test-server"},`+
+		`"version":"1.0.0","websiteUrl":"https://example.com/test-server"}`, output)
+
+	// The same document formatted differently, with its members in another order, has the
+	// same canonical form
+	compact, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	var members map[string]any
+	require.NoError(t, json.Unmarshal(compact, &members))
+	reordered, err := json.MarshalIndent(members, "", "\t")
+	require.NoError(t, err)
+	otherFile := filepath.Join(t.TempDir(), "server.json")
+	require.NoError(t, os.WriteFile(otherFile, reordered, 0600))
+
+	outputFile := filepath.Join(t.TempDir(), "canonical.json")
+	require.NoError(t, commands.CanonicalizeCommand([]string{"--output", outputFile, otherFile}))
+	canonical, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	assert.Equal(t, output, string(canonical))
+}
+
+func TestCanonicalizeCommand_UnknownFields(t *testing.T) {
+	serverJSON, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	createRawServerJSON(t, append(serverJSON[:len(serverJSON)-1], []byte(`,"license":"MIT"}`)...))
+
+	output := CaptureStdout(t, func() {
+		err = commands.CanonicalizeCommand(nil)
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, `"license":"MIT"`, "fields the publisher does not model should be kept")
+}
+
+func TestCanonicalizeCommand_Errors(t *testing.T) {
+	t.Run("invalid server.json", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" }))
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.CanonicalizeCommand(nil)
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, commands.ExitCode(err))
+		assert.Contains(t, err.Error(), "server.json failed validation, so it was not canonicalized")
+		assert.Empty(t, output)
+	})
+
+	t.Run("duplicate member", func(t *testing.T) {
+		serverJSON, err := json.Marshal(testServerJSON())
+		require.NoError(t, err)
+		createRawServerJSON(t, append(serverJSON[:len(serverJSON)-1], []byte(`,"version":"1.0.0"}`)...))
+		err = commands.CanonicalizeCommand(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate member name "version"`)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := commands.CanonicalizeCommand([]string{filepath.Join(t.TempDir(), "server.json")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server.json not found")
+	})
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+func init() {
+	if err := validators.RegisterRule(validators.Rule{
+		Reference:   "name-casing-conflict",
+		Type:        validators.ValidationIssueTypeSemantic,
+		Severity:    validators.ValidationIssueSeverityWarning,
+		Description: "Server name should not differ only in casing from an already published server (checked on publish)",
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// checkNameCasingConflict looks for an already-published server whose name matches
+// serverName case-insensitively but with different casing. The registry may treat
+// such names as distinct servers, fragmenting the listing.
+// Returns nil when there is no conflict or when the lookup is not supported by the
+// registry (any request or parsing failure is treated as "no conflict").
+func checkNameCasingConflict(client *http.Client, registryURL, apiPrefix, serverName string) *validators.ValidationIssue {
+	if serverName == "" {
+		return nil
+	}
+
+	if !strings.HasSuffix(registryURL, "/") {
+		registryURL += "/"
+	}
+
+	// The search parameter is a case-insensitive substring match
+	query := url.Values{}
+	query.Set("search", serverName)
+	query.Set("version", "latest")
+	searchURL := registryURL + apiPrefix + "servers?" + query.Encode()
+
+	body, statusCode, err := registryGet(client, searchURL, "")
+	if err != nil || statusCode != http.StatusOK {
+		return nil
+	}
+
+	var response apiv0.ServerListResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil
+	}
+
+	for _, s := range response.Servers {
+		existing := s.Server.Name
+		if existing != serverName && strings.EqualFold(existing, serverName) {
+			issue := validators.NewValidationIssue(
+				validators.ValidationIssueTypeSemantic,
+				"/name",
+				fmt.Sprintf("server name %s differs only in casing from already published server %s; use the existing name to avoid creating a separate listing", serverName, existing),
+				validators.ValidationIssueSeverityWarning,
+				"name-casing-conflict",
+			)
+			return &issue
+		}
+	}
+
+	return nil
+}
+
+// printValidationWarning prints a single warning-level issue to stdout
+func printValidationWarning(issue validators.ValidationIssue) {
+	_, _ = fmt.Fprintf(os.Stdout, "⚠️  %s\n", issue.Message)
+	_, _ = fmt.Fprintf(os.Stdout, "   Reference: %s\n", issue.Reference)
+	_, _ = fmt.Fprintln(os.Stdout)
+}
@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// listPageSize is the number of servers requested per page when listing a registry, the
+// maximum the list endpoint accepts
+const listPageSize = 100
+
+// serverVersion identifies a published version of a server
+type serverVersion struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// registryComparison is the result of the compare-registries command
+type registryComparison struct {
+	RegistryA string          `json:"registryA"`
+	RegistryB string          `json:"registryB"`
+	OnlyInA   []serverVersion `json:"onlyInA"`
+	OnlyInB   []serverVersion `json:"onlyInB"`
+	Common    int             `json:"common"`
+}
+
+// listAllServers returns every server version published to the registry, following the
+// pagination cursor of the list endpoint until the last page
+func listAllServers(client *http.Client, registryURL, apiPrefix string) ([]apiv0.ServerResponse, error) {
+	var servers []apiv0.ServerResponse
+	seen := map[string]bool{}
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(listPageSize))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		body, statusCode, err := registryGet(client, registryURL+"/"+apiPrefix+"servers?"+query.Encode(), "")
+		if err != nil {
+			return nil, err
+		}
+		if statusCode != http.StatusOK {
+			return nil, newRegistryError("server list of "+registryURL, statusCode, body)
+		}
+
+		var page apiv0.ServerListResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("invalid server list from %s: %w", registryURL, err)
+		}
+		servers = append(servers, page.Servers...)
+
+		cursor = page.Metadata.NextCursor
+		if cursor == "" {
+			return servers, nil
+		}
+		if seen[cursor] {
+			return nil, fmt.Errorf("server list from %s repeats the cursor %q", registryURL, cursor)
+		}
+		seen[cursor] = true
+	}
+}
+
+// compareServerLists returns the versions listed only in a and only in b, sorted by name
+// and version, and the number listed in both
+func compareServerLists(a, b []apiv0.ServerResponse) ([]serverVersion, []serverVersion, int) {
+	toSet := func(servers []apiv0.ServerResponse) map[serverVersion]bool {
+		set := make(map[serverVersion]bool, len(servers))
+		for _, s := range servers {
+			set[serverVersion{Name: s.Server.Name, Version: s.Server.Version}] = true
+		}
+		return set
+	}
+	difference := func(from, other map[serverVersion]bool) []serverVersion {
+		only := []serverVersion{}
+		for v := range from {
+			if !other[v] {
+				only = append(only, v)
+			}
+		}
+		sort.Slice(only, func(i, j int) bool {
+			if only[i].Name != only[j].Name {
+				return only[i].Name < only[j].Name
+			}
+			return only[i].Version < only[j].Version
+		})
+		return only
+	}
+
+	setA, setB := toSet(a), toSet(b)
+	onlyInA := difference(setA, setB)
+	return onlyInA, difference(setB, setA), len(setA) - len(onlyInA)
+}
+
+// CompareRegistriesCommand lists every server version published to two registries and
+// reports the versions present in only one of them
+func CompareRegistriesCommand(args []string) error {
+	fs := flag.NewFlagSet("compare-registries", flag.ExitOnError)
+	format := fs.String("format", formatText, "Output format: text or json")
+	apiPrefixFlag := fs.String("api-prefix", "", "Path below the registry URLs where their API is mounted (default: v0)")
+	proxy := fs.String("proxy", "", "Proxy URL for registry requests")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *format != formatText && *format != formatJSON {
+		return fmt.Errorf("invalid format '%s'. Must be one of: %s, %s", *format, formatText, formatJSON)
+	}
+	if len(positional) != 2 {
+		return errors.New("two registries are required\n\nUsage: mcp-publisher compare-registries [flags] <registry-a> <registry-b>")
+	}
+	registries := make([]string, len(positional))
+	for i, value := range positional {
+		if registries[i], err = resolveRegistryURL(value); err != nil {
+			return err
+		}
+	}
+	apiPrefix, err := resolveAPIPrefix(*apiPrefixFlag)
+	if err != nil {
+		return err
+	}
+	client, err := newHTTPClient(*proxy)
+	if err != nil {
+		return err
+	}
+
+	lists := make([][]apiv0.ServerResponse, len(registries))
+	for i, registryURL := range registries {
+		if lists[i], err = listAllServers(client, registryURL, apiPrefix); err != nil {
+			return operationFailed("server list of "+registryURL, err)
+		}
+	}
+
+	comparison := registryComparison{RegistryA: registries[0], RegistryB: registries[1]}
+	comparison.OnlyInA, comparison.OnlyInB, comparison.Common = compareServerLists(lists[0], lists[1])
+
+	if *format == formatJSON {
+		data, err := json.MarshalIndent(comparison, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize comparison: %w", err)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(data))
+	} else {
+		printRegistryComparison(os.Stdout, comparison)
+	}
+
+	if len(comparison.OnlyInA) > 0 || len(comparison.OnlyInB) > 0 {
+		return fmt.Errorf("registries differ: %d version(s) only in %s, %d only in %s",
+			len(comparison.OnlyInA), comparison.RegistryA, len(comparison.OnlyInB), comparison.RegistryB)
+	}
+	return nil
+}
+
+// printRegistryComparison prints the versions present in only one registry
+func printRegistryComparison(w io.Writer, c registryComparison) {
+	if len(c.OnlyInA) == 0 && len(c.OnlyInB) == 0 {
+		_, _ = fmt.Fprintf(w, "✓ %s and %s list the same %d server version(s)\n", c.RegistryA, c.RegistryB, c.Common)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%d server version(s) are listed by both registries\n", c.Common)
+	for _, side := range []struct {
+		registry string
+		only     []serverVersion
+	}{{c.RegistryA, c.OnlyInA}, {c.RegistryB, c.OnlyInB}} {
+		if len(side.only) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "\nOnly in %s (%d):\n", side.registry, len(side.only))
+		for _, v := range side.only {
+			_, _ = fmt.Fprintf(w, "  %s version %s\n", v.Name, v.Version)
+		}
+	}
+}
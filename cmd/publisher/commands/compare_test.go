@@ -0,0 +1,172 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupListRegistry serves versions (name, version pairs) from /v0/servers two per page,
+// using the index of the next entry as the cursor
+func setupListRegistry(t *testing.T, versions ...[2]string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/servers", func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			var err error
+			start, err = strconv.Atoi(cursor)
+			require.NoError(t, err)
+		}
+		end := min(start+2, len(versions))
+
+		var response apiv0.ServerListResponse
+		for _, v := range versions[start:end] {
+			response.Servers = append(response.Servers, apiv0.ServerResponse{Server: apiv0.ServerJSON{Name: v[0], Version: v[1]}})
+		}
+		response.Metadata.Count = len(response.Servers)
+		if end < len(versions) {
+			response.Metadata.NextCursor = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func setupCompareHome(t *testing.T) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(home, ".cache"))
+}
+
+func TestCompareRegistriesCommand(t *testing.T) {
+	setupCompareHome(t)
+	registryA := setupListRegistry(t,
+		[2]string{"com.example/a", "1.0.0"},
+		[2]string{"com.example/a", "1.1.0"},
+		[2]string{"com.example/b", "1.0.0"},
+		[2]string{"com.example/c", "2.0.0"},
+		[2]string{"com.example/only-a", "0.1.0"},
+	)
+	registryB := setupListRegistry(t,
+		[2]string{"com.example/a", "1.0.0"},
+		[2]string{"com.example/b", "1.0.0"},
+		[2]string{"com.example/c", "2.0.0"},
+		[2]string{"com.example/only-b", "3.0.0"},
+	)
+
+	t.Run("text", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.CompareRegistriesCommand([]string{registryA.URL, registryB.URL})
+		})
+		require.Error(t, err)
+		assert.Equal(t, "registries differ: 2 version(s) only in "+registryA.URL+", 1 only in "+registryB.URL, err.Error())
+		assert.Equal(t, "3 server version(s) are listed by both registries\n"+
+			"\nOnly in "+registryA.URL+" (2):\n  com.example/a version 1.1.0\n  com.example/only-a version 0.1.0\n"+
+			"\nOnly in "+registryB.URL+" (1):\n  com.example/only-b version 3.0.0\n", output)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.CompareRegistriesCommand([]string{"--format", "json", registryA.URL, registryB.URL})
+		})
+		require.Error(t, err)
+
+		var comparison struct {
+			RegistryA string              `json:"registryA"`
+			RegistryB string              `json:"registryB"`
+			OnlyInA   []map[string]string `json:"onlyInA"`
+			OnlyInB   []map[string]string `json:"onlyInB"`
+			Common    int                 `json:"common"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &comparison), output)
+		assert.Equal(t, registryA.URL, comparison.RegistryA)
+		assert.Equal(t, registryB.URL, comparison.RegistryB)
+		assert.Equal(t, []map[string]string{
+			{"name": "com.example/a", "version": "1.1.0"},
+			{"name": "com.example/only-a", "version": "0.1.0"},
+		}, comparison.OnlyInA)
+		assert.Equal(t, []map[string]string{{"name": "com.example/only-b", "version": "3.0.0"}}, comparison.OnlyInB)
+		assert.Equal(t, 3, comparison.Common)
+	})
+}
+
+func TestCompareRegistriesCommand_Disjoint(t *testing.T) {
+	setupCompareHome(t)
+	registryA := setupListRegistry(t, [2]string{"com.example/a", "1.0.0"}, [2]string{"com.example/b", "1.0.0"}, [2]string{"com.example/c", "1.0.0"})
+	registryB := setupListRegistry(t, [2]string{"com.example/d", "1.0.0"})
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.CompareRegistriesCommand([]string{"--format", "json", registryA.URL, registryB.URL})
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 version(s) only in")
+
+	var comparison struct {
+		OnlyInA []map[string]string `json:"onlyInA"`
+		OnlyInB []map[string]string `json:"onlyInB"`
+		Common  int                 `json:"common"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &comparison), output)
+	assert.Len(t, comparison.OnlyInA, 3)
+	assert.Len(t, comparison.OnlyInB, 1)
+	assert.Zero(t, comparison.Common)
+}
+
+func TestCompareRegistriesCommand_Same(t *testing.T) {
+	setupCompareHome(t)
+	versions := [][2]string{{"com.example/a", "1.0.0"}, {"com.example/b", "1.0.0"}, {"com.example/c", "1.0.0"}}
+	registryA := setupListRegistry(t, versions...)
+	registryB := setupListRegistry(t, versions[2], versions[0], versions[1])
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.CompareRegistriesCommand([]string{registryA.URL, registryB.URL})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "✓ "+registryA.URL+" and "+registryB.URL+" list the same 3 server version(s)\n", output)
+}
+
+func TestCompareRegistriesCommand_Errors(t *testing.T) {
+	setupCompareHome(t)
+	registry := setupListRegistry(t, [2]string{"com.example/a", "1.0.0"})
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(failing.Close)
+
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{"one registry", []string{registry.URL}, "two registries are required"},
+		{"invalid registry", []string{registry.URL, "not-a-registry"}, "invalid registry"},
+		{"invalid format", []string{"--format", "yaml", registry.URL, registry.URL}, "invalid format"},
+		{"list failure", []string{registry.URL, failing.URL}, "server list of " + failing.URL + " failed: server returned status 500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commands.CompareRegistriesCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
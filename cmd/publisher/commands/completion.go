@@ -0,0 +1,228 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// completionCommand describes a publisher command for shell completion
+type completionCommand struct {
+	name        string
+	description string
+	// args are the words completed as the first argument, e.g. login methods
+	args []string
+	// flags are the command's flag names without dashes
+	flags []string
+}
+
+// completionCommands lists every publisher command with its flags. Keep it in sync with
+// the command dispatch in main.go and the flags each command defines.
+var completionCommands = []completionCommand{
+	{
+		name:        "auth",
+		description: "Check that the saved token is accepted by the registry",
+		args:        []string{"test"},
+		flags:       []string{"proxy", "registry-auth-command", "token-file", "token-stdin"},
+	},
+	{
+		name:        "canonicalize",
+		description: "Print server.json as RFC 8785 canonical JSON for signing",
+		flags:       []string{"output"},
+	},
+	{
+		name:        "compare-registries",
+		description: "List server versions published to only one of two registries",
+		flags:       []string{"api-prefix", "format", "proxy"},
+	},
+	{
+		name:        "completion",
+		description: "Generate a shell completion script",
+		args:        []string{"bash", "zsh", "fish"},
+	},
+	{
+		name:        "config",
+		description: "Show the effective configuration and where each value comes from",
+		flags:       []string{"api-prefix", "format", "no-prerelease", "proxy", "registry", "registry-timeout", "timeout", "token-file"},
+	},
+	{
+		name:        "deprecate",
+		description: "Mark a server version deprecated with a notice",
+		flags:       []string{"api-prefix", "proxy", "reason", "registry-auth-command", "replacement", "token-file", "token-stdin"},
+	},
+	{name: "help", description: "Show usage"},
+	{name: "init", description: "Create a server.json file template"},
+	{
+		name:        "lint",
+		description: "Score server.json against registry listing best practices",
+		flags:       []string{"format"},
+	},
+	{
+		name:        "login",
+		description: "Authenticate with the registry",
+		args:        []string{MethodGitHub, MethodGitHubOIDC, MethodDNS, MethodHTTP, MethodNone, "refresh"},
+		flags:       []string{"algorithm", "domain", "dry-run", "key", "private-key", "registry", "resource", "token", "token-file", "token-stdin", "vault"},
+	},
+	{name: "logout", description: "Clear saved authentication"},
+	{
+		name:        "migrate",
+		description: "Migrate server.json to the current schema",
+		flags:       []string{"dry-run"},
+	},
+	{
+		name:        "publish",
+		description: "Publish server.json to the registry",
+		flags: []string{
+			"api-prefix", "auth-validate", "check-downgrade", "continue-on-error", "dump-body", "dump-body-redacted", "entry", "input-format", "merge", "merge-arrays", "name", "no-prerelease",
+			"notes", "notes-text", "notify-url", "print-request",
+			"proxy", "registry", "registry-auth-command", "registry-timeout", "resume",
+			"template", "timeout", "token-file", "token-stdin", "update", "values", "var", "version", "wait", "yes", "y",
+		},
+	},
+	{
+		name:        "status",
+		description: "Update the status of a server version",
+		flags:       []string{"all-versions", "message", "registry-auth-command", "status", "token-file", "token-stdin", "yes", "y"},
+	},
+	{
+		name:        "validate",
+		description: "Validate server.json without publishing",
+		flags: []string{
+			"api-prefix", "auth-validate", "baseline", "check-namespace-domain", "check-package-versions", "dump", "entry", "events-fd", "exit-zero", "explain-all", "extract-key", "fail-on",
+			"fix-schema", "format", "ignore", "input-format", "lockfile", "max-issues", "merge", "merge-arrays", "name", "no-truncate", "offline", "only", "output-dir", "parallel-validate", "path",
+			"print-request", "profile", "proxy", "registry", "remote-schema", "report", "require-license", "schema-ref-dir", "schema-versions", "severity", "show-references", "strict",
+			"summary-only", "template", "update-baseline", "use-embedded-schema", "values", "var", "version",
+		},
+	},
+	{name: "version", description: "Print the publisher version"},
+}
+
+// CompletionCommand prints a completion script for the given shell
+func CompletionCommand(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: mcp-publisher completion <bash|zsh|fish>")
+	}
+
+	switch args[0] {
+	case "bash":
+		writeBashCompletion(os.Stdout)
+	case "zsh":
+		writeZshCompletion(os.Stdout)
+	case "fish":
+		writeFishCompletion(os.Stdout)
+	default:
+		return fmt.Errorf("unsupported shell: %s (available: bash, zsh, fish)", args[0])
+	}
+	return nil
+}
+
+// dashedFlags returns flags with the dashes the flag package accepts for them
+func dashedFlags(flags []string) []string {
+	dashed := make([]string, 0, len(flags))
+	for _, name := range flags {
+		if len(name) == 1 {
+			dashed = append(dashed, "-"+name)
+		} else {
+			dashed = append(dashed, "--"+name)
+		}
+	}
+	return dashed
+}
+
+func commandNames() []string {
+	names := make([]string, 0, len(completionCommands))
+	for _, cmd := range completionCommands {
+		names = append(names, cmd.name)
+	}
+	return names
+}
+
+func writeBashCompletion(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "# bash completion for mcp-publisher")
+	_, _ = fmt.Fprintln(w, "# Load with: source <(mcp-publisher completion bash)")
+	_, _ = fmt.Fprintln(w, "_mcp_publisher() {")
+	_, _ = fmt.Fprintln(w, `    local cur=${COMP_WORDS[COMP_CWORD]}`)
+	_, _ = fmt.Fprintln(w, `    if [[ $COMP_CWORD -eq 1 ]]; then`)
+	_, _ = fmt.Fprintf(w, "        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(commandNames(), " "))
+	_, _ = fmt.Fprintln(w, "        return")
+	_, _ = fmt.Fprintln(w, "    fi")
+	_, _ = fmt.Fprintln(w, "    local args= flags=")
+	_, _ = fmt.Fprintln(w, `    case ${COMP_WORDS[1]} in`)
+	for _, cmd := range completionCommands {
+		if len(cmd.args) == 0 && len(cmd.flags) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "        %s) args=%q flags=%q ;;\n",
+			cmd.name, strings.Join(cmd.args, " "), strings.Join(dashedFlags(cmd.flags), " "))
+	}
+	_, _ = fmt.Fprintln(w, "    esac")
+	_, _ = fmt.Fprintln(w, `    if [[ $cur == -* ]]; then`)
+	_, _ = fmt.Fprintln(w, `        COMPREPLY=($(compgen -W "$flags" -- "$cur"))`)
+	_, _ = fmt.Fprintln(w, `    elif [[ $COMP_CWORD -eq 2 && -n $args ]]; then`)
+	_, _ = fmt.Fprintln(w, `        COMPREPLY=($(compgen -W "$args" -- "$cur"))`)
+	_, _ = fmt.Fprintln(w, "    fi")
+	_, _ = fmt.Fprintln(w, "}")
+	_, _ = fmt.Fprintln(w, "complete -o default -F _mcp_publisher mcp-publisher")
+}
+
+func writeZshCompletion(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "#compdef mcp-publisher")
+	_, _ = fmt.Fprintln(w, "# zsh completion for mcp-publisher")
+	_, _ = fmt.Fprintln(w, "# Load with: source <(mcp-publisher completion zsh)")
+	_, _ = fmt.Fprintln(w, "_mcp_publisher() {")
+	_, _ = fmt.Fprintln(w, "    local -a commands args flags")
+	_, _ = fmt.Fprintln(w, "    commands=(")
+	for _, cmd := range completionCommands {
+		_, _ = fmt.Fprintf(w, "        '%s:%s'\n", cmd.name, cmd.description)
+	}
+	_, _ = fmt.Fprintln(w, "    )")
+	_, _ = fmt.Fprintln(w, "    if (( CURRENT == 2 )); then")
+	_, _ = fmt.Fprintln(w, "        _describe 'command' commands")
+	_, _ = fmt.Fprintln(w, "        return")
+	_, _ = fmt.Fprintln(w, "    fi")
+	_, _ = fmt.Fprintln(w, "    case $words[2] in")
+	for _, cmd := range completionCommands {
+		if len(cmd.args) == 0 && len(cmd.flags) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "        %s) args=(%s) flags=(%s) ;;\n",
+			cmd.name, strings.Join(cmd.args, " "), strings.Join(dashedFlags(cmd.flags), " "))
+	}
+	_, _ = fmt.Fprintln(w, "    esac")
+	_, _ = fmt.Fprintln(w, "    if [[ $PREFIX == -* ]]; then")
+	_, _ = fmt.Fprintln(w, "        compadd -- $flags")
+	_, _ = fmt.Fprintln(w, "    elif (( CURRENT == 3 && $#args )); then")
+	_, _ = fmt.Fprintln(w, "        compadd -- $args")
+	_, _ = fmt.Fprintln(w, "    else")
+	_, _ = fmt.Fprintln(w, "        _files")
+	_, _ = fmt.Fprintln(w, "    fi")
+	_, _ = fmt.Fprintln(w, "}")
+	_, _ = fmt.Fprintln(w, `if [[ $zsh_eval_context[-1] == loadautofunc ]]; then`)
+	_, _ = fmt.Fprintln(w, `    _mcp_publisher "$@"`)
+	_, _ = fmt.Fprintln(w, "else")
+	_, _ = fmt.Fprintln(w, "    compdef _mcp_publisher mcp-publisher")
+	_, _ = fmt.Fprintln(w, "fi")
+}
+
+func writeFishCompletion(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "# fish completion for mcp-publisher")
+	_, _ = fmt.Fprintln(w, "# Load with: mcp-publisher completion fish | source")
+	for _, cmd := range completionCommands {
+		_, _ = fmt.Fprintf(w, "complete -c mcp-publisher -f -n __fish_use_subcommand -a %s -d '%s'\n", cmd.name, cmd.description)
+	}
+	for _, cmd := range completionCommands {
+		seen := "'__fish_seen_subcommand_from " + cmd.name + "'"
+		if len(cmd.args) > 0 {
+			_, _ = fmt.Fprintf(w, "complete -c mcp-publisher -f -n %s -a '%s'\n", seen, strings.Join(cmd.args, " "))
+		}
+		for _, name := range cmd.flags {
+			option := "-l"
+			if len(name) == 1 {
+				option = "-s"
+			}
+			_, _ = fmt.Fprintf(w, "complete -c mcp-publisher -n %s %s %s\n", seen, option, name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package commands_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletionCommand(t *testing.T) {
+	t.Run("bash script completes subcommands and flags", func(t *testing.T) {
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.CompletionCommand([]string{"bash"})
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, out, "complete -o default -F _mcp_publisher mcp-publisher")
+		for _, cmd := range []string{"auth", "completion", "init", "login", "logout", "migrate", "publish", "status", "validate"} {
+			assert.Contains(t, out, cmd+" ", "missing subcommand %s", cmd)
+		}
+		assert.Contains(t, out, "--dry-run")
+		assert.Contains(t, out, "--no-prerelease")
+		assert.Contains(t, out, "--use-embedded-schema")
+		assert.Contains(t, out, "github github-oidc dns http none")
+	})
+
+	t.Run("zsh and fish scripts", func(t *testing.T) {
+		out := CaptureStdout(t, func() {
+			require.NoError(t, commands.CompletionCommand([]string{"zsh"}))
+		})
+		assert.True(t, strings.HasPrefix(out, "#compdef mcp-publisher\n"))
+		assert.Contains(t, out, "'publish:Publish server.json to the registry'")
+
+		out = CaptureStdout(t, func() {
+			require.NoError(t, commands.CompletionCommand([]string{"fish"}))
+		})
+		assert.Contains(t, out, "complete -c mcp-publisher -f -n __fish_use_subcommand -a validate")
+		assert.Contains(t, out, "complete -c mcp-publisher -n '__fish_seen_subcommand_from publish' -s y")
+	})
+
+	t.Run("rejects unknown shells", func(t *testing.T) {
+		err := commands.CompletionCommand([]string{"powershell"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported shell")
+
+		err = commands.CompletionCommand(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "usage")
+	})
+}
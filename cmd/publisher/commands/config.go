@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// PublisherConfig holds optional user settings from ~/.config/mcp-publisher/config.json
+type PublisherConfig struct {
+	// ProductionHosts lists registry hosts that require confirmation before publishing.
+	// When unset, the default registry's host is used. An empty list disables the prompt.
+	ProductionHosts []string `json:"productionHosts"`
+
+	// RecommendedFields lists the optional server.json fields that validate warns about
+	// when missing. When unset, validators.DefaultRecommendedFields is used. An empty list
+	// disables the warnings.
+	RecommendedFields []string `json:"recommendedFields"`
+
+	// RequireLicense makes validate require a recognized SPDX license identifier in the
+	// license field, like validate --require-license.
+	RequireLicense bool `json:"requireLicense"`
+
+	// CheckPackageVersions makes validate warn about packages whose version differs from
+	// the server version, like validate --check-package-versions.
+	CheckPackageVersions bool `json:"checkPackageVersions"`
+
+	// MaxPackages is the number of packages above which local validation warns with
+	// too-many-packages. When unset, validators.DefaultMaxPackages is used.
+	MaxPackages int `json:"maxPackages"`
+
+	// MaxArguments is the number of runtime and package arguments of a package above which
+	// local validation fails with arguments-too-many. When unset,
+	// validators.DefaultMaxArguments is used.
+	MaxArguments int `json:"maxArguments"`
+
+	// MaxServerJSONSize is the serialized size of server.json in bytes above which local
+	// validation fails with server-json-too-large. When unset,
+	// validators.DefaultMaxServerJSONSize is used.
+	MaxServerJSONSize int `json:"maxServerJsonSize"`
+
+	// MaxNameLength is the number of characters of the server name above which local
+	// validation fails with name-too-long. When unset, validators.DefaultMaxNameLength is
+	// used.
+	MaxNameLength int `json:"maxNameLength"`
+
+	// EnvNamePattern is the regular expression package environment variable names are
+	// expected to match, warning with env-name-nonstandard otherwise. When unset,
+	// validators.DefaultEnvNamePattern is used.
+	EnvNamePattern string `json:"envNamePattern"`
+
+	// NoPrerelease forbids publishing semver pre-release versions (e.g. 1.0.0-rc.1) to
+	// production registries, like publish --no-prerelease.
+	NoPrerelease bool `json:"noPrerelease"`
+
+	// APIPrefix is the path below the registry URL where its API is mounted, like
+	// --api-prefix. When unset, defaultAPIPrefix is used.
+	APIPrefix string `json:"apiPrefix"`
+
+	// RegistryAliases maps short names to registry URLs, so that --registry accepts e.g.
+	// "staging" instead of the full URL.
+	RegistryAliases map[string]string `json:"registryAliases"`
+}
+
+// defaultAPIPrefix is the path below the registry URL where the registry API is mounted
+const defaultAPIPrefix = "v0"
+
+// configFilePath returns the path to the publisher config file
+// (~/.config/mcp-publisher/config.json). It does not create the directory.
+func configFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "mcp-publisher", "config.json"), nil
+}
+
+// loadConfig reads the publisher config file. A missing file, or no home directory to
+// look for it in, yields the default config.
+func loadConfig() (*PublisherConfig, error) {
+	configPath, err := configFilePath()
+	if err != nil {
+		return &PublisherConfig{}, nil //nolint:nilerr // Settings are optional, so fall back to the defaults
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &PublisherConfig{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var config PublisherConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", configPath, err)
+	}
+
+	return &config, nil
+}
+
+// recommendedFields returns the optional fields that validate should warn about
+func (c *PublisherConfig) recommendedFields() []string {
+	if c.RecommendedFields == nil {
+		return validators.DefaultRecommendedFields
+	}
+	return c.RecommendedFields
+}
+
+// envNamePattern returns the compiled EnvNamePattern, or validators.DefaultEnvNamePattern
+// when it is unset
+func (c *PublisherConfig) envNamePattern() (*regexp.Regexp, error) {
+	if c.EnvNamePattern == "" {
+		return validators.DefaultEnvNamePattern, nil
+	}
+	pattern, err := regexp.Compile(c.EnvNamePattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid envNamePattern in config: %w", err)
+	}
+	return pattern, nil
+}
+
+// localValidationOptions returns the options of local validation: all checks, with the
+// limits and environment variable name pattern of c
+func (c *PublisherConfig) localValidationOptions() (validators.ValidationOptions, error) {
+	opts := validators.ValidationAll
+	opts.MaxPackages = c.MaxPackages
+	opts.MaxArguments = c.MaxArguments
+	opts.MaxServerJSONSize = c.MaxServerJSONSize
+	opts.MaxNameLength = c.MaxNameLength
+	var err error
+	if opts.EnvNamePattern, err = c.envNamePattern(); err != nil {
+		return validators.ValidationOptions{}, err
+	}
+	return opts, nil
+}
+
+// isProductionRegistry reports whether registryURL points at one of the configured
+// production hosts, ignoring port and case.
+func (c *PublisherConfig) isProductionRegistry(registryURL string) bool {
+	hosts := c.ProductionHosts
+	if hosts == nil {
+		defaultURL, _ := url.Parse(DefaultRegistryURL)
+		hosts = []string{defaultURL.Hostname()}
+	}
+
+	parsed, err := url.Parse(registryURL)
+	if err != nil {
+		return false
+	}
+
+	for _, host := range hosts {
+		if strings.EqualFold(parsed.Hostname(), host) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAPIPrefix returns the API path prefix given by flagValue, the apiPrefix config
+// setting or defaultAPIPrefix, in that order. It is normalized for appending to a registry
+// URL that ends in "/": no leading slash and a trailing one, e.g. "api/v0/", or empty when
+// the API is mounted at the root ("/").
+func resolveAPIPrefix(flagValue string) (string, error) {
+	prefix := flagValue
+	if prefix == "" {
+		config, err := loadConfig()
+		if err != nil {
+			return "", err
+		}
+		prefix = config.APIPrefix
+	}
+	if prefix == "" {
+		prefix = defaultAPIPrefix
+	}
+
+	if strings.ContainsAny(prefix, "?#\\") || strings.Contains(prefix, "://") || slices.Contains(strings.Split(prefix, "/"), "..") {
+		return "", fmt.Errorf("invalid API prefix %q: must be a path such as %s or api/%s", prefix, defaultAPIPrefix, defaultAPIPrefix)
+	}
+
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return "", nil
+	}
+	return prefix + "/", nil
+}
+
+// resolveRegistryURL expands value if it is one of the configured registry aliases and
+// otherwise treats it as a literal registry URL. The URL is returned without a trailing slash.
+func resolveRegistryURL(value string) (string, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return "", err
+	}
+
+	registryURL := value
+	if aliased, ok := config.RegistryAliases[value]; ok {
+		registryURL = aliased
+	}
+
+	parsed, err := url.Parse(registryURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		if registryURL != value {
+			return "", fmt.Errorf("registry alias %q is not an http(s) URL: %s", value, registryURL)
+		}
+		return "", fmt.Errorf("invalid registry %q: not an http(s) URL or a registryAliases entry in the config file", value)
+	}
+	return strings.TrimRight(registryURL, "/"), nil
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// maxStatusMessageLength is the longest status message the registry accepts
+const maxStatusMessageLength = 500
+
+const deprecateUsage = "Usage: mcp-publisher deprecate --reason <reason> [--replacement <name>[@<version>]] [flags] <server-name> <version>"
+
+// DeprecateCommand marks a published version deprecated with a notice explaining why and,
+// optionally, what to use instead. Unlike deleting it, the version stays available.
+func DeprecateCommand(args []string) error {
+	fs := flag.NewFlagSet("deprecate", flag.ExitOnError)
+	reason := fs.String("reason", "", "Why the version is deprecated (required)")
+	replacement := fs.String("replacement", "", "Server to use instead, as <name> or <name>@<version>")
+	apiPrefixFlag := fs.String("api-prefix", "", "Path below the registry URL where its API is mounted (default: v0)")
+	proxy := fs.String("proxy", "", "Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+	var tokens tokenSource
+	tokens.register(fs)
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(*reason) == "" {
+		return errors.New("--reason is required\n\n" + deprecateUsage)
+	}
+	if len(positional) != 2 {
+		return errors.New("server name and version are required\n\n" + deprecateUsage)
+	}
+	serverName, version := positional[0], positional[1]
+
+	message, err := deprecationMessage(serverName, version, *reason, *replacement)
+	if err != nil {
+		return err
+	}
+
+	apiPrefix, err := resolveAPIPrefix(*apiPrefixFlag)
+	if err != nil {
+		return err
+	}
+	client, err := newHTTPClient(*proxy)
+	if err != nil {
+		return err
+	}
+	token, registryURL, err := tokens.load()
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Deprecating %s version %s...\n", serverName, version)
+	response, err := updateServerStatus(client, registryURL, apiPrefix, serverName, version, string(model.StatusDeprecated), message, token)
+	if err != nil {
+		return fmt.Errorf("failed to deprecate: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "✓ Successfully deprecated")
+	if official := response.Meta.Official; official != nil && official.StatusMessage != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "  Notice: %s\n", *official.StatusMessage)
+	}
+	return nil
+}
+
+// deprecationMessage returns the status message for deprecating version of serverName
+// because of reason, pointing to replacement if set. replacement must name a valid server,
+// optionally at a specific version, other than the deprecated version itself.
+func deprecationMessage(serverName, version, reason, replacement string) (string, error) {
+	message := strings.TrimSpace(reason)
+	if replacement != "" {
+		name, replacementVersion, hasVersion := strings.Cut(replacement, "@")
+		if err := validators.ValidateServerName(name); err != nil {
+			return "", fmt.Errorf("invalid --replacement %q: %w", replacement, err)
+		}
+		if hasVersion {
+			if replacementVersion == "" {
+				return "", fmt.Errorf("invalid --replacement %q: version is empty after @", replacement)
+			}
+			if result := validators.ValidateVersion(replacementVersion); !result.Valid {
+				return "", fmt.Errorf("invalid --replacement %q: %s", replacement, result.Issues[0].Message)
+			}
+		}
+		if name == serverName && replacementVersion == version {
+			return "", fmt.Errorf("--replacement %q is the version being deprecated", replacement)
+		}
+		message = fmt.Sprintf("%s. Use %s instead", strings.TrimSuffix(message, "."), replacement)
+	}
+
+	if length := utf8.RuneCountInString(message); length > maxStatusMessageLength {
+		return "", fmt.Errorf("the deprecation notice is %d characters, more than the registry's limit of %d; shorten --reason", length, maxStatusMessageLength)
+	}
+	return message, nil
+}
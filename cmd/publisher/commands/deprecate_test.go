@@ -0,0 +1,162 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecateCommand(t *testing.T) {
+	var method, path, auth string
+	var body commands.StatusUpdateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, auth = r.Method, r.URL.EscapedPath(), r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+			Server: apiv0.ServerJSON{Name: "io.github.user/my-server", Version: "1.0.0"},
+			Meta: apiv0.ResponseMeta{Official: &apiv0.RegistryExtensions{
+				Status:        model.StatusDeprecated,
+				StatusMessage: body.StatusMessage,
+			}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.DeprecateCommand([]string{
+			"--reason", "Superseded by the v2 API.",
+			"--replacement", "io.github.user/my-server@2.0.0",
+			"io.github.user/my-server", "1.0.0",
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPatch, method)
+	assert.Equal(t, "/v0/servers/io.github.user%2Fmy-server/versions/1.0.0/status", path)
+	assert.Equal(t, "Bearer test-token", auth)
+	assert.Equal(t, "deprecated", body.Status)
+	require.NotNil(t, body.StatusMessage)
+	assert.Equal(t, "Superseded by the v2 API. Use io.github.user/my-server@2.0.0 instead", *body.StatusMessage)
+
+	assert.Contains(t, output, "✓ Successfully deprecated")
+	assert.Contains(t, output, "Notice: Superseded by the v2 API. Use io.github.user/my-server@2.0.0 instead")
+}
+
+func TestDeprecateCommand_APIPrefix(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+			Server: apiv0.ServerJSON{Name: "io.github.user/my-server", Version: "1.0.0"},
+		})
+	}))
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+
+	var err error
+	_ = CaptureStdout(t, func() {
+		err = commands.DeprecateCommand([]string{"--api-prefix", "api/v0", "--reason", "Unmaintained", "io.github.user/my-server", "1.0.0"})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v0/servers/io.github.user%2Fmy-server/versions/1.0.0/status", path)
+}
+
+func TestDeprecateCommand_RegistryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"Not Found","status":404,"detail":"Server version not found"}`))
+	}))
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+
+	var err error
+	_ = CaptureStdout(t, func() {
+		err = commands.DeprecateCommand([]string{"--reason", "Unmaintained", "io.github.user/my-server", "9.9.9"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to deprecate")
+	assert.Contains(t, err.Error(), "Server version not found")
+}
+
+func TestDeprecateCommand_Validation(t *testing.T) {
+	// No request may reach the registry for invalid arguments
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+
+	tests := []struct {
+		name        string
+		args        []string
+		errorSubstr string
+	}{
+		{
+			name:        "missing --reason",
+			args:        []string{"io.github.user/my-server", "1.0.0"},
+			errorSubstr: "--reason is required",
+		},
+		{
+			name:        "missing version",
+			args:        []string{"--reason", "Unmaintained", "io.github.user/my-server"},
+			errorSubstr: "server name and version are required",
+		},
+		{
+			name:        "replacement without namespace",
+			args:        []string{"--reason", "Unmaintained", "--replacement", "my-server", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: `invalid --replacement "my-server"`,
+		},
+		{
+			name:        "replacement with invalid name",
+			args:        []string{"--reason", "Unmaintained", "--replacement", "io.github.user/-bad", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: `invalid --replacement "io.github.user/-bad"`,
+		},
+		{
+			name:        "replacement with empty version",
+			args:        []string{"--reason", "Unmaintained", "--replacement", "io.github.user/other@", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: "version is empty",
+		},
+		{
+			name:        "replacement with version range",
+			args:        []string{"--reason", "Unmaintained", "--replacement", "io.github.user/other@^2.0.0", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: `invalid --replacement "io.github.user/other@^2.0.0"`,
+		},
+		{
+			name:        "replacement is the deprecated version",
+			args:        []string{"--reason", "Unmaintained", "--replacement", "io.github.user/my-server@1.0.0", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: "is the version being deprecated",
+		},
+		{
+			name:        "invalid proxy",
+			args:        []string{"--reason", "Unmaintained", "--proxy", "not a url", "io.github.user/my-server", "1.0.0"},
+			errorSubstr: "invalid proxy URL",
+		},
+		{
+			name:        "notice too long",
+			args:        []string{"--reason", strings.Repeat("a", 501), "io.github.user/my-server", "1.0.0"},
+			errorSubstr: "more than the registry's limit of 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commands.DeprecateCommand(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorSubstr)
+		})
+	}
+}
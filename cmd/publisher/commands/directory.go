@@ -0,0 +1,231 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// maxConcurrentValidations limits how many files directory validation checks at once
+// locally, which is bound by CPU rather than by the network
+const maxConcurrentValidations = 8
+
+// defaultParallelValidate is how many files directory validation checks at once via the
+// registry unless --parallel-validate says otherwise. Those validations spend most of
+// their time waiting for the registry and for $schema URLs.
+const defaultParallelValidate = 8
+
+// maxParallelValidate is the largest --parallel-validate, and the number of idle
+// connections per host the HTTP client keeps so that every worker can reuse one
+const maxParallelValidate = 64
+
+func init() {
+	if err := validators.RegisterRule(validators.Rule{
+		Reference:   "server-json-unreadable",
+		Type:        validators.ValidationIssueTypeJSON,
+		Severity:    validators.ValidationIssueSeverityError,
+		Description: "server.json found in a directory must be readable and valid JSON (checked by validate <directory>)",
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// directoryEntry is the outcome of validating one server.json found in a directory
+type directoryEntry struct {
+	path       string
+	result     *validators.ValidationResult
+	serverJSON *apiv0.ServerJSON
+	unreadable bool
+	// failed is set when the entry fails validation under --fail-on
+	failed bool
+}
+
+// outcome returns the event stream outcome of the entry
+func (e directoryEntry) outcome() string {
+	switch {
+	case e.unreadable:
+		return outcomeUnreadable
+	case !e.failed:
+		return outcomeValid
+	default:
+		return outcomeInvalid
+	}
+}
+
+// findServerJSONFiles returns every server.json below dir in lexical order, skipping
+// hidden directories and node_modules
+func findServerJSONFiles(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && (strings.HasPrefix(d.Name(), ".") || d.Name() == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() == "server.json" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", dir, err)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// validateDirectoryEntry reads and validates a single server.json, applying policy to the
+// result. Read and parse errors are reported as an issue rather than aborting the whole run.
+func validateDirectoryEntry(client *http.Client, path string, mode validationMode, policy issuePolicy) directoryEntry {
+	entry := directoryEntry{path: path}
+
+	serverData, err := os.ReadFile(path)
+	if err == nil {
+		serverData, err = prepareJSONInput(path, serverData)
+	}
+	if err == nil {
+		err = checkServerResponse(path, serverData)
+	}
+	if err == nil {
+		var serverJSON apiv0.ServerJSON
+		if err = json.Unmarshal(serverData, &serverJSON); err == nil {
+			entry.serverJSON = &serverJSON
+			entry.result, err = validateServer(client, serverData, &serverJSON, mode, io.Discard)
+			if err != nil {
+				err = fmt.Errorf("validation request failed: %w", err)
+			} else {
+				entry.result, entry.failed = policy.apply(entry.result)
+			}
+		}
+	}
+
+	if err != nil {
+		entry.unreadable, entry.failed = true, true
+		entry.result = &validators.ValidationResult{Valid: false, Issues: []validators.ValidationIssue{
+			validators.NewValidationIssueFromError(validators.ValidationIssueTypeJSON, path, err, "server-json-unreadable"),
+		}}
+	}
+
+	return entry
+}
+
+// concurrency returns how many files directory validation checks at once in mode
+func (m validationMode) concurrency() int {
+	if m.offline || m.schema != nil || m.parallel < 1 {
+		return maxConcurrentValidations
+	}
+	return m.parallel
+}
+
+// validateDirectoryEntries validates paths concurrently, the workers sharing client and
+// its pooled connections. Each worker writes only to the slot of its own path, so the
+// returned entries are in the order of paths regardless of completion order. onDone is
+// called from the workers as each entry completes.
+func validateDirectoryEntries(client *http.Client, paths []string, mode validationMode, policy issuePolicy, onDone func(directoryEntry)) []directoryEntry {
+	entries := make([]directoryEntry, len(paths))
+	sem := make(chan struct{}, mode.concurrency())
+
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			entries[i] = validateDirectoryEntry(client, path, mode, policy)
+			onDone(entries[i])
+		}()
+	}
+	wg.Wait()
+
+	return entries
+}
+
+// validateDirectory validates every server.json below dir and prints the results in path
+// order followed by an aggregate summary, emitting an event as each file completes. policy
+// applies --strict, --fail-on, --severity and the issue list options to every file. It
+// returns an error if any file failed.
+func validateDirectory(client *http.Client, dir string, mode validationMode, policy issuePolicy, out batchOutput) error {
+	paths, err := findServerJSONFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no server.json files found in %s", dir)
+	}
+
+	out.events.started(len(paths))
+	entries := validateDirectoryEntries(client, paths, mode, policy, func(entry directoryEntry) {
+		out.events.itemCompleted(entry.path, "", entry.outcome())
+	})
+	out.events.finished()
+
+	var valid, invalid, unreadable int
+	for _, entry := range entries {
+		if !out.summaryOnly || entry.outcome() != outcomeValid {
+			_, _ = fmt.Fprintf(out.text, "── %s\n", entry.path)
+		}
+		if out.report != nil || out.reports != nil {
+			if err := addDirectoryReports(out, dir, entry, policy); err != nil {
+				return err
+			}
+		}
+
+		switch entry.outcome() {
+		case outcomeUnreadable:
+			unreadable++
+			issue := entry.result.Issues[0]
+			_, _ = fmt.Fprintf(out.text, "❌ [%s] %s\n", issue.Type, issue.Message)
+			_, _ = fmt.Fprintf(out.text, "   Reference: %s\n", issue.Reference)
+			_, _ = fmt.Fprintln(out.text)
+		case outcomeValid:
+			valid++
+			if !out.summaryOnly {
+				// printValidationIssues writes to stdout, which carries only events with --format events
+				if out.text != io.Discard {
+					policy.print(entry.result, entry.serverJSON, false)
+				}
+				_, _ = fmt.Fprintln(out.text, "✅ server.json is valid")
+				_, _ = fmt.Fprintln(out.text)
+			}
+		default:
+			invalid++
+			if out.text != io.Discard {
+				policy.print(entry.result, entry.serverJSON, true)
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintf(out.text, "Validated %d server.json file(s) in %s: %d valid, %d invalid, %d unreadable\n", len(paths), dir, valid, invalid, unreadable)
+
+	if invalid > 0 || unreadable > 0 {
+		return validationFailure("%d of %d server.json file(s) in %s failed validation", invalid+unreadable, len(paths), dir)
+	}
+	return nil
+}
+
+// addDirectoryReports adds entry to the merged SARIF report and writes its --output-dir
+// report, named after its path relative to dir
+func addDirectoryReports(out batchOutput, dir string, entry directoryEntry, policy issuePolicy) error {
+	source := annotationSource(entry.path)
+	out.report.add(entry.path, source, "", policy.shownIssues(entry.result))
+	relPath, err := filepath.Rel(dir, entry.path)
+	if err != nil {
+		return fmt.Errorf("failed to locate %s in %s: %w", entry.path, dir, err)
+	}
+	return out.reports.write(relPath, entry.path, source, entry.result)
+}
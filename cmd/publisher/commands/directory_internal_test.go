@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// BenchmarkValidateDirectoryEntries measures directory validation via a registry that
+// takes a few milliseconds per request, as live checks do, at several --parallel-validate
+// values
+func BenchmarkValidateDirectoryEntries(b *testing.B) {
+	b.Setenv("HOME", b.TempDir())
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/validate", func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	mux.HandleFunc("/schema.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	b.Cleanup(server.Close)
+
+	data, err := json.Marshal(apiv0.ServerJSON{
+		Schema:      server.URL + "/schema.json",
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	paths := make([]string, 32)
+	for i := range paths {
+		paths[i] = filepath.Join(b.TempDir(), "server.json")
+		if err := os.WriteFile(paths[i], data, 0600); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	client, err := newHTTPClient("")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, parallel := range []int{1, defaultParallelValidate, 32} {
+		b.Run(fmt.Sprintf("parallel-%d", parallel), func(b *testing.B) {
+			mode := validationMode{registryURL: server.URL, apiPrefix: defaultAPIPrefix + "/", parallel: parallel}
+			for b.Loop() {
+				validateDirectoryEntries(client, paths, mode, issuePolicy{}, func(directoryEntry) {})
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createServerJSONTree writes count server.json files below a temp directory. Every fifth
+// file has a version range and every tenth is not valid JSON.
+func createServerJSONTree(t *testing.T, count int) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for i := range count {
+		serverDir := filepath.Join(dir, fmt.Sprintf("server-%02d", i))
+		require.NoError(t, os.MkdirAll(serverDir, 0700))
+
+		var data []byte
+		switch {
+		case i%10 == 9:
+			data = []byte("{not json")
+		case i%5 == 4:
+			var err error
+			data, err = json.Marshal(testServerJSON(withRangeVersion))
+			require.NoError(t, err)
+		default:
+			var err error
+			data, err = json.Marshal(testServerJSON())
+			require.NoError(t, err)
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(serverDir, "server.json"), data, 0600))
+	}
+
+	// Ignored locations
+	hidden := filepath.Join(dir, ".git")
+	require.NoError(t, os.MkdirAll(hidden, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(hidden, "server.json"), []byte("{not json"), 0600))
+
+	return dir
+}
+
+func TestValidateCommand_Directory(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	dir := createServerJSONTree(t, 50)
+
+	var outputs []string
+	for range 3 {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", dir})
+		})
+		require.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("10 of 50 server.json file(s) in %s failed validation", dir), err.Error())
+		outputs = append(outputs, output)
+	}
+
+	output := outputs[0]
+	assert.Contains(t, output, fmt.Sprintf("Validated 50 server.json file(s) in %s: 40 valid, 5 invalid, 5 unreadable", dir))
+	assert.NotContains(t, output, ".git")
+
+	// Results are reported in path order regardless of completion order
+	previous := -1
+	for i := range 50 {
+		index := strings.Index(output, "── "+filepath.Join(dir, fmt.Sprintf("server-%02d", i), "server.json"))
+		require.Greater(t, index, previous, "server-%02d should be reported after the previous file", i)
+		previous = index
+	}
+
+	for _, other := range outputs[1:] {
+		assert.Equal(t, output, other, "directory validation output should be deterministic")
+	}
+}
+
+func TestValidateCommand_DirectoryUnsupportedFlags(t *testing.T) {
+	dir := createServerJSONTree(t, 1)
+
+	err := commands.ValidateCommand([]string{"--offline", "--format", "json", dir})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support")
+
+	err = commands.ValidateCommand([]string{"--offline", t.TempDir()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no server.json files found")
+}
+
+func TestValidateCommand_DirectorySummaryOnly(t *testing.T) {
+	dir := createServerJSONTree(t, 10)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--summary-only", dir})
+	})
+	require.Error(t, err)
+
+	for i := range 10 {
+		header := "── " + filepath.Join(dir, fmt.Sprintf("server-%02d", i), "server.json")
+		switch i {
+		case 4, 9:
+			assert.Contains(t, output, header, "failing server-%02d should be reported", i)
+		default:
+			assert.NotContains(t, output, header, "passing server-%02d should not be reported", i)
+		}
+	}
+	assert.NotContains(t, output, "✅ server.json is valid")
+	assert.Contains(t, output, "version-looks-like-range")
+	assert.Contains(t, output, fmt.Sprintf("Validated 10 server.json file(s) in %s: 8 valid, 1 invalid, 1 unreadable", dir))
+
+	err = commands.ValidateCommand([]string{"--offline", "--summary-only", filepath.Join(dir, "server-00", "server.json")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--summary-only is only supported when validating a directory or --lockfile")
+}
+
+func TestValidateCommand_DirectoryIssueOptions(t *testing.T) {
+	dir := t.TempDir()
+	serverJSON, err := json.Marshal(testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Repository = &model.Repository{URL: "http://github.com/example/test-server", Source: "github"}
+	}))
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "insecure"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "insecure", "server.json"), serverJSON, 0600))
+
+	validate := func(args ...string) (string, error) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand(append(append([]string{"--offline"}, args...), dir))
+		})
+		return output, err
+	}
+
+	t.Run("warnings are listed and pass by default", func(t *testing.T) {
+		output, err := validate()
+		require.NoError(t, err)
+		assert.Contains(t, output, "Reference: url-insecure-scheme")
+		assert.Contains(t, output, "✅ server.json is valid")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		output, err := validate("--strict")
+		require.Error(t, err)
+		assert.Contains(t, output, "1. [error] /repository/url (semantic)")
+		assert.Contains(t, output, "0 valid, 1 invalid, 0 unreadable")
+	})
+
+	t.Run("fail on warning", func(t *testing.T) {
+		output, err := validate("--fail-on", "warning")
+		require.Error(t, err)
+		assert.Contains(t, output, "Reference: url-insecure-scheme")
+		assert.Contains(t, output, "0 valid, 1 invalid, 0 unreadable")
+	})
+
+	t.Run("severity", func(t *testing.T) {
+		output, err := validate("--severity", "error")
+		require.NoError(t, err)
+		assert.NotContains(t, output, "url-insecure-scheme")
+	})
+
+	t.Run("show references", func(t *testing.T) {
+		output, err := validate("--show-references")
+		require.NoError(t, err)
+		assert.Contains(t, output, "1. [url-insecure-scheme] [warning] /repository/url (semantic)")
+	})
+}
+
+func TestValidateCommand_DirectoryOutputDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]apiv0.ServerJSON{
+		"server.json":                 testServerJSON(),
+		"team-a/weather/server.json":  testServerJSON(),
+		"team-b/nested/x/server.json": testServerJSON(withRangeVersion),
+	}
+	for relPath, serverJSON := range files {
+		path := filepath.Join(dir, filepath.FromSlash(relPath))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		data, err := json.Marshal(serverJSON)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0600))
+	}
+
+	t.Run("json", func(t *testing.T) {
+		outputDir := filepath.Join(t.TempDir(), "reports")
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--output-dir", outputDir, dir})
+		})
+		require.Error(t, err)
+
+		var reports []string
+		require.NoError(t, filepath.WalkDir(outputDir, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			relPath, err := filepath.Rel(outputDir, path)
+			reports = append(reports, filepath.ToSlash(relPath))
+			return err
+		}))
+		assert.ElementsMatch(t, []string{
+			"server.json.report.json",
+			"team-a/weather/server.json.report.json",
+			"team-b/nested/x/server.json.report.json",
+		}, reports)
+
+		var report struct {
+			Valid  bool                         `json:"valid"`
+			Issues []validators.ValidationIssue `json:"issues"`
+		}
+		data, err := os.ReadFile(filepath.Join(outputDir, "team-a", "weather", "server.json.report.json"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &report))
+		assert.True(t, report.Valid)
+
+		data, err = os.ReadFile(filepath.Join(outputDir, "team-b", "nested", "x", "server.json.report.json"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &report))
+		assert.False(t, report.Valid)
+		assert.NotEmpty(t, report.Issues)
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		outputDir := t.TempDir()
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "sarif", "--output-dir", outputDir, dir})
+		})
+		require.Error(t, err)
+
+		data, err := os.ReadFile(filepath.Join(outputDir, "team-b", "nested", "x", "server.json.report.json"))
+		require.NoError(t, err)
+		var log struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Artifacts []json.RawMessage `json:"artifacts"`
+				Results   []json.RawMessage `json:"results"`
+			} `json:"runs"`
+		}
+		require.NoError(t, json.Unmarshal(data, &log))
+		assert.Equal(t, "2.1.0", log.Version)
+		require.Len(t, log.Runs, 1)
+		assert.Len(t, log.Runs[0].Artifacts, 1)
+		assert.NotEmpty(t, log.Runs[0].Results)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "--output-dir", t.TempDir(), filepath.Join(dir, "server.json")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--output-dir is only supported when validating a directory or --lockfile")
+	})
+}
+
+// TestValidateCommand_DirectoryParallelValidate checks the concurrency of directory
+// validation via the registry. Run it with -race: the workers share the HTTP client.
+func TestValidateCommand_DirectoryParallelValidate(t *testing.T) {
+	var inFlight, maxInFlight, requests, connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		requests.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+	dir := createServerJSONTree(t, 20)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--parallel-validate", "4", dir})
+	})
+	require.Error(t, err, "the files that are not valid JSON still fail")
+	assert.Contains(t, output, fmt.Sprintf("Validated 20 server.json file(s) in %s: 18 valid, 0 invalid, 2 unreadable", dir))
+
+	assert.Equal(t, int32(18), requests.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(4))
+	assert.Greater(t, maxInFlight.Load(), int32(1), "files should be validated concurrently")
+	assert.LessOrEqual(t, connections.Load(), int32(4), "workers should reuse pooled connections")
+
+	for _, value := range []string{"0", "65"} {
+		err = commands.ValidateCommand([]string{"--parallel-validate", value, dir})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid parallel-validate "+value)
+	}
+}
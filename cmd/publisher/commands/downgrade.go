@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// checkDowngrade fetches the latest published version of serverJSON's server and returns
+// an error if serverJSON's version is lower. A server that has not been published yet has
+// no latest version, so it always passes.
+func checkDowngrade(client *http.Client, registryURL, apiPrefix string, serverJSON *apiv0.ServerJSON) error {
+	latest, err := fetchLatestVersion(client, registryURL, apiPrefix, serverJSON.Name)
+	if err != nil || latest == "" {
+		return err
+	}
+
+	result := validators.ValidateVersionNotDowngrade(serverJSON, latest)
+	if result.Valid {
+		return nil
+	}
+	issue := result.Issues[0]
+	return fmt.Errorf("%s [%s]; publish a version higher than %s", issue.Message, issue.Reference, latest)
+}
+
+// fetchLatestVersion returns the latest published version of serverName, or "" if the
+// registry does not know the server
+func fetchLatestVersion(client *http.Client, registryURL, apiPrefix, serverName string) (string, error) {
+	if !strings.HasSuffix(registryURL, "/") {
+		registryURL += "/"
+	}
+	latestURL := registryURL + apiPrefix + "servers/" + url.PathEscape(serverName) + "/versions/latest"
+
+	body, statusCode, err := registryGet(client, latestURL, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the latest published version: %w", err)
+	}
+	switch statusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", nil
+	default:
+		return "", newRegistryError("latest version lookup", statusCode, body)
+	}
+
+	var response apiv0.ServerResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("failed to parse the latest published version: %w", err)
+	}
+	return response.Server.Version, nil
+}
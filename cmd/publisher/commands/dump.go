@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// dumpEntryName identifies an entry of a registry export in the output: its name and
+// version, or its position if it has no name
+func dumpEntryName(serverJSON *apiv0.ServerJSON, position int) string {
+	if serverJSON == nil || serverJSON.Name == "" {
+		return "entry " + strconv.Itoa(position)
+	}
+	if serverJSON.Version == "" {
+		return serverJSON.Name
+	}
+	return serverJSON.Name + " version " + serverJSON.Version
+}
+
+// validateDumpEntry validates one server.json of a registry export, printing its issues to
+// w if it fails. It returns the name of the entry and whether it is valid.
+func validateDumpEntry(client *http.Client, serverData []byte, position int, mode validationMode, w io.Writer) (string, bool) {
+	var serverJSON apiv0.ServerJSON
+	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
+		name := dumpEntryName(nil, position)
+		_, _ = fmt.Fprintf(w, "❌ %s: invalid server.json: %v\n", name, err)
+		return name, false
+	}
+
+	name := dumpEntryName(&serverJSON, position)
+	result, err := validateServer(client, serverData, &serverJSON, mode, io.Discard)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "❌ %s: validation request failed: %v\n", name, err)
+		return name, false
+	}
+	if result.Valid {
+		return name, true
+	}
+
+	_, _ = fmt.Fprintf(w, "❌ %s\n", name)
+	for _, issue := range result.Issues {
+		_, _ = fmt.Fprintf(w, "   [%s] %s: %s\n", issue.Severity, issue.Path, issue.Message)
+	}
+	return name, false
+}
+
+// validateDump validates every server.json in the registry export at path, a JSON array of
+// server.json documents. The array is decoded one element at a time, so memory use is
+// bounded by the largest document rather than the size of the export. Failing entries are
+// printed as they are found, followed by a summary and the names of the failing servers.
+func validateDump(client *http.Client, path string, mode validationMode, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%s not found, please check the file path", path)
+		}
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return fmt.Errorf("invalid dump %s: expected a JSON array of server.json documents", path)
+	}
+
+	var total int
+	var failing []string
+	for dec.More() {
+		var serverData json.RawMessage
+		if err := dec.Decode(&serverData); err != nil {
+			return fmt.Errorf("invalid dump %s: entry %d: %w", path, total+1, err)
+		}
+		total++
+		if name, valid := validateDumpEntry(client, serverData, total, mode, w); !valid {
+			failing = append(failing, name)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid dump %s: %w", path, err)
+	}
+
+	_, _ = fmt.Fprintf(w, "Validated %d server(s) from %s: %d valid, %d invalid\n", total, path, total-len(failing), len(failing))
+	if len(failing) == 0 {
+		return nil
+	}
+	_, _ = fmt.Fprintln(w, "Failing servers:")
+	for _, name := range failing {
+		_, _ = fmt.Fprintf(w, "  %s\n", name)
+	}
+	return validationFailure("%d of %d server(s) in %s failed validation", len(failing), total, path)
+}
@@ -0,0 +1,101 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeDump writes docs as a registry export, encoding one array element at a time
+func writeDump(t *testing.T, docs ...any) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "dump.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = f.WriteString("[\n")
+	require.NoError(t, err)
+	enc := json.NewEncoder(f)
+	for i, doc := range docs {
+		if i > 0 {
+			_, err = f.WriteString(",")
+			require.NoError(t, err)
+		}
+		require.NoError(t, enc.Encode(doc))
+	}
+	_, err = f.WriteString("]\n")
+	require.NoError(t, err)
+	return path
+}
+
+func TestValidateCommand_Dump(t *testing.T) {
+	path := writeDump(t,
+		testServerJSON(),
+		testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "com.example/range-server"; s.Version = "^1.0.0" }),
+		testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "com.example/other-server"; s.Version = "2.0.0" }),
+		map[string]any{"name": 5},
+	)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--dump", path})
+	})
+	require.Error(t, err)
+	assert.Equal(t, "2 of 4 server(s) in "+path+" failed validation", err.Error())
+
+	assert.Contains(t, output, "❌ com.example/range-server version ^1.0.0\n   [error] /version: ")
+	assert.Contains(t, output, "❌ entry 4: invalid server.json: ")
+	assert.Contains(t, output, "Validated 4 server(s) from "+path+": 2 valid, 2 invalid\n")
+	assert.Contains(t, output, "Failing servers:\n  com.example/range-server version ^1.0.0\n  entry 4\n")
+	assert.NotContains(t, output, "com.example/test-server")
+	assert.NotContains(t, output, "com.example/other-server")
+}
+
+func TestValidateCommand_DumpValid(t *testing.T) {
+	path := writeDump(t, testServerJSON(), testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "1.0.1" }))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--dump", path})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Validated 2 server(s) from "+path+": 2 valid, 0 invalid\n", output)
+}
+
+func TestValidateCommand_DumpErrors(t *testing.T) {
+	dir := t.TempDir()
+	notArray := filepath.Join(dir, "object.json")
+	require.NoError(t, os.WriteFile(notArray, []byte(`{"name": "com.example/test-server"}`), 0600))
+	truncated := filepath.Join(dir, "truncated.json")
+	require.NoError(t, os.WriteFile(truncated, []byte(`[{"name": "com.example/test-server"}, {"name": `), 0600))
+
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{"missing file", []string{"--offline", "--dump", filepath.Join(dir, "missing.json")}, "not found"},
+		{"not an array", []string{"--offline", "--dump", notArray}, "expected a JSON array of server.json documents"},
+		{"truncated", []string{"--offline", "--dump", truncated}, "entry 2"},
+		{"format", []string{"--offline", "--dump", notArray, "--format", "json"}, "--dump cannot be combined with --format"},
+		{"only", []string{"--offline", "--dump", notArray, "--only", "version-looks-like-range"}, "--dump cannot be combined with --only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			CaptureStdout(t, func() {
+				err = commands.ValidateCommand(tt.args)
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
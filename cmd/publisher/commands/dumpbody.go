@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// redactedValue replaces secret values in a --dump-body-redacted file
+const redactedValue = "<redacted>"
+
+// bodyDump holds the --dump-body and --dump-body-redacted flags, which save the exact
+// request body sent to the registry, after templates and overrides are applied, so that
+// publish issues can be reproduced and attached to support tickets
+type bodyDump struct {
+	path         string
+	redactedPath string
+}
+
+// register adds the body dump flags to fs
+func (d *bodyDump) register(fs *flag.FlagSet) {
+	fs.StringVar(&d.path, "dump-body", "", "Write the exact JSON body of the publish request to this file")
+	fs.StringVar(&d.redactedPath, "dump-body-redacted", "", "Write the JSON body of the publish request to this file with secret values redacted")
+}
+
+// enabled reports whether either dump flag was given
+func (d *bodyDump) enabled() bool {
+	return d.path != "" || d.redactedPath != ""
+}
+
+// write saves body to the requested files
+func (d *bodyDump) write(body []byte) error {
+	if d.path != "" {
+		if err := os.WriteFile(d.path, body, 0600); err != nil {
+			return fmt.Errorf("failed to write request body to %s: %w", d.path, err)
+		}
+	}
+	if d.redactedPath != "" {
+		redacted, err := redactSecrets(body)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(d.redactedPath, redacted, 0600); err != nil {
+			return fmt.Errorf("failed to write redacted request body to %s: %w", d.redactedPath, err)
+		}
+	}
+	return nil
+}
+
+// redactSecrets returns body with the value and default of every input marked isSecret
+// replaced by redactedValue. Inputs appear in arguments, environment variables, headers
+// and their variables, so every object of the document is checked.
+func redactSecrets(body []byte) ([]byte, error) {
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse request body: %w", err)
+	}
+	redactSecretInputs(document)
+
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize redacted request body: %w", err)
+	}
+	return redacted, nil
+}
+
+// redactSecretInputs redacts the secret inputs in a decoded JSON value in place
+func redactSecretInputs(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if secret, _ := v["isSecret"].(bool); secret {
+			for _, field := range []string{"value", "default"} {
+				if _, ok := v[field]; ok {
+					v[field] = redactedValue
+				}
+			}
+		}
+		for _, child := range v {
+			redactSecretInputs(child)
+		}
+	case []any:
+		for _, child := range v {
+			redactSecretInputs(child)
+		}
+	}
+}
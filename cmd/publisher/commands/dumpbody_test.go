@@ -0,0 +1,112 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withSecretEnvironmentVariable adds a package with a secret environment variable and a
+// public one
+func withSecretEnvironmentVariable(s *apiv0.ServerJSON) {
+	secret := model.KeyValueInput{Name: "API_KEY"}
+	secret.Value = "sk-live-123"
+	secret.IsSecret = true
+	public := model.KeyValueInput{Name: "LOG_LEVEL"}
+	public.Default = "info"
+	s.Packages = []model.Package{{
+		RegistryType:         model.RegistryTypeNPM,
+		Identifier:           "@example/test-server",
+		Version:              "1.0.0",
+		Transport:            model.Transport{Type: model.TransportTypeStdio},
+		EnvironmentVariables: []model.KeyValueInput{secret, public},
+	}}
+}
+
+// setupDumpRegistry starts a registry that records each publish request body and responds
+// with status
+func setupDumpRegistry(t *testing.T, status int, received *[]byte) {
+	t.Helper()
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		*received = body
+		w.WriteHeader(status)
+		if status == http.StatusCreated {
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+		}
+	}, nil)
+	SetupTestToken(t, server.URL, "test-token")
+}
+
+func TestPublishCommand_DumpBody(t *testing.T) {
+	var received []byte
+	setupDumpRegistry(t, http.StatusCreated, &received)
+	CreateTestServerJSON(t, testServerJSON(withSecretEnvironmentVariable))
+	dir := t.TempDir()
+	bodyFile := filepath.Join(dir, "body.json")
+	redactedFile := filepath.Join(dir, "body-redacted.json")
+
+	var err error
+	CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{
+			"--dump-body", bodyFile, "--dump-body-redacted", redactedFile,
+			"--version", "2.0.0", "--notes-text", "Fixes",
+		})
+	})
+	require.NoError(t, err)
+
+	body, err := os.ReadFile(bodyFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(received), string(body), "the dumped body should be exactly what the registry received")
+
+	var request apiv0.PublishRequest
+	require.NoError(t, json.Unmarshal(body, &request))
+	assert.Equal(t, "2.0.0", request.Version, "overrides should be applied")
+	assert.Equal(t, "Fixes", request.ReleaseNotes)
+	assert.Equal(t, "sk-live-123", request.Packages[0].EnvironmentVariables[0].Value)
+
+	redacted, err := os.ReadFile(redactedFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(redacted), "sk-live-123")
+	var redactedRequest apiv0.PublishRequest
+	require.NoError(t, json.Unmarshal(redacted, &redactedRequest))
+	assert.Equal(t, "<redacted>", redactedRequest.Packages[0].EnvironmentVariables[0].Value)
+	assert.Equal(t, "info", redactedRequest.Packages[0].EnvironmentVariables[1].Default, "public values should be kept")
+
+	// Apart from the secret, the redacted body is the body that was sent
+	redactedRequest.Packages[0].EnvironmentVariables[0].Value = "sk-live-123"
+	assert.Equal(t, request, redactedRequest)
+}
+
+func TestPublishCommand_DumpBodyOnFailure(t *testing.T) {
+	var received []byte
+	setupDumpRegistry(t, http.StatusInternalServerError, &received)
+	CreateTestServerJSON(t, testServerJSON())
+	bodyFile := filepath.Join(t.TempDir(), "body.json")
+
+	var err error
+	CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--dump-body", bodyFile})
+	})
+	require.Error(t, err)
+
+	body, err := os.ReadFile(bodyFile)
+	require.NoError(t, err, "the body should be dumped even if the publish fails")
+	assert.Equal(t, string(received), string(body))
+}
+
+func TestPublishCommand_DumpBodySeveralFiles(t *testing.T) {
+	err := commands.PublishCommand([]string{"--dump-body", filepath.Join(t.TempDir(), "body.json"), "a.json", "b.json"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be used when publishing several files")
+}
@@ -0,0 +1,296 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// Sources of effective configuration values that are not a flag or environment variable
+const (
+	sourceDefault    = "default"
+	sourceConfigFile = "config file"
+	sourceTokenFile  = "token file"
+)
+
+// configSetting is one resolved setting printed by the config command, with where its
+// value came from, e.g. "flag --registry", "env HTTPS_PROXY", "config file" or "default"
+type configSetting struct {
+	Name   string `json:"name"`
+	Value  any    `json:"value"`
+	Source string `json:"source"`
+}
+
+// configFlags holds the config command's flags, which mirror those of publish and
+// validate so that their effect on the resolved settings can be inspected
+type configFlags struct {
+	format          string
+	registry        string
+	apiPrefix       string
+	proxy           string
+	tokenFile       string
+	timeout         time.Duration
+	registryTimeout time.Duration
+	noPrerelease    bool
+	// set holds the names of the flags given on the command line
+	set map[string]bool
+}
+
+// flagSource returns the source label of a flag
+func flagSource(name string) string {
+	return "flag --" + name
+}
+
+// ConfigCommand prints the effective configuration after merging flags, environment
+// variables, the config file and the token file, with the source of each value
+func ConfigCommand(args []string) error {
+	flags := configFlags{set: map[string]bool{}}
+	fs := flag.NewFlagSet("config", flag.ExitOnError)
+	fs.StringVar(&flags.format, "format", formatText, "Output format: text or json")
+	fs.StringVar(&flags.registry, "registry", "", "Resolve as if this registry URL or alias were passed to publish or validate")
+	fs.StringVar(&flags.apiPrefix, "api-prefix", "", "Resolve as if this API prefix were passed to publish or validate")
+	fs.StringVar(&flags.proxy, "proxy", "", "Resolve as if this proxy URL were passed to publish or validate")
+	fs.StringVar(&flags.tokenFile, "token-file", "", "Resolve as if this token file were passed to publish")
+	fs.DurationVar(&flags.timeout, "timeout", defaultRequestTimeout, "Resolve as if this request timeout were passed to publish")
+	fs.DurationVar(&flags.registryTimeout, "registry-timeout", defaultRegistryTimeout, "Resolve as if this --wait timeout were passed to publish")
+	fs.BoolVar(&flags.noPrerelease, "no-prerelease", false, "Resolve as if --no-prerelease were passed to publish")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	fs.Visit(func(f *flag.Flag) { flags.set[f.Name] = true })
+
+	if flags.format != formatText && flags.format != formatJSON {
+		return fmt.Errorf("invalid format '%s'. Must be one of: %s, %s", flags.format, formatText, formatJSON)
+	}
+	if err := checkTimeouts(flags.timeout, flags.registryTimeout); err != nil {
+		return err
+	}
+
+	settings, err := effectiveConfig(flags)
+	if err != nil {
+		return err
+	}
+
+	if flags.format == formatJSON {
+		data, err := json.MarshalIndent(settings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize configuration: %w", err)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(data))
+		return nil
+	}
+	printConfigSettings(os.Stdout, settings)
+	return nil
+}
+
+// effectiveConfig resolves every setting the way publish and validate do
+func effectiveConfig(flags configFlags) ([]configSetting, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	configPath, err := configFilePath()
+	if err != nil {
+		configPath = ""
+	}
+	settings := []configSetting{{Name: "configFile", Value: configPath, Source: "env HOME"}}
+
+	tokenSetting, registry, err := resolveTokenAndRegistry(flags)
+	if err != nil {
+		return nil, err
+	}
+	settings = append(settings, tokenSetting, registry)
+	registryURL, _ := registry.Value.(string)
+
+	apiPrefix, err := resolveAPIPrefix(flags.apiPrefix)
+	if err != nil {
+		return nil, err
+	}
+	apiPrefixSource := sourceDefault
+	switch {
+	case flags.apiPrefix != "":
+		apiPrefixSource = flagSource("api-prefix")
+	case config.APIPrefix != "":
+		apiPrefixSource = sourceConfigFile
+	}
+	settings = append(settings,
+		configSetting{Name: "apiPrefix", Value: "/" + apiPrefix, Source: apiPrefixSource},
+		resolveProxySetting(flags.proxy, registryURL),
+		durationSetting("timeout", "timeout", flags.timeout, flags.set),
+		durationSetting("registryTimeout", "registry-timeout", flags.registryTimeout, flags.set),
+	)
+
+	return append(settings, configFileSettings(config, flags)...), nil
+}
+
+// resolveTokenAndRegistry returns the token file setting and the registry publish would
+// use: --registry, the registry recorded by login in the token file, or the default
+func resolveTokenAndRegistry(flags configFlags) (configSetting, configSetting, error) {
+	tokenSetting := configSetting{Name: "tokenFile", Value: flags.tokenFile, Source: flagSource("token-file")}
+	if flags.tokenFile == "" {
+		path, err := tokenFilePath()
+		if err != nil {
+			path = ""
+		}
+		tokenSetting = configSetting{Name: "tokenFile", Value: path, Source: "env HOME"}
+	}
+
+	if flags.registry != "" {
+		registryURL, err := resolveRegistryURL(flags.registry)
+		if err != nil {
+			return configSetting{}, configSetting{}, err
+		}
+		return tokenSetting, configSetting{Name: "registry", Value: registryURL, Source: flagSource("registry")}, nil
+	}
+
+	registry := configSetting{Name: "registry", Value: DefaultRegistryURL, Source: sourceDefault + " (not logged in)"}
+	tokenPath, _ := tokenSetting.Value.(string)
+	if tokenPath == "" {
+		return tokenSetting, registry, nil
+	}
+	if _, err := os.Stat(tokenPath); errors.Is(err, os.ErrNotExist) {
+		return tokenSetting, registry, nil
+	}
+	// The token is not used, so an expired one is not refreshed
+	_, tokenInfo, err := readSavedToken(flags.tokenFile)
+	if err != nil {
+		return configSetting{}, configSetting{}, err
+	}
+	registryURL := savedRegistryURL(tokenInfo)
+	return tokenSetting, configSetting{Name: "registry", Value: registryURL, Source: sourceTokenFile}, nil
+}
+
+// resolveProxySetting returns the proxy used for requests to registryURL: --proxy, or
+// the proxy environment variable for the registry's scheme
+func resolveProxySetting(proxyFlag, registryURL string) configSetting {
+	if proxyFlag != "" {
+		return configSetting{Name: "proxy", Value: proxyFlag, Source: flagSource("proxy")}
+	}
+
+	names := []string{"HTTPS_PROXY", "https_proxy"}
+	if parsed, err := url.Parse(registryURL); err == nil && parsed.Scheme == "http" {
+		names = []string{"HTTP_PROXY", "http_proxy"}
+	}
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return configSetting{Name: "proxy", Value: value, Source: "env " + name}
+		}
+	}
+	return configSetting{Name: "proxy", Value: "", Source: sourceDefault}
+}
+
+// durationSetting returns the value of a timeout flag, which has no other source
+func durationSetting(name, flagName string, value time.Duration, set map[string]bool) configSetting {
+	source := sourceDefault
+	if set[flagName] {
+		source = flagSource(flagName)
+	}
+	return configSetting{Name: name, Value: value.String(), Source: source}
+}
+
+// configFileSettings returns the settings that only the config file (or a flag of the
+// same meaning) sets
+func configFileSettings(config *PublisherConfig, flags configFlags) []configSetting {
+	fromFile := func(set bool) string {
+		if set {
+			return sourceConfigFile
+		}
+		return sourceDefault
+	}
+
+	productionHosts := config.ProductionHosts
+	if productionHosts == nil {
+		defaultURL, _ := url.Parse(DefaultRegistryURL)
+		productionHosts = []string{defaultURL.Hostname()}
+	}
+
+	noPrerelease := configSetting{Name: "noPrerelease", Value: config.NoPrerelease, Source: fromFile(config.NoPrerelease)}
+	if flags.noPrerelease {
+		noPrerelease = configSetting{Name: "noPrerelease", Value: true, Source: flagSource("no-prerelease")}
+	}
+
+	envNamePattern := configSetting{Name: "envNamePattern", Value: validators.DefaultEnvNamePattern.String(), Source: sourceDefault}
+	if config.EnvNamePattern != "" {
+		envNamePattern = configSetting{Name: "envNamePattern", Value: config.EnvNamePattern, Source: sourceConfigFile}
+	}
+
+	aliases := config.RegistryAliases
+	if aliases == nil {
+		aliases = map[string]string{}
+	}
+
+	return []configSetting{
+		{Name: "productionHosts", Value: productionHosts, Source: fromFile(config.ProductionHosts != nil)},
+		{Name: "recommendedFields", Value: config.recommendedFields(), Source: fromFile(config.RecommendedFields != nil)},
+		noPrerelease,
+		{Name: "requireLicense", Value: config.RequireLicense, Source: fromFile(config.RequireLicense)},
+		{Name: "checkPackageVersions", Value: config.CheckPackageVersions, Source: fromFile(config.CheckPackageVersions)},
+		limitSetting("maxPackages", config.MaxPackages, validators.DefaultMaxPackages),
+		limitSetting("maxArguments", config.MaxArguments, validators.DefaultMaxArguments),
+		limitSetting("maxServerJsonSize", config.MaxServerJSONSize, validators.DefaultMaxServerJSONSize),
+		limitSetting("maxNameLength", config.MaxNameLength, validators.DefaultMaxNameLength),
+		envNamePattern,
+		{Name: "registryAliases", Value: aliases, Source: fromFile(config.RegistryAliases != nil)},
+	}
+}
+
+// limitSetting returns the setting of a validation limit, which the config file sets to a
+// positive value or is fallback
+func limitSetting(name string, configured, fallback int) configSetting {
+	if configured > 0 {
+		return configSetting{Name: name, Value: configured, Source: sourceConfigFile}
+	}
+	return configSetting{Name: name, Value: fallback, Source: sourceDefault}
+}
+
+// printConfigSettings prints settings as an aligned table of name, value and source
+func printConfigSettings(w io.Writer, settings []configSetting) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, setting := range settings {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t(%s)\n", setting.Name, formatSettingValue(setting.Value), setting.Source)
+	}
+	_ = tw.Flush()
+}
+
+// formatSettingValue renders a setting value for the text output
+func formatSettingValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return "(none)"
+		}
+		return v
+	case []string:
+		if len(v) == 0 {
+			return "(none)"
+		}
+		return strings.Join(v, ", ")
+	case map[string]string:
+		if len(v) == 0 {
+			return "(none)"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, key+"="+v[key])
+		}
+		return strings.Join(pairs, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,63 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type effectiveSetting struct {
+	Name   string `json:"name"`
+	Value  any    `json:"value"`
+	Source string `json:"source"`
+}
+
+func runConfigJSON(t *testing.T, args ...string) map[string]effectiveSetting {
+	t.Helper()
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ConfigCommand(append([]string{"--format", "json"}, args...))
+	})
+	require.NoError(t, err)
+
+	var settings []effectiveSetting
+	require.NoError(t, json.Unmarshal([]byte(output), &settings), "stdout should only contain the JSON settings: %s", output)
+	byName := map[string]effectiveSetting{}
+	for _, setting := range settings {
+		byName[setting.Name] = setting
+	}
+	return byName
+}
+
+func TestConfigCommand_Sources(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("https_proxy", "")
+
+	settings := runConfigJSON(t)
+	assert.Equal(t, effectiveSetting{Name: "registry", Value: commands.DefaultRegistryURL, Source: "default (not logged in)"}, settings["registry"])
+	assert.Equal(t, effectiveSetting{Name: "proxy", Value: "", Source: "default"}, settings["proxy"])
+	assert.Equal(t, effectiveSetting{Name: "apiPrefix", Value: "/v0/", Source: "default"}, settings["apiPrefix"])
+
+	t.Setenv("HTTPS_PROXY", "http://proxy.example.com:3128")
+	SetupTestToken(t, "https://staging.example.com", "test-token")
+	SetupTestConfig(t, commands.PublisherConfig{APIPrefix: "api/v0", NoPrerelease: true})
+
+	settings = runConfigJSON(t, "--timeout", "5s")
+	assert.Equal(t, effectiveSetting{Name: "proxy", Value: "http://proxy.example.com:3128", Source: "env HTTPS_PROXY"}, settings["proxy"])
+	assert.Equal(t, effectiveSetting{Name: "registry", Value: "https://staging.example.com", Source: "token file"}, settings["registry"])
+	assert.Equal(t, effectiveSetting{Name: "apiPrefix", Value: "/api/v0/", Source: "config file"}, settings["apiPrefix"])
+	assert.Equal(t, effectiveSetting{Name: "noPrerelease", Value: true, Source: "config file"}, settings["noPrerelease"])
+	assert.Equal(t, effectiveSetting{Name: "timeout", Value: "5s", Source: "flag --timeout"}, settings["timeout"])
+	assert.Equal(t, effectiveSetting{Name: "registryTimeout", Value: "2m0s", Source: "default"}, settings["registryTimeout"])
+
+	// Flags take precedence over the environment, the config file and the token file
+	settings = runConfigJSON(t, "--proxy", "http://flag-proxy.example.com", "--registry", "https://other.example.com/", "--api-prefix", "/")
+	assert.Equal(t, effectiveSetting{Name: "proxy", Value: "http://flag-proxy.example.com", Source: "flag --proxy"}, settings["proxy"])
+	assert.Equal(t, effectiveSetting{Name: "registry", Value: "https://other.example.com", Source: "flag --registry"}, settings["registry"])
+	assert.Equal(t, effectiveSetting{Name: "apiPrefix", Value: "/", Source: "flag --api-prefix"}, settings["apiPrefix"])
+}
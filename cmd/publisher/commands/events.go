@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Types of the events in a batch validation event stream
+const (
+	eventStarted       = "started"
+	eventItemCompleted = "item-completed"
+	eventFinished      = "finished"
+)
+
+// Outcomes of a single item in a batch validation event stream
+const (
+	outcomeValid       = "valid"
+	outcomeInvalid     = "invalid"
+	outcomeUnreadable  = "unreadable"
+	outcomeFetchFailed = "fetch-failed"
+)
+
+// progressEvent is one line of the event stream written by batch validation with
+// --format events or --events-fd. The counts are running totals at the time of the event.
+type progressEvent struct {
+	Type      string `json:"type"`
+	File      string `json:"file,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Outcome   string `json:"outcome,omitempty"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	Valid     int    `json:"valid"`
+	Invalid   int    `json:"invalid"`
+	Failed    int    `json:"failed"`
+}
+
+// eventStream writes progress events as JSON lines. Its methods are safe for concurrent
+// use, and do nothing on a nil stream so callers need not check whether events are enabled.
+type eventStream struct {
+	mu     sync.Mutex
+	enc    *json.Encoder
+	counts progressEvent
+}
+
+func newEventStream(w io.Writer) *eventStream {
+	return &eventStream{enc: json.NewEncoder(w)}
+}
+
+// started reports that total items are about to be validated
+func (s *eventStream) started(total int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts.Total = total
+	s.emit(progressEvent{Type: eventStarted})
+}
+
+// itemCompleted reports the outcome of one item. name is set for lockfile entries.
+func (s *eventStream) itemCompleted(file, name, outcome string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts.Completed++
+	switch outcome {
+	case outcomeValid:
+		s.counts.Valid++
+	case outcomeInvalid:
+		s.counts.Invalid++
+	default:
+		s.counts.Failed++
+	}
+	s.emit(progressEvent{Type: eventItemCompleted, File: file, Name: name, Outcome: outcome})
+}
+
+// finished reports that every item has been validated
+func (s *eventStream) finished() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emit(progressEvent{Type: eventFinished})
+}
+
+// emit writes event with the current counts. The caller must hold s.mu.
+func (s *eventStream) emit(event progressEvent) {
+	event.Total = s.counts.Total
+	event.Completed = s.counts.Completed
+	event.Valid = s.counts.Valid
+	event.Invalid = s.counts.Invalid
+	event.Failed = s.counts.Failed
+	_ = s.enc.Encode(event)
+}
+
+// batchOutput is where directory and lockfile validation write their human-readable
+// report and, if enabled, their event stream, merged SARIF report and per-item reports
+type batchOutput struct {
+	text    io.Writer
+	events  *eventStream
+	report  *sarifReport
+	reports *reportDir
+	// summaryOnly leaves valid items out of the human-readable report
+	summaryOnly bool
+}
+
+// openBatchOutput returns the output selected by --format and --events-fd. The returned
+// function closes the events file descriptor, so a reader of it sees the end of the stream.
+func (o *validateOptions) openBatchOutput() (batchOutput, func(), error) {
+	out := batchOutput{text: os.Stdout, summaryOnly: o.summaryOnly}
+	if o.format == formatSARIF {
+		// The SARIF report is written once every item has been validated
+		out = batchOutput{text: io.Discard, report: newSARIFReport()}
+	}
+	if o.outputDir != "" {
+		out.reports = &reportDir{dir: o.outputDir, sarif: o.format == formatSARIF}
+	}
+
+	switch {
+	case o.format == formatEvents:
+		return batchOutput{text: io.Discard, events: newEventStream(os.Stdout), reports: out.reports}, func() {}, nil
+	case o.eventsFD > 0:
+		f, closeEvents, err := openEventsFD(o.eventsFD)
+		if err != nil {
+			return batchOutput{}, nil, err
+		}
+		out.events = newEventStream(f)
+		return out, closeEvents, nil
+	default:
+		return out, func() {}, nil
+	}
+}
+
+// openEventsFD returns the file for --events-fd and a function that closes it. The
+// standard streams are used as they are and left open.
+func openEventsFD(fd int) (*os.File, func(), error) {
+	switch fd {
+	case 1:
+		return os.Stdout, func() {}, nil
+	case 2:
+		return os.Stderr, func() {}, nil
+	}
+	f := os.NewFile(uintptr(fd), fmt.Sprintf("fd %d", fd))
+	if _, err := f.Stat(); err != nil {
+		return nil, nil, fmt.Errorf("invalid --events-fd %d: %w", fd, err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+// withBatchOutput runs validate with the output selected by --format and --events-fd,
+// then writes the merged SARIF report if one was requested
+func (o *validateOptions) withBatchOutput(validate func(out batchOutput) error) error {
+	out, closeEvents, err := o.openBatchOutput()
+	if err != nil {
+		return err
+	}
+	defer closeEvents()
+
+	err = validate(out)
+	if out.report != nil {
+		if writeErr := o.writeSARIF(out.report); writeErr != nil {
+			return writeErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+package commands_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// progressEvent mirrors the events written by --format events and --events-fd
+type progressEvent struct {
+	Type      string `json:"type"`
+	File      string `json:"file"`
+	Outcome   string `json:"outcome"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	Valid     int    `json:"valid"`
+	Invalid   int    `json:"invalid"`
+	Failed    int    `json:"failed"`
+}
+
+// decodeEvents parses one event per line of r
+func decodeEvents(t *testing.T, r io.Reader) []progressEvent {
+	t.Helper()
+
+	var events []progressEvent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var event progressEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event), "every line should be an event: %s", scanner.Text())
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+// assertEventSequence checks that events are started, one item-completed per file and
+// finished, with counts adding up
+func assertEventSequence(t *testing.T, events []progressEvent, total int) {
+	t.Helper()
+
+	require.Len(t, events, total+2)
+	assert.Equal(t, "started", events[0].Type)
+	assert.Equal(t, total, events[0].Total)
+	assert.Equal(t, 0, events[0].Completed)
+	for i, event := range events[1 : total+1] {
+		assert.Equal(t, "item-completed", event.Type)
+		assert.Equal(t, i+1, event.Completed)
+		assert.Equal(t, event.Completed, event.Valid+event.Invalid+event.Failed)
+		assert.NotEmpty(t, event.File)
+		assert.Contains(t, []string{"valid", "invalid", "unreadable"}, event.Outcome)
+	}
+	finished := events[total+1]
+	assert.Equal(t, "finished", finished.Type)
+	assert.Equal(t, total, finished.Completed)
+}
+
+func TestValidateCommand_Events(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	dir := createServerJSONTree(t, 10)
+
+	t.Run("format events", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "events", dir})
+		})
+		require.Error(t, err)
+
+		events := decodeEvents(t, strings.NewReader(output))
+		assertEventSequence(t, events, 10)
+		finished := events[len(events)-1]
+		assert.Equal(t, 8, finished.Valid)
+		assert.Equal(t, 1, finished.Invalid)
+		assert.Equal(t, 1, finished.Failed)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.ValidateCommand([]string{"--offline", "--format", "events"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported when validating a directory or --lockfile")
+	})
+
+	t.Run("invalid fd", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "--events-fd", "987", dir})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --events-fd 987")
+	})
+}
@@ -0,0 +1,43 @@
+//go:build unix
+
+package commands_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommand_EventsFD(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	dir := createServerJSONTree(t, 10)
+
+	// The write end is a raw descriptor handed over to the command, which closes it
+	fds := make([]int, 2)
+	require.NoError(t, syscall.Pipe(fds))
+	r := os.NewFile(uintptr(fds[0]), "events")
+	defer r.Close()
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--events-fd", strconv.Itoa(fds[1]), dir})
+	})
+	require.Error(t, err)
+	assert.Contains(t, output, "8 valid, 1 invalid, 1 unreadable", "the text report should still be printed")
+
+	// Closing the descriptor ends the stream
+	assertEventSequence(t, decodeEvents(t, bytes.NewReader(<-done)), 10)
+}
@@ -0,0 +1,30 @@
+package commands
+
+import "errors"
+
+// ExitPartialSuccess is the exit code of a batch publish where some servers were
+// published and others were not. Other failures exit with 1.
+const ExitPartialSuccess = 5
+
+// ExitError is a command error that should make the publisher exit with Code instead of 1
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string { return e.Err.Error() }
+
+func (e *ExitError) Unwrap() error { return e.Err }
+
+// ExitCode returns the exit code for the error returned by a command: 0 for nil, the code
+// of an ExitError, and 1 otherwise
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return 1
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// extractServerJSON returns the object stored under key at the top level of data, such as
+// the server metadata some projects embed in package.json under "mcp"
+func extractServerJSON(filename string, data []byte, key string) ([]byte, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid JSON in %s: %w", filename, err)
+	}
+
+	nested, ok := document[key]
+	if !ok {
+		return nil, fmt.Errorf("%s has no %q key to extract server.json from", filename, key)
+	}
+	if trimmed := bytes.TrimSpace(nested); len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, fmt.Errorf("%q in %s is not a JSON object", key, filename)
+	}
+
+	return nested, nil
+}
@@ -0,0 +1,69 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePackageJSON writes a package.json to the current directory with extra top-level fields
+func writePackageJSON(t *testing.T, fields map[string]any) {
+	t.Helper()
+
+	document := map[string]any{"name": "@example/test-server", "version": "1.0.0"}
+	for key, value := range fields {
+		document[key] = value
+	}
+	data, err := json.MarshalIndent(document, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile("package.json", data, 0600))
+}
+
+func TestValidateCommand_ExtractKey(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+
+	t.Run("valid embedded server.json", func(t *testing.T) {
+		writePackageJSON(t, map[string]any{"mcp": testServerJSON()})
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--extract-key", "mcp", "package.json"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "✅ server.json is valid")
+	})
+
+	t.Run("embedded server.json is validated", func(t *testing.T) {
+		writePackageJSON(t, map[string]any{"mcp": testServerJSON(withRangeVersion)})
+
+		result, err := runValidateJSON(t, "--extract-key", "mcp", "package.json")
+		require.Error(t, err)
+		assert.Equal(t, []string{"version-looks-like-range"}, issueReferences(result.Issues))
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		writePackageJSON(t, nil)
+
+		err := commands.ValidateCommand([]string{"--offline", "--extract-key", "mcp", "package.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `package.json has no "mcp" key`)
+	})
+
+	t.Run("key is not an object", func(t *testing.T) {
+		writePackageJSON(t, map[string]any{"mcp": "com.example/test-server"})
+
+		err := commands.ValidateCommand([]string{"--offline", "--extract-key", "mcp", "package.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"mcp" in package.json is not a JSON object`)
+	})
+
+	t.Run("not combinable with lockfile", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--extract-key", "mcp", "--lockfile", "servers.lock.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--extract-key cannot be combined with --lockfile")
+	})
+}
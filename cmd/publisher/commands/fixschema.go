@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// fixSchemaField rewrites the value of the top-level $schema field of serverFile to the
+// current schema URL for validate --fix-schema. Every other byte of the file, including
+// indentation and key order, is left as it was. Unlike migrate, nothing else is changed.
+func fixSchemaField(serverFile string, progress io.Writer) error {
+	data, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%s not found, please check the file path", serverFile)
+		}
+		return fmt.Errorf("failed to read %s: %w", serverFile, err)
+	}
+	input, err := prepareJSONInput(serverFile, data)
+	if err != nil {
+		return err
+	}
+	// A byte order mark stripped by prepareJSONInput is kept in front of the document
+	offset := len(data) - len(input)
+
+	start, end, err := schemaValueSpan(input)
+	if err != nil {
+		return fmt.Errorf("cannot fix $schema in %s: %w", serverFile, err)
+	}
+	var current string
+	if err := json.Unmarshal(input[start:end], &current); err != nil {
+		return fmt.Errorf("cannot fix $schema in %s: $schema is not a string", serverFile)
+	}
+	if current == model.CurrentSchemaURL {
+		_, _ = fmt.Fprintf(progress, "✓ $schema in %s is already %s\n", serverFile, model.CurrentSchemaURL)
+		return nil
+	}
+
+	value, err := json.Marshal(model.CurrentSchemaURL)
+	if err != nil {
+		return fmt.Errorf("failed to encode $schema: %w", err)
+	}
+	fixed := make([]byte, 0, len(data)+len(value))
+	fixed = append(fixed, data[:offset+start]...)
+	fixed = append(fixed, value...)
+	fixed = append(fixed, data[offset+end:]...)
+
+	info, err := os.Stat(serverFile)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", serverFile, err)
+	}
+	if err := os.WriteFile(serverFile, fixed, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", serverFile, err)
+	}
+	_, _ = fmt.Fprintf(progress, "✓ Updated $schema in %s from %s to %s\n", serverFile, current, model.CurrentSchemaURL)
+	return nil
+}
+
+// schemaValueSpan returns the byte range of the value of the top-level $schema field of
+// data, a JSON object
+func schemaValueSpan(data []byte) (int, int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return 0, 0, fmt.Errorf("invalid JSON: %w", err)
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid JSON: %w", err)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return 0, 0, fmt.Errorf("invalid JSON: %w", err)
+		}
+		if key == "$schema" {
+			// The decoder stops right after the value, whose raw bytes are kept as they were
+			end := int(dec.InputOffset())
+			return end - len(value), end, nil
+		}
+	}
+	return 0, 0, errors.New("there is no $schema field; add one or run 'mcp-publisher migrate'")
+}
@@ -0,0 +1,97 @@
+package commands_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deprecatedSchemaServerJSON is a valid server.json with an older $schema and formatting
+// that a re-encoding would not reproduce
+const deprecatedSchemaServerJSON = `{
+	"name":    "com.example/test-server",
+	"description": "A test server",
+	"$schema": "https://static.modelcontextprotocol.io/schemas/2025-10-17/server.schema.json",
+	"version": "1.0.0",
+	"repository": {"url": "https://github.com/example/test-server", "source": "github"},
+	"websiteUrl": "https://example.com/test-server"
+}
+`
+
+func TestValidateCommand_FixSchema(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("server.json", []byte(deprecatedSchemaServerJSON), 0600))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--fix-schema", "server.json"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "✓ Updated $schema in server.json from https://static.modelcontextprotocol.io/schemas/2025-10-17/server.schema.json to "+model.CurrentSchemaURL)
+	assert.Contains(t, output, "✅ server.json is valid")
+
+	fixed, err := os.ReadFile("server.json")
+	require.NoError(t, err)
+	before := strings.Split(deprecatedSchemaServerJSON, "\n")
+	after := strings.Split(string(fixed), "\n")
+	require.Len(t, after, len(before))
+	for i := range before {
+		if i == 3 {
+			assert.Equal(t, `	"$schema": "`+model.CurrentSchemaURL+`",`, after[i])
+			continue
+		}
+		assert.Equal(t, before[i], after[i], "only the $schema line should change")
+	}
+
+	// Running it again leaves the file as it is
+	output = CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--fix-schema", "server.json"})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "✓ $schema in server.json is already "+model.CurrentSchemaURL)
+	unchanged, err := os.ReadFile("server.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(fixed), string(unchanged))
+}
+
+func TestValidateCommand_FixSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		args          []string
+		expectedError string
+	}{
+		{"no $schema", `{"name": "com.example/test-server"}`, nil, "there is no $schema field"},
+		{"non-string $schema", `{"$schema": 1, "name": "com.example/test-server"}`, nil, "$schema is not a string"},
+		{"directory", "", []string{"."}, "does not support"},
+		{"with --lockfile", "", []string{"--lockfile", "servers.lock"}, "--fix-schema cannot be combined with --lockfile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+			if tt.content != "" {
+				require.NoError(t, os.WriteFile("server.json", []byte(tt.content), 0600))
+			}
+
+			args := append([]string{"--offline", "--fix-schema"}, tt.args...)
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.ValidateCommand(args)
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+
+			if tt.content != "" {
+				data, readErr := os.ReadFile("server.json")
+				require.NoError(t, readErr)
+				assert.Equal(t, tt.content, string(data), "the file should not be changed")
+			}
+		})
+	}
+}
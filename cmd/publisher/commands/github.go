@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// printGitHubAnnotations prints issues as GitHub Actions workflow commands so that they are
+// shown inline on the file. source is the validated file, used to find the line of each
+// issue's JSON path; pathPrefix locates the server.json inside it when it was extracted
+// with --extract-key.
+func printGitHubAnnotations(issues []validators.ValidationIssue, file string, source []byte, pathPrefix string) {
+	lines := jsonPathLines(source)
+
+	for _, issue := range issues {
+		command := "error"
+		switch issue.Severity {
+		case validators.ValidationIssueSeverityWarning:
+			command = "warning"
+		case validators.ValidationIssueSeverityInfo:
+			command = "notice"
+		}
+
+		properties := "file=" + escapeWorkflowProperty(file)
+		if line := issueLine(lines, joinJSONPath(pathPrefix, issue.Path)); line > 0 {
+			properties += ",line=" + strconv.Itoa(line)
+		}
+		if issue.Reference != "" {
+			properties += ",title=" + escapeWorkflowProperty(issue.Reference)
+		}
+
+		message := issue.Message
+		if issue.Path != "" {
+			message = issue.Path + ": " + message
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "::%s %s::%s\n", command, properties, escapeWorkflowData(message))
+	}
+}
+
+// annotationSource returns the contents of serverFile for locating issue lines, or nil if
+// it is not a readable local file
+func annotationSource(serverFile string) []byte {
+	if isOCIReference(serverFile) {
+		return nil
+	}
+	data, err := os.ReadFile(serverFile)
+	if err != nil {
+		return nil
+	}
+	if data, err = prepareJSONInput(serverFile, data); err != nil {
+		return nil
+	}
+	return data
+}
+
+// escapeWorkflowData escapes a workflow command message
+func escapeWorkflowData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeWorkflowProperty escapes a workflow command property value
+func escapeWorkflowProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// issueLine returns the line of path, or of its closest ancestor with a known line.
+// It returns 0 if none is known.
+func issueLine(lines map[string]int, path string) int {
+	for path != "" {
+		if line, ok := lines[path]; ok {
+			return line
+		}
+		path = path[:max(strings.LastIndexByte(path, '/'), 0)]
+	}
+	return 0
+}
+
+// joinJSONPath locates a JSON pointer inside the object under the top-level key prefix
+func joinJSONPath(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	return validators.JSONPointerChild("", prefix) + path
+}
+
+// jsonPathLines maps the JSON pointer of every value in a JSON document (e.g.
+// /packages/0/version) to the line it starts on. It is best effort: on malformed input the
+// lines found so far are returned.
+func jsonPathLines(data []byte) map[string]int {
+	lines := map[string]int{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	_ = recordJSONPathLines(dec, data, "", lines)
+	return lines
+}
+
+func recordJSONPathLines(dec *json.Decoder, data []byte, path string, lines map[string]int) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	switch delim {
+	case '{':
+		for dec.More() {
+			line := lineOfNextToken(data, dec.InputOffset())
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyTok.(string)
+			child := validators.JSONPointerChild(path, key)
+			lines[child] = line
+			if err := recordJSONPathLines(dec, data, child, lines); err != nil {
+				return err
+			}
+		}
+	case '[':
+		for i := 0; dec.More(); i++ {
+			child := validators.JSONPointerChild(path, strconv.Itoa(i))
+			lines[child] = lineOfNextToken(data, dec.InputOffset())
+			if err := recordJSONPathLines(dec, data, child, lines); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Consume the closing delimiter
+	_, err = dec.Token()
+	return err
+}
+
+// lineOfNextToken returns the 1-based line of the first token at or after offset
+func lineOfNextToken(data []byte, offset int64) int {
+	pos := int(offset)
+	for pos < len(data) && strings.IndexByte(" \t\r\n,:", data[pos]) >= 0 {
+		pos++
+	}
+	return bytes.Count(data[:pos], []byte("\n")) + 1
+}
@@ -0,0 +1,80 @@
+package commands_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lineContaining returns the 1-based line of the first line of file containing text
+func lineContaining(t *testing.T, file, text string) int {
+	t.Helper()
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	for i, line := range strings.Split(string(data), "\n") {
+		if strings.Contains(line, text) {
+			return i + 1
+		}
+	}
+	t.Fatalf("%q not found in %s", text, file)
+	return 0
+}
+
+// workflowCommands returns the lines of output that are GitHub Actions workflow commands
+func workflowCommands(output string) []string {
+	var cmds []string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "::") {
+			cmds = append(cmds, line)
+		}
+	}
+	return cmds
+}
+
+func TestValidateCommand_GitHubFormat(t *testing.T) {
+	t.Run("annotations", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "github"})
+		})
+		require.Error(t, err)
+
+		lines := workflowCommands(output)
+		require.Len(t, lines, 2, output)
+		assert.Equal(t,
+			fmt.Sprintf("::error file=server.json,line=%d,title=version-looks-like-range::/version: version must be a specific version, not a range: \"^1.0.0\"", lineContaining(t, "server.json", `"version": "^1.0.0"`)),
+			lines[0])
+		assert.Regexp(t, `^::warning file=server\.json,line=\d+,title=repository-package-mismatch::/packages/0/`, lines[1])
+	})
+
+	t.Run("valid document", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "github"})
+		})
+		require.NoError(t, err)
+		assert.Empty(t, workflowCommands(output))
+	})
+
+	t.Run("lines inside an extracted key", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+		writePackageJSON(t, map[string]any{"mcp": testServerJSON(withRangeVersion)})
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "github", "--extract-key", "mcp", "package.json"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, output, fmt.Sprintf("::error file=package.json,line=%d,title=version-looks-like-range::", lineContaining(t, "package.json", `"version": "^1.0.0"`)))
+	})
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// defaultGitHubRepoPath is the file of a GitHub repository read as server.json without --path
+const defaultGitHubRepoPath = "server.json"
+
+// githubRawBaseURL serves the raw contents of GitHub repositories. HEAD as the ref selects
+// the default branch.
+var githubRawBaseURL = "https://raw.githubusercontent.com"
+
+// serverGitHubRepo holds the --path flag, the file of a GitHub repository URL source that is
+// read as server.json. Community submissions are often reviewed from their repository.
+type serverGitHubRepo struct {
+	path string
+}
+
+// register adds the GitHub repository flag to fs
+func (g *serverGitHubRepo) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.path, "path", "", "Read server.json from this path of a GitHub repository URL (default: server.json)")
+}
+
+// parseGitHubRepoURL returns the owner and repository of a https://github.com/owner/repo
+// URL, optionally ending in .git or a slash. It reports false for any other source.
+func parseGitHubRepoURL(source string) (string, string, bool) {
+	u, err := url.Parse(source)
+	if err != nil || u.Scheme != "https" || (u.Host != "github.com" && u.Host != "www.github.com") || u.RawQuery != "" || u.Fragment != "" {
+		return "", "", false
+	}
+	owner, repo, found := strings.Cut(strings.Trim(u.Path, "/"), "/")
+	repo = strings.TrimSuffix(repo, ".git")
+	if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", false
+	}
+	return owner, repo, true
+}
+
+// isGitHubRepoURL reports whether source is a GitHub repository URL to read server.json from
+func isGitHubRepoURL(source string) bool {
+	_, _, ok := parseGitHubRepoURL(source)
+	return ok
+}
+
+// check rejects --path for a source that is not a GitHub repository URL, where it would be
+// ignored
+func (g *serverGitHubRepo) check(source string) error {
+	if g.path != "" && !isGitHubRepoURL(source) {
+		return fmt.Errorf("--path requires a GitHub repository URL such as https://github.com/owner/repo, got %s", source)
+	}
+	return nil
+}
+
+// read fetches server.json, or the file at --path, from the default branch of the GitHub
+// repository at source through the raw content endpoint
+func (g *serverGitHubRepo) read(client *http.Client, source string) ([]byte, error) {
+	owner, repo, _ := parseGitHubRepoURL(source)
+	file := defaultGitHubRepoPath
+	if g.path != "" {
+		file = path.Clean(strings.TrimPrefix(g.path, "/"))
+		if !localArchivePath(file) || file == "." {
+			return nil, fmt.Errorf("invalid --path %q: must be a file path inside the repository", g.path)
+		}
+	}
+
+	rawURL, err := url.JoinPath(githubRawBaseURL, owner, repo, "HEAD", file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub repository %s: %w", source, err)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s from %s: %w", file, source, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s not found on the default branch of %s; use --path to point at the server.json inside the repository", file, source)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching %s from %s: server returned status %d", file, source, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxServerJSONSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s from %s: %w", file, source, err)
+	}
+	if len(data) > maxServerJSONSize {
+		return nil, fmt.Errorf("%s in %s is more than the limit of %d bytes", file, source, maxServerJSONSize)
+	}
+	return data, nil
+}
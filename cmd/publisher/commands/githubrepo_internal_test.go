@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitHubRepoURL(t *testing.T) {
+	tests := []struct {
+		source string
+		owner  string
+		repo   string
+		ok     bool
+	}{
+		{"https://github.com/owner/repo", "owner", "repo", true},
+		{"https://github.com/owner/repo/", "owner", "repo", true},
+		{"https://github.com/owner/repo.git", "owner", "repo", true},
+		{"https://www.github.com/owner/repo", "owner", "repo", true},
+		{"https://github.com/owner", "", "", false},
+		{"https://github.com/owner/repo/tree/main", "", "", false},
+		{"http://github.com/owner/repo", "", "", false},
+		{"https://gitlab.com/owner/repo", "", "", false},
+		{"https://github.com/owner/repo?tab=readme", "", "", false},
+		{"server.json", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			owner, repo, ok := parseGitHubRepoURL(tt.source)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.owner, owner)
+			assert.Equal(t, tt.repo, repo)
+		})
+	}
+}
+
+// setupMockGitHubRaw serves files as the raw content of the default branch of
+// github.com/owner/repo and answers 404 for anything else
+func setupMockGitHubRaw(t *testing.T, files map[string]apiv0.ServerJSON) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverJSON, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(serverJSON)
+	}))
+	t.Cleanup(server.Close)
+
+	previous := githubRawBaseURL
+	githubRawBaseURL = server.URL
+	t.Cleanup(func() { githubRawBaseURL = previous })
+}
+
+func TestValidateCommand_GitHubRepo(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	serverJSON := func(name string) apiv0.ServerJSON {
+		return apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        name,
+			Description: "A test server",
+			Version:     "1.0.0",
+			Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+		}
+	}
+	setupMockGitHubRaw(t, map[string]apiv0.ServerJSON{
+		"/owner/repo/HEAD/server.json":                 serverJSON("io.github.owner/repo"),
+		"/owner/repo/HEAD/servers/weather/server.json": serverJSON("io.github.owner/weather"),
+		"/owner/other/HEAD/server.json":                {Name: "io.github.owner/other"},
+	})
+
+	read := func(t *testing.T, args ...string) (*apiv0.ServerJSON, error) {
+		t.Helper()
+		opts, positional, err := parseValidateFlags(append([]string{"--offline"}, args...))
+		require.NoError(t, err)
+		_, parsed, err := readServerFile(http.DefaultClient, positional[0], opts)
+		return parsed, err
+	}
+
+	t.Run("default path", func(t *testing.T) {
+		parsed, err := read(t, "https://github.com/owner/repo.git")
+		require.NoError(t, err)
+		assert.Equal(t, "io.github.owner/repo", parsed.Name)
+		require.NoError(t, ValidateCommand([]string{"--offline", "https://github.com/owner/repo"}))
+	})
+
+	t.Run("--path", func(t *testing.T) {
+		parsed, err := read(t, "--path", "servers/weather/server.json", "https://github.com/owner/repo")
+		require.NoError(t, err)
+		assert.Equal(t, "io.github.owner/weather", parsed.Name)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := read(t, "--path", "missing/server.json", "https://github.com/owner/repo")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing/server.json not found on the default branch of https://github.com/owner/repo; use --path")
+
+		_, err = read(t, "https://github.com/owner/empty")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server.json not found on the default branch of https://github.com/owner/empty")
+	})
+
+	t.Run("invalid server.json", func(t *testing.T) {
+		require.Error(t, ValidateCommand([]string{"--offline", "https://github.com/owner/other"}))
+	})
+
+	t.Run("invalid --path", func(t *testing.T) {
+		_, err := read(t, "--path", "../secrets.json", "https://github.com/owner/repo")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --path")
+
+		_, err = read(t, "--path", "server.json", "server.json")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--path requires a GitHub repository URL")
+	})
+}
@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unsetHome makes os.UserHomeDir fail, as in minimal containers without HOME
+func unsetHome(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", "")
+	t.Setenv("USERPROFILE", "")
+}
+
+func TestPublishCommand_NoHomeDirectory(t *testing.T) {
+	var auth string
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	tokenPath := SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+	unsetHome(t)
+
+	t.Run("explains how to recover", func(t *testing.T) {
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not determine the home directory")
+		assert.Contains(t, err.Error(), "Set HOME or pass --token-file")
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--token-file", tokenPath})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer test-token", auth)
+		assert.Contains(t, output, "Publishing to "+server.URL)
+	})
+
+	t.Run("missing token file", func(t *testing.T) {
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--token-file", tokenPath + ".missing"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "token file "+tokenPath+".missing not found")
+	})
+}
+
+func TestValidateCommand_NoHomeDirectory(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+	unsetHome(t)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline"})
+	})
+	require.NoError(t, err, "settings should fall back to their defaults")
+	assert.Contains(t, output, "✅ server.json is valid")
+}
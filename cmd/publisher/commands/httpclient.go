@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// newHTTPClient returns the client used for requests to the registry.
+// By default proxies are taken from the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables. If proxyURL is set, all requests are sent through it instead.
+// Requests rejected with 429 Too Many Requests are retried after their Retry-After delay.
+// Concurrent requests share the client, which keeps enough idle connections per host for
+// each of them to be reused rather than reopened.
+func newHTTPClient(proxyURL string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.MaxIdleConnsPerHost = maxParallelValidate
+
+	if proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL: %s", proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(parsed)
+	}
+
+	return &http.Client{Transport: &retryTransport{next: transport, sleep: sleepContext}}, nil
+}
+
+// parseFlags parses args with fs, allowing flags to appear before or after positional
+// arguments. It returns the positional arguments in order.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,139 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unreachableRegistryURL is only reachable through the mock proxy
+const unreachableRegistryURL = "http://registry.invalid"
+
+// setupMockProxy starts an HTTP proxy that answers registry requests itself and
+// records the absolute URLs it was asked to forward.
+func setupMockProxy(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var proxied []string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		proxied = append(proxied, r.Method+" "+r.URL.String())
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v0/validate":
+			_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+		case "/v0/publish":
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+				Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(proxy.Close)
+
+	return proxy, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), proxied...)
+	}
+}
+
+func TestValidateCommand_Proxy(t *testing.T) {
+	proxy, proxied := setupMockProxy(t)
+	SetupTestToken(t, unreachableRegistryURL, "test-token")
+	CreateTestServerJSON(t, apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+	})
+
+	err := commands.ValidateCommand([]string{"--proxy", proxy.URL})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST " + unreachableRegistryURL + "/v0/validate"}, proxied())
+}
+
+func TestPublishCommand_Proxy(t *testing.T) {
+	proxy, proxied := setupMockProxy(t)
+	SetupTestToken(t, unreachableRegistryURL, "test-token")
+	CreateTestServerJSON(t, apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+	})
+
+	err := commands.PublishCommand([]string{"--proxy", proxy.URL, "server.json"})
+	require.NoError(t, err)
+	assert.Contains(t, proxied(), "POST "+unreachableRegistryURL+"/v0/publish")
+}
+
+func TestValidateCommand_InvalidProxy(t *testing.T) {
+	server := SetupMockRegistryServer(t, nil, nil)
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+	})
+
+	err := commands.ValidateCommand([]string{"--proxy", "not a url"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid proxy URL")
+}
+
+func TestCommands_RetryRateLimitedRequests(t *testing.T) {
+	// Each endpoint rejects its first request with 429 and Retry-After: 0
+	var publishes, validations int
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		publishes++
+		if publishes == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		var serverJSON apiv0.ServerJSON
+		_ = json.NewDecoder(r.Body).Decode(&serverJSON)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: serverJSON})
+	}, func(w http.ResponseWriter, _ *http.Request) {
+		validations++
+		if validations == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	CaptureStdout(t, func() {
+		err = commands.PublishCommand(nil)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, publishes)
+
+	CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--registry", server.URL})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, validations)
+}
@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Input formats of --input-format
+const (
+	inputFormatJSON     = "json"
+	inputFormatYAML     = "yaml"
+	inputFormatJSONL    = "jsonl"
+	inputFormatTemplate = "template"
+)
+
+// stdinSource is the file name that reads server.json from stdin
+const stdinSource = "-"
+
+// inputFormatsByExtension are the formats detected from a file extension without
+// --input-format. Any other file is read as JSON.
+var inputFormatsByExtension = map[string]string{
+	".yaml":           inputFormatYAML,
+	".yml":            inputFormatYAML,
+	".jsonl":          inputFormatJSONL,
+	templateExtension: inputFormatTemplate,
+}
+
+// inputFormat holds the --input-format flag, which selects how server.json is parsed
+// instead of detecting it from the file extension. That is needed for stdin and for
+// files whose name does not tell.
+type inputFormat struct {
+	name string
+}
+
+// register adds the input format flag to fs
+func (f *inputFormat) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.name, "input-format", "", "Parse server.json as json, yaml, jsonl or template instead of detecting it from the file extension")
+}
+
+// init checks the input format and applies it to tmpl: template renders every file as a
+// template, and any other format turns off rendering files with the .tmpl extension
+func (f *inputFormat) init(tmpl *serverTemplate) error {
+	if f.name == "" {
+		return nil
+	}
+	if !slices.Contains([]string{inputFormatJSON, inputFormatYAML, inputFormatJSONL, inputFormatTemplate}, f.name) {
+		return fmt.Errorf("invalid input-format '%s'. Must be one of: %s, %s, %s, %s", f.name, inputFormatJSON, inputFormatYAML, inputFormatJSONL, inputFormatTemplate)
+	}
+	if f.name == inputFormatTemplate {
+		tmpl.enabled = true
+		return nil
+	}
+	if tmpl.enabled {
+		return fmt.Errorf("--template cannot be combined with --input-format %s", f.name)
+	}
+	tmpl.ignoreExtension = true
+	return nil
+}
+
+// detect returns the format of filename: --input-format if given, otherwise the format
+// of its extension, and JSON for other files and stdin
+func (f *inputFormat) detect(filename string) string {
+	if f.name != "" {
+		return f.name
+	}
+	if format, ok := inputFormatsByExtension[strings.ToLower(filepath.Ext(filename))]; ok {
+		return format
+	}
+	return inputFormatJSON
+}
+
+// decode converts data, read from filename, to the JSON of the server.json it holds.
+// JSON, including a rendered template, is returned unchanged.
+func (f *inputFormat) decode(filename string, data []byte) ([]byte, error) {
+	switch f.detect(filename) {
+	case inputFormatYAML:
+		return yamlToJSON(filename, data)
+	case inputFormatJSONL:
+		return jsonLinesRecord(filename, data)
+	default:
+		return data, nil
+	}
+}
+
+// yamlToJSON converts a YAML document holding a server.json to JSON. A stream of several
+// documents is rejected, as only one server.json is read per file.
+func yamlToJSON(filename string, data []byte) ([]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var document any
+	if err := dec.Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("invalid YAML in %s: the file is empty", filename)
+		}
+		return nil, fmt.Errorf("invalid YAML in %s: %w", filename, err)
+	}
+	var extra any
+	if err := dec.Decode(&extra); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid YAML in %s: expected a single document", filename)
+	}
+
+	converted, err := jsonCompatible(document)
+	if err != nil {
+		return nil, fmt.Errorf("invalid YAML in %s: %w", filename, err)
+	}
+	return json.Marshal(converted)
+}
+
+// jsonCompatible returns value, as decoded from YAML, with the types JSON cannot represent
+// rejected: mappings with keys that are not strings
+func jsonCompatible(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = converted
+		}
+		return v, nil
+	case map[any]any:
+		return nil, errors.New("mapping keys must be strings")
+	case []any:
+		for i, item := range v {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// jsonLinesRecord returns the single record of a JSON Lines file. Blank lines are ignored;
+// several records are rejected, as only one server.json is read per file.
+func jsonLinesRecord(filename string, data []byte) ([]byte, error) {
+	var record []byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, maxServerJSONSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		if record != nil {
+			return nil, fmt.Errorf("invalid JSON Lines in %s: line %d starts a second record, but only one server.json is read per file", filename, line)
+		}
+		record = slices.Clone(scanner.Bytes())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid JSON Lines in %s: %w", filename, err)
+	}
+	if record == nil {
+		return nil, fmt.Errorf("invalid JSON Lines in %s: the file has no records", filename)
+	}
+	return record, nil
+}
+
+// readStdin reads a server.json piped to stdin, up to maxServerJSONSize
+func readStdin() ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(os.Stdin, maxServerJSONSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server.json from stdin: %w", err)
+	}
+	if len(data) > maxServerJSONSize {
+		return nil, fmt.Errorf("server.json on stdin is larger than the limit of %d bytes", maxServerJSONSize)
+	}
+	return data, nil
+}
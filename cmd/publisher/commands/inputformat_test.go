@@ -0,0 +1,151 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// serverYAML returns serverJSON as a YAML document
+func serverYAML(t *testing.T, serverJSON apiv0.ServerJSON) string {
+	t.Helper()
+	data, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	var document map[string]any
+	require.NoError(t, json.Unmarshal(data, &document))
+	out, err := yaml.Marshal(document)
+	require.NoError(t, err)
+	return string(out)
+}
+
+// writeServerFile writes data to name in a temporary directory and returns its path
+func writeServerFile(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+	return path
+}
+
+func TestValidateCommand_InputFormat(t *testing.T) {
+	valid, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	yamlDocument := serverYAML(t, testServerJSON())
+	template := `{"$schema": "` + testServerJSON().Schema + `", "name": "com.example/test-server", "description": "A test server", "version": {{printf "%q" .Version}}}`
+
+	tests := []struct {
+		name          string
+		file          string
+		data          string
+		args          []string
+		expectedError string
+	}{
+		{name: "yaml detected from the extension", file: "server.yaml", data: yamlDocument},
+		{name: "yml detected from the extension", file: "server.yml", data: yamlDocument},
+		{name: "jsonl detected from the extension", file: "server.jsonl", data: "\n" + string(valid) + "\n\n"},
+		{name: "yaml despite a json extension", file: "server.json", data: yamlDocument, args: []string{"--input-format", "yaml"}},
+		{name: "yaml without the flag is parsed as json", file: "server.json", data: yamlDocument, expectedError: "invalid"},
+		{name: "yaml despite a jsonl extension", file: "server.jsonl", data: yamlDocument, args: []string{"--input-format", "yaml"}},
+		{name: "jsonl despite a txt extension", file: "server.txt", data: string(valid) + "\n" + string(valid) + "\n", args: []string{"--input-format", "jsonl"}, expectedError: "line 2 starts a second record"},
+		{name: "json despite a yaml extension", file: "server.yaml", data: "# comment\n" + string(valid), args: []string{"--input-format", "json"}, expectedError: "invalid"},
+		{name: "template despite a json extension", file: "server.json", data: template, args: []string{"--input-format", "template", "--var", "Version=1.0.0"}},
+		{name: "tmpl extension not rendered as json", file: "server.json.tmpl", data: template, args: []string{"--input-format", "json"}, expectedError: "invalid"},
+		{name: "several yaml documents", file: "server.yaml", data: yamlDocument + "---\n" + yamlDocument, expectedError: "expected a single document"},
+		{name: "empty jsonl", file: "server.jsonl", data: "\n\n", expectedError: "the file has no records"},
+		{name: "unknown format", file: "server.json", data: string(valid), args: []string{"--input-format", "toml"}, expectedError: "invalid input-format 'toml'"},
+		{name: "template flag with another format", file: "server.json", data: string(valid), args: []string{"--input-format", "yaml", "--template"}, expectedError: "--template cannot be combined with --input-format yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeServerFile(t, tt.file, tt.data)
+			var err error
+			out := CaptureStdout(t, func() {
+				err = commands.ValidateCommand(append(append([]string{"--offline"}, tt.args...), path))
+			})
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err, out)
+			assert.Contains(t, out, "is valid")
+		})
+	}
+}
+
+func TestValidateCommand_Stdin(t *testing.T) {
+	valid, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+
+	t.Run("json by default", func(t *testing.T) {
+		SetStdin(t, string(valid))
+		result, err := runValidateJSON(t, "-")
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+	})
+
+	t.Run("yaml with the flag", func(t *testing.T) {
+		SetStdin(t, serverYAML(t, testServerJSON(withRangeVersion)))
+		result, err := runValidateJSON(t, "--input-format", "yaml", "-")
+		require.Error(t, err)
+		assert.Contains(t, issueReferences(result.Issues), "version-looks-like-range")
+	})
+
+	t.Run("yaml without the flag", func(t *testing.T) {
+		SetStdin(t, serverYAML(t, testServerJSON()))
+		err := commands.ValidateCommand([]string{"--offline", "-"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid")
+	})
+
+	t.Run("fix-schema", func(t *testing.T) {
+		SetStdin(t, string(valid))
+		err := commands.ValidateCommand([]string{"--offline", "--fix-schema", "-"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--fix-schema requires a server.json file in JSON format")
+	})
+}
+
+func TestPublishCommand_InputFormat(t *testing.T) {
+	var published apiv0.PublishRequest
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&published))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+
+	t.Run("yaml despite a json extension", func(t *testing.T) {
+		path := writeServerFile(t, "server.json", serverYAML(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "2.0.0" })))
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--input-format", "yaml", path})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2.0.0", published.Version)
+	})
+
+	t.Run("yaml from stdin", func(t *testing.T) {
+		SetStdin(t, serverYAML(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "3.0.0" })))
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--input-format", "yaml", "-"})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "3.0.0", published.Version)
+	})
+
+	t.Run("stdin with token-stdin", func(t *testing.T) {
+		SetStdin(t, "test-token\n")
+		err := commands.PublishCommand([]string{"--token-stdin", "-"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--token-stdin cannot be combined with reading server.json from stdin")
+	})
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// LintCommand scores a server.json against registry listing best practices and prints
+// suggestions to improve it. It is advisory: unlike validate, it does not check that the
+// file is valid and does not fail on a low score.
+func LintCommand(args []string) error {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	format := fs.String("format", formatText, "Output format: text or json")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *format != formatText && *format != formatJSON {
+		return fmt.Errorf("invalid format '%s'. Must be one of: %s, %s", *format, formatText, formatJSON)
+	}
+
+	serverFile := "server.json"
+	if len(positional) > 0 {
+		serverFile = positional[0]
+	}
+
+	data, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("server.json not found at %s", serverFile)
+		}
+		return fmt.Errorf("failed to read server.json: %w", err)
+	}
+	if data, err = prepareJSONInput(serverFile, data); err != nil {
+		return err
+	}
+
+	result, err := validators.LintServerJSON(data)
+	if err != nil {
+		return err
+	}
+
+	if *format == formatJSON {
+		out, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize lint result: %w", err)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(out))
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Listing quality score: %d/100\n", result.Score)
+	if len(result.Issues) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "✅ server.json follows all listing best practices")
+		return nil
+	}
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "Suggestions:")
+	for i, issue := range result.Issues {
+		_, _ = fmt.Fprintf(os.Stdout, "%d. [%s] %s\n", i+1, issue.Reference, issue.Path)
+		_, _ = fmt.Fprintf(os.Stdout, "   %s\n", issue.Message)
+	}
+	return nil
+}
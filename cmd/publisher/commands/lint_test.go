@@ -0,0 +1,78 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lintServerFile writes serverJSON, with a license when it is not empty, to a temporary
+// file and returns its path
+func lintServerFile(t *testing.T, serverJSON apiv0.ServerJSON, license string) string {
+	t.Helper()
+	document := map[string]any{}
+	data, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &document))
+	if license != "" {
+		document["license"] = license
+	}
+	data, err = json.Marshal(document)
+	require.NoError(t, err)
+	return writeServerFile(t, "server.json", string(data))
+}
+
+func TestLintCommand(t *testing.T) {
+	described := func(s *apiv0.ServerJSON) { s.Description = "Weather forecasts for any city" }
+
+	t.Run("recommended fields present", func(t *testing.T) {
+		path := lintServerFile(t, testServerJSON(described), "MIT")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.LintCommand([]string{path})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "Listing quality score: 100/100")
+		assert.Contains(t, out, "follows all listing best practices")
+	})
+
+	t.Run("recommended fields absent", func(t *testing.T) {
+		path := lintServerFile(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Repository = nil }), "")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.LintCommand([]string{path})
+		})
+		require.NoError(t, err, "a low score is not an error")
+		assert.Contains(t, out, "Listing quality score: 40/100")
+		assert.Contains(t, out, "[listing-description-short] /description")
+		assert.Contains(t, out, "[listing-repository-missing] /repository")
+		assert.Contains(t, out, "[listing-license-missing] /license")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		path := lintServerFile(t, testServerJSON(described, func(s *apiv0.ServerJSON) { s.Remotes = nil }), "MIT")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.LintCommand([]string{"--format", "json", path})
+		})
+		require.NoError(t, err)
+		var result validators.LintResult
+		require.NoError(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, 80, result.Score)
+		assert.Equal(t, []string{"listing-install-method-missing"}, issueReferences(result.Issues))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		err := commands.LintCommand([]string{"--format", "sarif", lintServerFile(t, testServerJSON(), "")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid format 'sarif'")
+
+		err = commands.LintCommand([]string{writeServerFile(t, "server.json", "{")})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// maxServerJSONSize limits how much of a remote server.json is read
+const maxServerJSONSize = 1 << 20
+
+func init() {
+	if err := validators.RegisterRule(validators.Rule{
+		Reference:   "server-json-fetch-failed",
+		Type:        validators.ValidationIssueTypeFetch,
+		Severity:    validators.ValidationIssueSeverityError,
+		Description: "server.json referenced by a lockfile must be reachable and valid JSON (checked by validate --lockfile)",
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// outcome returns the event stream outcome of the entry
+func (e lockfileEntry) outcome() string {
+	switch {
+	case e.fetchFailed:
+		return outcomeFetchFailed
+	case !e.failed:
+		return outcomeValid
+	default:
+		return outcomeInvalid
+	}
+}
+
+// lockfileEntry is the outcome of validating one server referenced by a lockfile
+type lockfileEntry struct {
+	result      *validators.ValidationResult
+	serverJSON  *apiv0.ServerJSON
+	source      []byte
+	fetchFailed bool
+	// failed is set when the entry fails validation under --fail-on
+	failed bool
+}
+
+// loadLockfile reads a lockfile mapping server names to server.json URLs
+func loadLockfile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s not found, please check the file path", path)
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var servers map[string]string
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, fmt.Errorf("invalid lockfile %s: expected an object mapping server names to server.json URLs: %w", path, err)
+	}
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("lockfile %s lists no servers", path)
+	}
+
+	return servers, nil
+}
+
+// fetchServerJSON downloads and parses the server.json at serverURL
+func fetchServerJSON(client *http.Client, serverURL string) ([]byte, *apiv0.ServerJSON, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, serverURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching %s: %w", serverURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("error fetching %s: server returned status %d", serverURL, resp.StatusCode)
+	}
+
+	serverData, err := io.ReadAll(io.LimitReader(resp.Body, maxServerJSONSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading %s: %w", serverURL, err)
+	}
+	if len(serverData) > maxServerJSONSize {
+		return nil, nil, fmt.Errorf("%s is more than the limit of %d bytes", serverURL, maxServerJSONSize)
+	}
+	if serverData, err = prepareJSONInput(serverURL, serverData); err != nil {
+		return nil, nil, err
+	}
+	if err := checkServerResponse(serverURL, serverData); err != nil {
+		return nil, nil, err
+	}
+
+	var serverJSON apiv0.ServerJSON
+	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON at %s: %w", serverURL, err)
+	}
+
+	return serverData, &serverJSON, nil
+}
+
+// validateLockfileEntry fetches and validates a single lockfile entry. Failing to fetch the
+// document or to reach the validate endpoint is reported as a fetch issue rather than
+// aborting the whole run. policy is applied to the result. Progress messages are written to
+// progress.
+func validateLockfileEntry(client *http.Client, name, serverURL string, mode validationMode, policy issuePolicy, progress io.Writer) lockfileEntry {
+	var entry lockfileEntry
+
+	serverData, serverJSON, err := fetchServerJSON(client, serverURL)
+	if err == nil {
+		entry.serverJSON = serverJSON
+		entry.source = serverData
+		entry.result, err = validateServer(client, serverData, serverJSON, mode, progress)
+		if err != nil {
+			err = fmt.Errorf("validation request failed: %w", err)
+		} else {
+			entry.result, entry.failed = policy.apply(entry.result)
+		}
+	}
+
+	if err != nil {
+		entry.fetchFailed, entry.failed = true, true
+		entry.result = &validators.ValidationResult{Valid: false, Issues: []validators.ValidationIssue{
+			validators.NewValidationIssueFromError(validators.ValidationIssueTypeFetch, name, err, "server-json-fetch-failed"),
+		}}
+	}
+
+	return entry
+}
+
+// validateLockfile validates every server.json referenced by the lockfile at path and
+// prints an aggregate report, emitting an event as each entry completes. policy applies
+// --strict, --fail-on, --severity and the issue list options to every entry. It returns an
+// error if any entry failed to fetch or validate.
+func validateLockfile(client *http.Client, path string, mode validationMode, policy issuePolicy, out batchOutput) error {
+	servers, err := loadLockfile(path)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out.events.started(len(names))
+	var valid, invalid, fetchFailed int
+	for _, name := range names {
+		// With --summary-only, the header and progress of an entry are held back and only
+		// shown if it fails
+		var held bytes.Buffer
+		text := out.text
+		if out.summaryOnly {
+			text = &held
+		}
+		_, _ = fmt.Fprintf(text, "── %s (%s)\n", name, servers[name])
+		entry := validateLockfileEntry(client, name, servers[name], mode, policy, text)
+		if entry.outcome() != outcomeValid {
+			_, _ = held.WriteTo(out.text)
+		}
+		out.events.itemCompleted(servers[name], name, entry.outcome())
+		out.report.add(servers[name], entry.source, "", policy.shownIssues(entry.result))
+		if err := out.reports.write(name, servers[name], entry.source, entry.result); err != nil {
+			return err
+		}
+
+		switch entry.outcome() {
+		case outcomeFetchFailed:
+			fetchFailed++
+			issue := entry.result.Issues[0]
+			_, _ = fmt.Fprintf(out.text, "❌ [%s] %s\n", issue.Type, issue.Message)
+			_, _ = fmt.Fprintf(out.text, "   Reference: %s\n", issue.Reference)
+			_, _ = fmt.Fprintln(out.text)
+		case outcomeValid:
+			valid++
+			if !out.summaryOnly {
+				// printValidationIssues writes to stdout, which carries only events with --format events
+				if out.text != io.Discard {
+					policy.print(entry.result, entry.serverJSON, false)
+				}
+				_, _ = fmt.Fprintln(out.text, "✅ server.json is valid")
+				_, _ = fmt.Fprintln(out.text)
+			}
+		default:
+			invalid++
+			if out.text != io.Discard {
+				policy.print(entry.result, entry.serverJSON, true)
+			}
+		}
+	}
+	out.events.finished()
+
+	_, _ = fmt.Fprintf(out.text, "Validated %d server(s) from %s: %d valid, %d invalid, %d fetch failed\n", len(names), path, valid, invalid, fetchFailed)
+
+	if invalid > 0 || fetchFailed > 0 {
+		return validationFailure("%d of %d server(s) in %s failed validation", invalid+fetchFailed, len(names), path)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package commands_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupLockfile serves the given documents over HTTP and writes a lockfile mapping each
+// name to its URL. Entries in unreachable are written with a URL nothing listens on.
+func setupLockfile(t *testing.T, docs map[string]any, unreachable []string) string {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for name, doc := range docs {
+		mux.HandleFunc("/"+name+"/server.json", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(doc)
+		})
+	}
+	docServer := httptest.NewServer(mux)
+	t.Cleanup(docServer.Close)
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	lock := map[string]string{}
+	for name := range docs {
+		lock[name] = docServer.URL + "/" + name + "/server.json"
+	}
+	for _, name := range unreachable {
+		lock[name] = closed.URL + "/server.json"
+	}
+	lock["com.example/missing"] = docServer.URL + "/missing/server.json"
+
+	data, err := json.Marshal(lock)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "servers.lock.json")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+func TestValidateCommand_Lockfile(t *testing.T) {
+	lockfile := setupLockfile(t, map[string]any{
+		"valid":   testServerJSON(),
+		"invalid": testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" }),
+		"garbage": "not a server.json",
+	}, []string{"com.example/unreachable"})
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--lockfile", lockfile})
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "4 of 5 server(s)")
+	assert.Contains(t, output, "Validated 5 server(s)")
+	assert.Contains(t, output, "1 valid, 1 invalid, 3 fetch failed")
+	assert.Contains(t, output, "version-looks-like-range")
+	assert.Contains(t, output, "server returned status 404")
+	assert.Contains(t, output, "❌ [fetch]")
+	assert.Contains(t, output, "Reference: server-json-fetch-failed")
+}
+
+func TestValidateCommand_LockfileSummaryOnly(t *testing.T) {
+	lockfile := setupLockfile(t, map[string]any{
+		"valid":   testServerJSON(),
+		"invalid": testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "^1.0.0" }),
+	}, nil)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--summary-only", "--lockfile", lockfile})
+	})
+
+	require.Error(t, err)
+	assert.NotContains(t, output, "── valid ")
+	assert.NotContains(t, output, "✅ server.json is valid")
+	assert.Contains(t, output, "── invalid ")
+	assert.Contains(t, output, "version-looks-like-range")
+	assert.Contains(t, output, "── com.example/missing ")
+	assert.Contains(t, output, "1 valid, 1 invalid, 1 fetch failed")
+}
+
+func TestValidateCommand_LockfileTooLarge(t *testing.T) {
+	// A valid document padded past the size limit, which must not be cut off and parsed
+	data, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	padded := append(bytes.Repeat([]byte(" "), 1<<20), data...)
+	docServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(padded)
+	}))
+	t.Cleanup(docServer.Close)
+
+	lock, err := json.Marshal(map[string]string{"com.example/large": docServer.URL + "/server.json"})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "servers.lock.json")
+	require.NoError(t, os.WriteFile(path, lock, 0600))
+
+	var validateErr error
+	output := CaptureStdout(t, func() {
+		validateErr = commands.ValidateCommand([]string{"--offline", "--lockfile", path})
+	})
+	require.Error(t, validateErr)
+	assert.Contains(t, output, docServer.URL+"/server.json is more than the limit of 1048576 bytes")
+	assert.Contains(t, output, "0 valid, 0 invalid, 1 fetch failed")
+}
+
+func TestValidateCommand_LockfileIssueOptions(t *testing.T) {
+	lockfile := setupLockfile(t, map[string]any{
+		"insecure": testServerJSON(func(s *apiv0.ServerJSON) {
+			s.Repository = &model.Repository{URL: "http://github.com/example/test-server", Source: "github"}
+		}),
+	}, nil)
+
+	for _, args := range [][]string{{"--strict"}, {"--fail-on", "warning"}} {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand(append(append([]string{"--offline"}, args...), "--lockfile", lockfile))
+		})
+		require.Error(t, err, "%v should fail the entry with a warning", args)
+		assert.Contains(t, output, "Reference: url-insecure-scheme")
+		assert.Contains(t, output, "0 valid, 1 invalid, 1 fetch failed")
+	}
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--lockfile", lockfile})
+	})
+	require.Error(t, err, "the missing entry should fail")
+	assert.Contains(t, output, "Reference: url-insecure-scheme")
+	assert.Contains(t, output, "1 valid, 0 invalid, 1 fetch failed")
+}
+
+func TestValidateCommand_LockfileViaRegistry(t *testing.T) {
+	validateCallCount := 0
+	registry := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		validateCallCount++
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true, Issues: []validators.ValidationIssue{}})
+	})
+	SetupTestToken(t, registry.URL, "test-token")
+
+	lockfile := setupLockfile(t, map[string]any{
+		"a": testServerJSON(),
+		"b": testServerJSON(),
+	}, nil)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--lockfile", lockfile})
+	})
+
+	require.Error(t, err, "the missing entry should fail")
+	assert.Contains(t, output, "2 valid, 0 invalid, 1 fetch failed")
+	assert.Equal(t, 2, validateCallCount, "only fetched documents should be sent to the registry")
+}
+
+func TestValidateCommand_LockfileErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("missing lockfile", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--lockfile", filepath.Join(dir, "missing.lock.json")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("malformed lockfile", func(t *testing.T) {
+		path := filepath.Join(dir, "bad.lock.json")
+		require.NoError(t, os.WriteFile(path, []byte(`["not", "a", "map"]`), 0600))
+		err := commands.ValidateCommand([]string{"--lockfile", path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid lockfile")
+	})
+
+	t.Run("combined with baseline", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--lockfile", "servers.lock.json", "--baseline", "baseline.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be combined")
+	})
+}
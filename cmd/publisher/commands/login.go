@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"time"
 
 	"github.com/modelcontextprotocol/registry/cmd/publisher/auth"
 	"github.com/modelcontextprotocol/registry/cmd/publisher/auth/azurekeyvault"
@@ -30,11 +30,17 @@ const (
 func tokenFilePath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		return "", homeDirError(err)
 	}
 	return filepath.Join(homeDir, ".config", "mcp-publisher", "token.json"), nil
 }
 
+// homeDirError explains how to proceed when the home directory cannot be determined,
+// which is common in minimal containers where HOME is unset
+func homeDirError(err error) error {
+	return fmt.Errorf("could not determine the home directory to locate the saved token (%w). Set HOME or pass --token-file with the path to a token file", err)
+}
+
 // notAuthenticatedError returns an error guiding the user to log in,
 // with a hint about the token location change for users upgrading.
 func notAuthenticatedError() error {
@@ -42,6 +48,161 @@ func notAuthenticatedError() error {
 	return errors.New("not authenticated, run 'mcp-publisher login <method>' first")
 }
 
+// tokenExpiryMargin is how long before its expiry a saved token is refreshed, so that it
+// does not expire while a command is using it
+const tokenExpiryMargin = time.Minute
+
+// errNoRefreshToken is returned when a saved token is to be refreshed but login did not
+// save a refresh token
+var errNoRefreshToken = errors.New("login did not save a refresh token")
+
+// loadSavedToken reads the token saved by login and the registry it was issued for,
+// falling back to the default registry if none was recorded. tokenFile overrides the
+// default token location when set. A token that has expired is refreshed first, if login
+// saved a refresh token.
+func loadSavedToken(tokenFile string) (token, registryURL string, err error) {
+	tokenPath, tokenInfo, err := readSavedToken(tokenFile)
+	if err != nil {
+		return "", "", err
+	}
+
+	registryURL = savedRegistryURL(tokenInfo)
+
+	if savedTokenExpired(tokenInfo) {
+		if err := refreshSavedToken(tokenPath, tokenInfo, registryURL); err != nil {
+			return "", "", fmt.Errorf("the saved token has expired and could not be refreshed: %w. Run 'mcp-publisher login %s' again", err, savedLoginMethod(tokenInfo))
+		}
+	}
+
+	return tokenInfo["token"], registryURL, nil
+}
+
+// readSavedToken returns the path and content of the token file, tokenFile or the default
+// token location
+func readSavedToken(tokenFile string) (string, map[string]string, error) {
+	tokenPath := tokenFile
+	if tokenPath == "" {
+		var err error
+		if tokenPath, err = tokenFilePath(); err != nil {
+			return "", nil, err
+		}
+	}
+
+	tokenData, err := os.ReadFile(tokenPath)
+	if err != nil {
+		if os.IsNotExist(err) && tokenFile != "" {
+			return "", nil, fmt.Errorf("token file %s not found", tokenFile)
+		}
+		if os.IsNotExist(err) {
+			return "", nil, notAuthenticatedError()
+		}
+		return "", nil, fmt.Errorf("failed to read token: %w", err)
+	}
+
+	var tokenInfo map[string]string
+	if err := json.Unmarshal(tokenData, &tokenInfo); err != nil {
+		return "", nil, fmt.Errorf("invalid token data: %w", err)
+	}
+	return tokenPath, tokenInfo, nil
+}
+
+// savedTokenExpired reports whether the expiry recorded by login for a saved token has
+// passed or is about to. Tokens without a recorded expiry never expire here.
+func savedTokenExpired(tokenInfo map[string]string) bool {
+	expiresAt, err := time.Parse(time.RFC3339, tokenInfo["expires_at"])
+	if err != nil {
+		return false
+	}
+	return time.Now().Add(tokenExpiryMargin).After(expiresAt)
+}
+
+// savedRegistryURL returns the registry a saved token was issued for, or the default
+// registry if login did not record one
+func savedRegistryURL(tokenInfo map[string]string) string {
+	if registryURL := tokenInfo["registry"]; registryURL != "" {
+		return registryURL
+	}
+	return DefaultRegistryURL
+}
+
+// savedLoginMethod returns the login method recorded in a saved token, for messages
+func savedLoginMethod(tokenInfo map[string]string) string {
+	if method := tokenInfo["method"]; method != "" {
+		return method
+	}
+	return "<method>"
+}
+
+// refreshSavedToken obtains a new registry token for registryURL with the refresh token
+// saved in tokenInfo and saves it to tokenPath
+func refreshSavedToken(tokenPath string, tokenInfo map[string]string, registryURL string) error {
+	if tokenInfo["refresh_token"] == "" {
+		return errNoRefreshToken
+	}
+	grant := auth.RefreshGrant{
+		TokenURL:     tokenInfo["refresh_url"],
+		ClientID:     tokenInfo["client_id"],
+		RefreshToken: tokenInfo["refresh_token"],
+	}
+	token, expiresAt, next, err := auth.RefreshRegistryToken(context.Background(), registryURL, grant)
+	if err != nil {
+		return err
+	}
+	tokenInfo["token"] = token
+	recordTokenRefresh(tokenInfo, expiresAt, next)
+	return writeSavedToken(tokenPath, tokenInfo)
+}
+
+// recordTokenRefresh stores the expiry of the token in tokenInfo and the grant that renews
+// it, replacing any recorded before. Either may be absent.
+func recordTokenRefresh(tokenInfo map[string]string, expiresAt time.Time, grant *auth.RefreshGrant) {
+	for _, key := range []string{"expires_at", "refresh_token", "refresh_url", "client_id"} {
+		delete(tokenInfo, key)
+	}
+	if !expiresAt.IsZero() {
+		tokenInfo["expires_at"] = expiresAt.UTC().Format(time.RFC3339)
+	}
+	if grant != nil {
+		tokenInfo["refresh_token"] = grant.RefreshToken
+		tokenInfo["refresh_url"] = grant.TokenURL
+		tokenInfo["client_id"] = grant.ClientID
+	}
+}
+
+// writeSavedToken writes tokenInfo to the token file at tokenPath, readable only by the user
+func writeSavedToken(tokenPath string, tokenInfo map[string]string) error {
+	jsonData, err := json.Marshal(tokenInfo)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token data: %w", err)
+	}
+	if err := os.WriteFile(tokenPath, jsonData, 0600); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+	return nil
+}
+
+// loginRefresh renews the saved token with the refresh token saved by login, without
+// waiting for it to expire
+func loginRefresh(args []string) error {
+	fs := flag.NewFlagSet("login refresh", flag.ExitOnError)
+	tokenFile := fs.String("token-file", "", "Refresh the token in this file instead of ~/.config/mcp-publisher/token.json")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	tokenPath, tokenInfo, err := readSavedToken(*tokenFile)
+	if err != nil {
+		return err
+	}
+	registryURL := savedRegistryURL(tokenInfo)
+	if err := refreshSavedToken(tokenPath, tokenInfo, registryURL); err != nil {
+		return fmt.Errorf("failed to refresh the saved token: %w. Run 'mcp-publisher login %s' again", err, savedLoginMethod(tokenInfo))
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "✓ Successfully refreshed the saved token")
+	return nil
+}
+
 // ensureTokenDir creates the token directory (~/.config/mcp-publisher/) if needed.
 func ensureTokenDir() error {
 	homeDir, err := os.UserHomeDir()
@@ -70,6 +231,7 @@ type LoginFlags struct {
 	CryptoAlgorithm CryptoAlgorithm
 	SignerType      SignerType
 	ArgOffset       int
+	DryRun          bool
 }
 
 const (
@@ -100,12 +262,15 @@ func parseLoginFlags(method string, args []string) (LoginFlags, error) {
 	flags.CryptoAlgorithm = CryptoAlgorithm(auth.AlgorithmEd25519)
 	flags.SignerType = NoSignerType
 	flags.ArgOffset = 1
-	loginFlags.StringVar(&flags.RegistryURL, "registry", DefaultRegistryURL, "Registry URL")
+	loginFlags.StringVar(&flags.RegistryURL, "registry", DefaultRegistryURL, "Registry URL or alias from the config file")
+	loginFlags.BoolVar(&flags.DryRun, "dry-run", false, "Log in and print what the token grants without saving it")
 
 	// Add --token flag for GitHub authentication
 	var token string
+	var tokenStdin bool
 	if method == MethodGitHub {
 		loginFlags.StringVar(&token, "token", "", "GitHub Personal Access Token")
+		loginFlags.BoolVar(&tokenStdin, "token-stdin", false, "Read the GitHub Personal Access Token from stdin")
 	}
 
 	if method == "dns" || method == "http" {
@@ -131,7 +296,14 @@ func parseLoginFlags(method string, args []string) (LoginFlags, error) {
 	}
 	err := loginFlags.Parse(args[flags.ArgOffset:])
 	if err == nil {
-		flags.RegistryURL = strings.TrimRight(flags.RegistryURL, "/")
+		flags.RegistryURL, err = resolveRegistryURL(flags.RegistryURL)
+	}
+
+	if err == nil && tokenStdin {
+		if token != "" {
+			return flags, errors.New("--token-stdin and --token cannot be used together")
+		}
+		token, err = readTokenFromStdin()
 	}
 
 	// Store the token in flags if it was provided
@@ -192,6 +364,8 @@ Methods:
   dns               DNS-based authentication (requires --domain)
   http              HTTP-based authentication (requires --domain)
   none              Anonymous authentication (for testing)
+  refresh           Renew the saved token with the refresh token saved by
+                    'login github', without repeating the login
 
 Signing providers:
   azure-key-vault   Sign using Azure Key Vault
@@ -208,6 +382,12 @@ Examples:
 
   # Interactive GitHub login, using device code flow
   mcp-publisher login github
+
+  # Check which namespaces a login grants without saving the token
+  mcp-publisher login github --dry-run
+
+  # Renew the saved GitHub login before it expires
+  mcp-publisher login refresh
   
   # Sign in using a specific Ed25519 private key for DNS authentication
   mcp-publisher login dns -algorithm ed25519 -domain example.com -private-key <64 hex chars>
@@ -227,6 +407,9 @@ Examples:
 	}
 
 	method := args[0]
+	if method == "refresh" {
+		return loginRefresh(args[1:])
+	}
 	flags, err := parseLoginFlags(method, args)
 	if err != nil {
 		return err
@@ -256,6 +439,11 @@ Examples:
 		return fmt.Errorf("failed to get token: %w", err)
 	}
 
+	if flags.DryRun {
+		printLoginDryRun(token)
+		return nil
+	}
+
 	if err := ensureTokenDir(); err != nil {
 		return err
 	}
@@ -270,14 +458,12 @@ Examples:
 		"method":   method,
 		"registry": flags.RegistryURL,
 	}
-
-	jsonData, err := json.Marshal(tokenData)
-	if err != nil {
-		return fmt.Errorf("failed to marshal token data: %w", err)
+	if refresher, ok := authProvider.(auth.Refresher); ok {
+		recordTokenRefresh(tokenData, refresher.TokenExpiry(), refresher.RefreshGrant())
 	}
 
-	if err := os.WriteFile(tokenPath, jsonData, 0600); err != nil {
-		return fmt.Errorf("failed to save token: %w", err)
+	if err := writeSavedToken(tokenPath, tokenData); err != nil {
+		return err
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "✓ Successfully logged in")
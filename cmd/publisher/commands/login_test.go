@@ -1,14 +1,18 @@
 package commands_test
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -95,3 +99,291 @@ func setupNoneAuthServer(t *testing.T) *httptest.Server {
 	t.Cleanup(server.Close)
 	return server
 }
+
+func TestLoginCommand_DryRun(t *testing.T) {
+	t.Run("prints the token's claims", func(t *testing.T) {
+		tempHome := t.TempDir()
+		t.Setenv("HOME", tempHome)
+
+		claims := base64.RawURLEncoding.EncodeToString([]byte(`{"auth_method":"none","auth_method_sub":"anonymous","permissions":[{"action":"publish","resource":"io.modelcontextprotocol.anonymous/*"}],"exp":4070908800}`))
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v0/auth/none", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"registry_token": "eyJhbGciOiJFZERTQSJ9." + claims + ".signature",
+				"expires_at":     4070908800,
+			})
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.LoginCommand([]string{"none", "--dry-run", "--registry", server.URL})
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, output, "dry run, token not saved")
+		assert.Contains(t, output, "Authenticated via: none (anonymous)")
+		assert.Contains(t, output, "Expires: 2099-01-01T00:00:00Z")
+		assert.Contains(t, output, "publish  io.modelcontextprotocol.anonymous/*")
+
+		_, err = os.Stat(filepath.Join(tempHome, ".config", "mcp-publisher"))
+		assert.True(t, os.IsNotExist(err), "dry run should not create the token directory")
+	})
+
+	t.Run("token that is not a JWT", func(t *testing.T) {
+		tempHome := t.TempDir()
+		t.Setenv("HOME", tempHome)
+		server := setupNoneAuthServer(t)
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.LoginCommand([]string{"none", "--registry", server.URL, "--dry-run"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Could not show the token's claims")
+
+		_, err = os.Stat(filepath.Join(tempHome, ".config", "mcp-publisher", "token.json"))
+		assert.True(t, os.IsNotExist(err), "dry run should not write the token file")
+	})
+}
+
+func TestLoginCommand_GitHubTokenStdin(t *testing.T) {
+	tempHome := t.TempDir()
+	t.Setenv("HOME", tempHome)
+
+	var githubToken string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/auth/github-at", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		githubToken = body["github_token"]
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"registry_token": "test-registry-jwt",
+			"expires_at":     9999999999,
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	SetStdin(t, "ghp_piped\n")
+	err := commands.LoginCommand([]string{"github", "--registry", server.URL, "--token-stdin"})
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_piped", githubToken)
+
+	err = commands.LoginCommand([]string{"github", "--registry", server.URL, "--token-stdin", "--token", "ghp_flag"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be used together")
+}
+
+// refreshRegistry is a mock registry that also serves GitHub's token endpoint, so that a
+// saved GitHub login can be refreshed against it
+type refreshRegistry struct {
+	*httptest.Server
+	// published and validated hold the Authorization header of every publish and validate
+	// request
+	published []string
+	validated []string
+}
+
+// setupRefreshRegistry starts a refreshRegistry that accepts the refresh token "valid-refresh",
+// rotating it to "rotated-refresh", and issues the registry token "refreshed-jwt"
+func setupRefreshRegistry(t *testing.T) *refreshRegistry {
+	t.Helper()
+	registry := &refreshRegistry{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if body["grant_type"] != "refresh_token" || body["refresh_token"] != "valid-refresh" {
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "bad_refresh_token"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "gho_refreshed", "refresh_token": "rotated-refresh"})
+	})
+	mux.HandleFunc("/v0/auth/github-at", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["github_token"] != "gho_refreshed" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"registry_token": "refreshed-jwt",
+			"expires_at":     9999999999,
+		})
+	})
+	mux.HandleFunc("/v0/publish", func(w http.ResponseWriter, r *http.Request) {
+		registry.published = append(registry.published, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	})
+	mux.HandleFunc("/v0/validate", func(w http.ResponseWriter, r *http.Request) {
+		registry.validated = append(registry.validated, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	registry.Server = httptest.NewServer(mux)
+	t.Cleanup(registry.Close)
+	return registry
+}
+
+// saveExpiredGitHubLogin saves an expired registry token for registry with refreshToken,
+// if not empty, as login github does, and returns the path of the token file
+func saveExpiredGitHubLogin(t *testing.T, registry *refreshRegistry, refreshToken string) string {
+	t.Helper()
+	tokenPath := SetupTestToken(t, registry.URL, "expired-jwt")
+	tokenInfo := map[string]string{
+		"token":      "expired-jwt",
+		"method":     "github",
+		"registry":   registry.URL,
+		"expires_at": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	}
+	if refreshToken != "" {
+		tokenInfo["refresh_token"] = refreshToken
+		tokenInfo["refresh_url"] = registry.URL + "/login/oauth/access_token"
+		tokenInfo["client_id"] = "test-client-id"
+	}
+	data, err := json.Marshal(tokenInfo)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tokenPath, data, 0600))
+	return tokenPath
+}
+
+// readTokenFile returns the content of the token file at tokenPath
+func readTokenFile(t *testing.T, tokenPath string) map[string]string {
+	t.Helper()
+	data, err := os.ReadFile(tokenPath)
+	require.NoError(t, err)
+	var tokenInfo map[string]string
+	require.NoError(t, json.Unmarshal(data, &tokenInfo))
+	return tokenInfo
+}
+
+func TestPublishCommand_RefreshesExpiredToken(t *testing.T) {
+	t.Run("refresh succeeds", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		tokenPath := saveExpiredGitHubLogin(t, registry, "valid-refresh")
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand(nil)
+		})
+		require.NoError(t, err, out)
+		assert.Equal(t, []string{"Bearer refreshed-jwt"}, registry.published)
+
+		tokenInfo := readTokenFile(t, tokenPath)
+		assert.Equal(t, "refreshed-jwt", tokenInfo["token"])
+		assert.Equal(t, "rotated-refresh", tokenInfo["refresh_token"])
+		assert.Equal(t, "2286-11-20T17:46:39Z", tokenInfo["expires_at"])
+		assert.Equal(t, "github", tokenInfo["method"])
+	})
+
+	t.Run("refresh token expired", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		saveExpiredGitHubLogin(t, registry, "revoked-refresh")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.PublishCommand(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the saved token has expired and could not be refreshed")
+		assert.Contains(t, err.Error(), "bad_refresh_token")
+		assert.Contains(t, err.Error(), "Run 'mcp-publisher login github' again")
+		assert.Empty(t, registry.published)
+	})
+
+	t.Run("no refresh token", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		saveExpiredGitHubLogin(t, registry, "")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.PublishCommand(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "login did not save a refresh token")
+		assert.Empty(t, registry.published)
+	})
+}
+
+func TestValidateCommand_AuthValidateRefreshesExpiredToken(t *testing.T) {
+	registry := setupRefreshRegistry(t)
+	tokenPath := saveExpiredGitHubLogin(t, registry, "valid-refresh")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--auth-validate", "--registry", registry.URL})
+	})
+	require.NoError(t, err, out)
+	assert.Equal(t, []string{"Bearer refreshed-jwt"}, registry.validated)
+	assert.Equal(t, "refreshed-jwt", readTokenFile(t, tokenPath)["token"])
+}
+
+func TestLoginCommand_Refresh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		tokenPath := saveExpiredGitHubLogin(t, registry, "valid-refresh")
+
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.LoginCommand([]string{"refresh"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "Successfully refreshed the saved token")
+		tokenInfo := readTokenFile(t, tokenPath)
+		assert.Equal(t, "refreshed-jwt", tokenInfo["token"])
+		assert.Equal(t, "rotated-refresh", tokenInfo["refresh_token"])
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		tokenPath := saveExpiredGitHubLogin(t, registry, "valid-refresh")
+		otherPath := filepath.Join(t.TempDir(), "token.json")
+		data, err := os.ReadFile(tokenPath)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(otherPath, data, 0600))
+
+		CaptureStdout(t, func() {
+			err = commands.LoginCommand([]string{"refresh", "--token-file", otherPath})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed-jwt", readTokenFile(t, otherPath)["token"])
+		assert.Equal(t, "expired-jwt", readTokenFile(t, tokenPath)["token"])
+	})
+
+	t.Run("refresh token expired", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		tokenPath := saveExpiredGitHubLogin(t, registry, "revoked-refresh")
+
+		err := commands.LoginCommand([]string{"refresh"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to refresh the saved token")
+		assert.Contains(t, err.Error(), "bad_refresh_token")
+		assert.Equal(t, "expired-jwt", readTokenFile(t, tokenPath)["token"], "the token file is unchanged")
+	})
+
+	t.Run("no refresh token", func(t *testing.T) {
+		registry := setupRefreshRegistry(t)
+		saveExpiredGitHubLogin(t, registry, "")
+
+		err := commands.LoginCommand([]string{"refresh"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "login did not save a refresh token")
+	})
+}
+
+func TestLoginCommand_SavesTokenExpiry(t *testing.T) {
+	tempHome := t.TempDir()
+	t.Setenv("HOME", tempHome)
+	registry := setupRefreshRegistry(t)
+
+	require.NoError(t, commands.LoginCommand([]string{"github", "--registry", registry.URL, "--token", "gho_refreshed"}))
+	tokenInfo := readTokenFile(t, filepath.Join(tempHome, ".config", "mcp-publisher", "token.json"))
+	assert.Equal(t, "refreshed-jwt", tokenInfo["token"])
+	assert.Equal(t, "2286-11-20T17:46:39Z", tokenInfo["expires_at"])
+	assert.NotContains(t, tokenInfo, "refresh_token", "a provided GitHub token has no refresh token")
+}
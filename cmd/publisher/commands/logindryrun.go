@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// registryTokenClaims are the claims of a registry token that describe what it grants
+type registryTokenClaims struct {
+	AuthMethod        string            `json:"auth_method"`
+	AuthMethodSubject string            `json:"auth_method_sub"`
+	Permissions       []tokenPermission `json:"permissions"`
+	ExpiresAt         int64             `json:"exp"`
+}
+
+// decodeTokenClaims reads the claims of a registry token without verifying its signature,
+// which only the registry can do. It is meant for showing the user what a token grants.
+func decodeTokenClaims(token string) (*tokenIdentity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("the registry token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry token payload: %w", err)
+	}
+
+	var claims registryTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("invalid registry token claims: %w", err)
+	}
+	identity := &tokenIdentity{
+		AuthMethod:        claims.AuthMethod,
+		AuthMethodSubject: claims.AuthMethodSubject,
+		Permissions:       claims.Permissions,
+	}
+	if claims.ExpiresAt != 0 {
+		expiresAt := time.Unix(claims.ExpiresAt, 0).UTC()
+		identity.ExpiresAt = &expiresAt
+	}
+	return identity, nil
+}
+
+// printLoginDryRun describes the token obtained by a login with --dry-run, which is not saved
+func printLoginDryRun(token string) {
+	_, _ = fmt.Fprintln(os.Stdout, "✓ Login succeeded (dry run, token not saved)")
+	identity, err := decodeTokenClaims(token)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "  Could not show the token's claims: %v\n", err)
+		return
+	}
+	printTokenIdentity(identity)
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+)
+
+// Array merge modes of --merge-arrays
+const (
+	mergeArraysReplace = "replace"
+	mergeArraysAppend  = "append"
+)
+
+// serverMerge holds the --merge and --merge-arrays flags that combine several server.json
+// fragments, such as a base file and an environment overlay, into one server.json
+type serverMerge struct {
+	enabled bool
+	arrays  string
+	// files are the fragments to merge, in order
+	files []string
+}
+
+// register adds the merge flags to fs
+func (m *serverMerge) register(fs *flag.FlagSet) {
+	fs.BoolVar(&m.enabled, "merge", false, "Deep-merge the given files into one server.json, later files overriding earlier ones")
+	fs.StringVar(&m.arrays, "merge-arrays", "", "How --merge combines arrays: replace (default) or append")
+}
+
+// init checks the merge flags and records files, the positional arguments, as the fragments
+// to merge
+func (m *serverMerge) init(files []string) error {
+	if !m.enabled {
+		if m.arrays != "" {
+			return errors.New("--merge-arrays requires --merge")
+		}
+		return nil
+	}
+	if m.arrays == "" {
+		m.arrays = mergeArraysReplace
+	}
+	if !slices.Contains([]string{mergeArraysReplace, mergeArraysAppend}, m.arrays) {
+		return fmt.Errorf("invalid merge-arrays '%s'. Must be one of: %s, %s", m.arrays, mergeArraysReplace, mergeArraysAppend)
+	}
+	if len(files) < 2 {
+		return errors.New("--merge requires at least two server.json files")
+	}
+	m.files = files
+	return nil
+}
+
+// read returns serverFile read with readFile, or without --merge, or the JSON of the
+// fragments read with readFile and merged in order with --merge
+func (m *serverMerge) read(serverFile string, readFile func(string) ([]byte, error)) ([]byte, error) {
+	if !m.enabled {
+		return readFile(serverFile)
+	}
+
+	var merged map[string]any
+	for _, file := range m.files {
+		data, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+		// Numbers are kept as written rather than converted to float64
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		var fragment map[string]any
+		if err := dec.Decode(&fragment); err != nil || fragment == nil {
+			return nil, fmt.Errorf("--merge: %s must hold a JSON object", file)
+		}
+		merged = mergeObjects(merged, fragment, m.arrays == mergeArraysAppend)
+	}
+	return json.Marshal(merged)
+}
+
+// mergeObjects deep-merges overlay into base and returns the result. Objects are merged key
+// by key, a null value removes the key, as in a JSON merge patch, and arrays are replaced,
+// or appended to the base array with appendArrays. Any other value replaces the base value.
+func mergeObjects(base, overlay map[string]any, appendArrays bool) map[string]any {
+	if base == nil {
+		base = map[string]any{}
+	}
+	for key, value := range overlay {
+		switch v := value.(type) {
+		case nil:
+			delete(base, key)
+		case map[string]any:
+			baseObject, _ := base[key].(map[string]any)
+			base[key] = mergeObjects(baseObject, v, appendArrays)
+		case []any:
+			if baseArray, ok := base[key].([]any); ok && appendArrays {
+				base[key] = append(baseArray, v...)
+				continue
+			}
+			base[key] = v
+		default:
+			base[key] = v
+		}
+	}
+	return base
+}
@@ -0,0 +1,129 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFragment writes fragment as JSON to name in a temporary directory and returns its path
+func writeFragment(t *testing.T, name string, fragment map[string]any) string {
+	t.Helper()
+	data, err := json.Marshal(fragment)
+	require.NoError(t, err)
+	return writeServerFile(t, name, string(data))
+}
+
+// baseFragment returns testServerJSON as a fragment, the base that overlays are merged into
+func baseFragment(t *testing.T) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	var fragment map[string]any
+	require.NoError(t, json.Unmarshal(data, &fragment))
+	return fragment
+}
+
+// mergedServerJSON publishes the merged fragments to a mock registry and returns the
+// server.json it received
+func mergedServerJSON(t *testing.T, args ...string) apiv0.PublishRequest {
+	t.Helper()
+	var published apiv0.PublishRequest
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&published))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.PublishCommand(append([]string{"--merge"}, args...))
+	})
+	require.NoError(t, err, out)
+	return published
+}
+
+func TestPublishCommand_Merge(t *testing.T) {
+	base := writeFragment(t, "server.base.json", baseFragment(t))
+	overlay := writeFragment(t, "server.prod.json", map[string]any{
+		"version":    "2.0.0",
+		"repository": map[string]any{"subfolder": "servers/test"},
+		"websiteUrl": nil,
+		"remotes":    []any{map[string]any{"type": "sse", "url": "https://prod.example.com/sse"}},
+	})
+
+	t.Run("later keys override earlier ones", func(t *testing.T) {
+		published := mergedServerJSON(t, base, overlay)
+		assert.Equal(t, "2.0.0", published.Version)
+		assert.Equal(t, "com.example/test-server", published.Name, "keys missing from the overlay are kept")
+		require.NotNil(t, published.Repository)
+		assert.Equal(t, "https://github.com/example/test-server", published.Repository.URL, "objects are merged key by key")
+		assert.Equal(t, "servers/test", published.Repository.Subfolder)
+		assert.Empty(t, published.WebsiteURL, "null removes the key")
+	})
+
+	t.Run("arrays are replaced by default", func(t *testing.T) {
+		published := mergedServerJSON(t, base, overlay)
+		assert.Equal(t, []model.Transport{{Type: "sse", URL: "https://prod.example.com/sse"}}, published.Remotes)
+	})
+
+	t.Run("arrays are appended with merge-arrays append", func(t *testing.T) {
+		published := mergedServerJSON(t, "--merge-arrays", "append", base, overlay)
+		require.Len(t, published.Remotes, 2)
+		assert.Equal(t, "https://example.com/test-server/mcp", published.Remotes[0].URL)
+		assert.Equal(t, "https://prod.example.com/sse", published.Remotes[1].URL)
+	})
+
+	t.Run("fragments in other input formats", func(t *testing.T) {
+		yamlOverlay := writeServerFile(t, "server.prod.yaml", "version: 3.0.0\n")
+		published := mergedServerJSON(t, base, overlay, yamlOverlay)
+		assert.Equal(t, "3.0.0", published.Version)
+	})
+}
+
+func TestValidateCommand_Merge(t *testing.T) {
+	base := writeFragment(t, "server.base.json", baseFragment(t))
+
+	t.Run("merged result is validated", func(t *testing.T) {
+		overlay := writeFragment(t, "server.dev.json", map[string]any{"version": "^1.0.0"})
+		result, err := runValidateJSON(t, "--merge", base, overlay)
+		require.Error(t, err)
+		assert.Contains(t, issueReferences(result.Issues), "version-looks-like-range")
+	})
+
+	t.Run("fragments need not be valid on their own", func(t *testing.T) {
+		partial := baseFragment(t)
+		delete(partial, "version")
+		first := writeFragment(t, "server.base.json", partial)
+		overlay := writeFragment(t, "server.version.json", map[string]any{"version": "1.2.3"})
+		result, err := runValidateJSON(t, "--merge", first, overlay)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+	})
+
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{name: "single file", args: []string{"--merge", base}, expectedError: "--merge requires at least two server.json files"},
+		{name: "merge-arrays without merge", args: []string{"--merge-arrays", "append", base}, expectedError: "--merge-arrays requires --merge"},
+		{name: "unknown array mode", args: []string{"--merge", "--merge-arrays", "union", base, base}, expectedError: "invalid merge-arrays 'union'"},
+		{name: "fix-schema", args: []string{"--merge", "--fix-schema", base, base}, expectedError: "--merge cannot be combined with"},
+		{name: "fragment that is not an object", args: []string{"--merge", base, writeServerFile(t, "list.json", "[]")}, expectedError: "expected a JSON object at the top level"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commands.ValidateCommand(append([]string{"--offline"}, tt.args...))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// officialMetaKey is the registry-managed _meta namespace, which publishers must not send
+const officialMetaKey = "io.modelcontextprotocol.registry/official"
+
+// legacyFieldNames maps snake_case field names used by older schema versions to their
+// current camelCase equivalents
+var legacyFieldNames = map[string]string{
+	"registry_type":         "registryType",
+	"registry_base_url":     "registryBaseUrl",
+	"file_sha256":           "fileSha256",
+	"runtime_hint":          "runtimeHint",
+	"runtime_arguments":     "runtimeArguments",
+	"package_arguments":     "packageArguments",
+	"environment_variables": "environmentVariables",
+	"is_required":           "isRequired",
+	"is_secret":             "isSecret",
+	"value_hint":            "valueHint",
+	"is_repeated":           "isRepeated",
+	"website_url":           "websiteUrl",
+}
+
+func MigrateCommand(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Print a diff of the migration without writing the file")
+
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	serverFile := "server.json"
+	if len(positional) > 0 {
+		serverFile = positional[0]
+	}
+
+	before, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("server.json not found at %s", serverFile)
+		}
+		return fmt.Errorf("failed to read server.json: %w", err)
+	}
+
+	input, err := prepareJSONInput(serverFile, before)
+	if err != nil {
+		return err
+	}
+
+	after, err := migrateServerJSON(input)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(before, after) {
+		_, _ = fmt.Fprintf(os.Stdout, "✅ %s already uses the current schema\n", serverFile)
+		return nil
+	}
+
+	if *dryRun {
+		diff, err := migrationDiff(filepath.Base(serverFile), before, after)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(os.Stdout, diff)
+		return nil
+	}
+
+	info, err := os.Stat(serverFile)
+	if err != nil {
+		return fmt.Errorf("failed to stat server.json: %w", err)
+	}
+	if err := os.WriteFile(serverFile, after, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write server.json: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "✓ Migrated %s to %s\n", serverFile, model.CurrentSchemaURL)
+	return nil
+}
+
+// migrationDiff renders a unified diff between the original and migrated server.json
+func migrationDiff(name string, before, after []byte) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(before)),
+		B:        difflib.SplitLines(string(after)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute diff: %w", err)
+	}
+	return diff, nil
+}
+
+// migrateServerJSON rewrites a server.json document to the current schema. Key order is
+// preserved so that the migrated file stays close to what the publisher wrote.
+func migrateServerJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	doc, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server.json: %w", err)
+	}
+	root, ok := doc.(*orderedObject)
+	if !ok {
+		return nil, fmt.Errorf("invalid server.json: expected a JSON object")
+	}
+
+	renameLegacyFields(root)
+
+	// Registry-managed fields are rejected by the current publish API
+	root.delete("status")
+	if meta, ok := root.get("_meta").(*orderedObject); ok {
+		meta.delete(officialMetaKey)
+		if len(meta.members) == 0 {
+			root.delete("_meta")
+		}
+	}
+
+	root.setFirst("$schema", model.CurrentSchemaURL)
+
+	var buf bytes.Buffer
+	if err := encodeOrdered(&buf, root, ""); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// renameLegacyFields recursively renames snake_case fields, leaving _meta untouched since
+// its contents are publisher-defined
+func renameLegacyFields(value any) {
+	switch v := value.(type) {
+	case *orderedObject:
+		for i := range v.members {
+			if v.members[i].key == "_meta" {
+				continue
+			}
+			if renamed, ok := legacyFieldNames[v.members[i].key]; ok && v.get(renamed) == nil {
+				v.members[i].key = renamed
+			}
+			renameLegacyFields(v.members[i].value)
+		}
+	case []any:
+		for _, item := range v {
+			renameLegacyFields(item)
+		}
+	}
+}
+
+type orderedMember struct {
+	key   string
+	value any
+}
+
+// orderedObject is a JSON object that remembers the order of its keys
+type orderedObject struct {
+	members []orderedMember
+}
+
+func (o *orderedObject) get(key string) any {
+	for _, m := range o.members {
+		if m.key == key {
+			return m.value
+		}
+	}
+	return nil
+}
+
+func (o *orderedObject) delete(key string) {
+	for i, m := range o.members {
+		if m.key == key {
+			o.members = append(o.members[:i], o.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// setFirst sets key to value, moving it to the front of the object
+func (o *orderedObject) setFirst(key string, value any) {
+	o.delete(key)
+	o.members = append([]orderedMember{{key: key, value: value}}, o.members...)
+}
+
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := &orderedObject{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyTok)
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.members = append(obj.members, orderedMember{key: key, value: value})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		arr := []any{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	default:
+		return nil, errors.New("unexpected JSON delimiter")
+	}
+}
+
+// encodeOrdered writes value as JSON indented with two spaces, matching 'mcp-publisher init'
+func encodeOrdered(w *bytes.Buffer, value any, indent string) error {
+	switch v := value.(type) {
+	case *orderedObject:
+		if len(v.members) == 0 {
+			w.WriteString("{}")
+			return nil
+		}
+		w.WriteString("{\n")
+		for i, m := range v.members {
+			w.WriteString(indent + "  ")
+			if err := writeJSONScalar(w, m.key); err != nil {
+				return err
+			}
+			w.WriteString(": ")
+			if err := encodeOrdered(w, m.value, indent+"  "); err != nil {
+				return err
+			}
+			if i < len(v.members)-1 {
+				w.WriteByte(',')
+			}
+			w.WriteByte('\n')
+		}
+		w.WriteString(indent + "}")
+	case []any:
+		if len(v) == 0 {
+			w.WriteString("[]")
+			return nil
+		}
+		w.WriteString("[\n")
+		for i, item := range v {
+			w.WriteString(indent + "  ")
+			if err := encodeOrdered(w, item, indent+"  "); err != nil {
+				return err
+			}
+			if i < len(v)-1 {
+				w.WriteByte(',')
+			}
+			w.WriteByte('\n')
+		}
+		w.WriteString(indent + "]")
+	default:
+		return writeJSONScalar(w, v)
+	}
+	return nil
+}
+
+func writeJSONScalar(w io.Writer, value any) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return fmt.Errorf("failed to encode server.json: %w", err)
+	}
+	_, err := w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return err
+}
@@ -0,0 +1,85 @@
+package commands_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const legacyServerJSON = `{
+  "$schema": "https://static.modelcontextprotocol.io/schemas/2025-07-09/server.schema.json",
+  "name": "com.example/test-server",
+  "description": "A test server",
+  "version": "1.0.0",
+  "status": "active",
+  "packages": [
+    {
+      "registry_type": "npm",
+      "identifier": "@example/test-server",
+      "version": "1.0.0",
+      "environment_variables": [
+        {
+          "name": "API_KEY",
+          "is_required": true
+        }
+      ]
+    }
+  ]
+}
+`
+
+func writeLegacyServerJSON(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "server.json")
+	require.NoError(t, os.WriteFile(path, []byte(legacyServerJSON), 0600))
+	return path
+}
+
+func TestMigrateCommand_DryRun(t *testing.T) {
+	path := writeLegacyServerJSON(t)
+
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.MigrateCommand([]string{"--dry-run", path})
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "--- a/server.json")
+	assert.Contains(t, out, "+++ b/server.json")
+	assert.Contains(t, out, `-  "$schema": "https://static.modelcontextprotocol.io/schemas/2025-07-09/server.schema.json",`)
+	assert.Contains(t, out, `+  "$schema": "`+model.CurrentSchemaURL+`",`)
+	assert.Contains(t, out, `-  "status": "active",`)
+	assert.Contains(t, out, `+      "registryType": "npm",`)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, legacyServerJSON, string(data), "dry run must not modify the file")
+}
+
+func TestMigrateCommand_WritesFile(t *testing.T) {
+	path := writeLegacyServerJSON(t)
+
+	var err error
+	_ = CaptureStdout(t, func() {
+		err = commands.MigrateCommand([]string{path})
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), model.CurrentSchemaURL)
+	assert.Contains(t, string(data), `"isRequired": true`)
+	assert.NotContains(t, string(data), `"status"`)
+
+	// Running again is a no-op
+	out := CaptureStdout(t, func() {
+		err = commands.MigrateCommand([]string{"--dry-run", path})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "already uses the current schema")
+}
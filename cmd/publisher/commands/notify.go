@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// Statuses reported to the --notify-url webhook
+const (
+	notifyStatusPublished = "published"
+	notifyStatusUpdated   = "updated"
+)
+
+// publishNotification is the JSON payload POSTed to the --notify-url webhook after a
+// successful publish
+type publishNotification struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Registry  string `json:"registry"`
+	Timestamp string `json:"timestamp"`
+	Status    string `json:"status"`
+}
+
+// publishWebhook holds the --notify-url flag, a URL that is notified of every successful
+// publish so that other systems can react to it
+type publishWebhook struct {
+	url    string
+	client *http.Client
+}
+
+// register adds the webhook flag to fs
+func (w *publishWebhook) register(fs *flag.FlagSet) {
+	fs.StringVar(&w.url, "notify-url", "", "POST a JSON notification to this URL after each successful publish")
+}
+
+// init checks the webhook URL and creates the client that notifies it, using the same
+// proxy and request timeout as registry requests. It does nothing without --notify-url.
+func (w *publishWebhook) init(proxyURL string, timeout time.Duration) error {
+	if w.url == "" {
+		return nil
+	}
+	parsed, err := url.Parse(w.url)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid --notify-url %s: must be an absolute http or https URL", w.url)
+	}
+
+	if w.client, err = newHTTPClient(proxyURL); err != nil {
+		return err
+	}
+	w.client.Timeout = timeout
+	return nil
+}
+
+// notify POSTs notification to the webhook. Any response other than 2xx is an error.
+func (w *publishWebhook) notify(notification publishNotification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return newRegistryError("notification", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// notifyPublished reports the publish of response to the --notify-url webhook, if one was
+// given. The server is already published at this point, so a failed notification is
+// printed as a warning instead of failing the publish.
+func (p *publisher) notifyPublished(response *apiv0.ServerResponse) {
+	if p.webhook.url == "" {
+		return
+	}
+
+	status := notifyStatusPublished
+	if p.update {
+		status = notifyStatusUpdated
+	}
+	err := p.webhook.notify(publishNotification{
+		Name:      response.Server.Name,
+		Version:   response.Server.Version,
+		Registry:  p.registryURL,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Status:    status,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "⚠️  Failed to notify %s: %v\n", p.webhook.url, err)
+		return
+	}
+	_, _ = fmt.Fprintf(os.Stdout, "✓ Notified %s\n", p.webhook.url)
+}
@@ -0,0 +1,110 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWebhook starts a webhook that records the notifications it receives and responds
+// with status
+func setupWebhook(t *testing.T, status int, notifications *[]map[string]string) *httptest.Server {
+	t.Helper()
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var notification map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+		*notifications = append(*notifications, notification)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(webhook.Close)
+	return webhook
+}
+
+func setupNotifyRegistry(t *testing.T) string {
+	t.Helper()
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+	return registry.URL
+}
+
+func TestPublishCommand_NotifyURL(t *testing.T) {
+	registryURL := setupNotifyRegistry(t)
+	var notifications []map[string]string
+	webhook := setupWebhook(t, http.StatusNoContent, &notifications)
+
+	before := time.Now().UTC().Truncate(time.Second)
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--notify-url", webhook.URL})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, out, "✓ Notified "+webhook.URL)
+
+	require.Len(t, notifications, 1)
+	notification := notifications[0]
+	timestamp, err := time.Parse(time.RFC3339, notification["timestamp"])
+	require.NoError(t, err)
+	assert.False(t, timestamp.Before(before), "timestamp should be the time of the publish")
+	delete(notification, "timestamp")
+	assert.Equal(t, map[string]string{
+		"name":     "com.example/test-server",
+		"version":  "1.0.0",
+		"registry": registryURL,
+		"status":   "published",
+	}, notification)
+}
+
+func TestPublishCommand_NotifyURLFailure(t *testing.T) {
+	setupNotifyRegistry(t)
+	var notifications []map[string]string
+	webhook := setupWebhook(t, http.StatusInternalServerError, &notifications)
+
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--notify-url", webhook.URL})
+	})
+	require.NoError(t, err, "a failed notification should not fail the publish")
+	assert.Len(t, notifications, 1)
+	assert.Contains(t, out, "✓ Successfully published")
+	assert.Contains(t, out, "⚠️  Failed to notify "+webhook.URL+": notification failed: server returned status 500")
+}
+
+func TestPublishCommand_NotifyURLNotCalledOnFailure(t *testing.T) {
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+	var notifications []map[string]string
+	webhook := setupWebhook(t, http.StatusOK, &notifications)
+
+	var err error
+	CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--notify-url", webhook.URL})
+	})
+	require.Error(t, err)
+	assert.Empty(t, notifications)
+}
+
+func TestPublishCommand_InvalidNotifyURL(t *testing.T) {
+	for _, notifyURL := range []string{"hooks.example.com/publish", "ftp://hooks.example.com/publish", "https://"} {
+		t.Run(notifyURL, func(t *testing.T) {
+			err := commands.PublishCommand([]string{"--notify-url", notifyURL})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid --notify-url")
+		})
+	}
+}
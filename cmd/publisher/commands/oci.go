@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	ociScheme = "oci://"
+
+	// ociTitleAnnotation names a layer's file, as set by e.g. `oras push`
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+// isOCIReference reports whether source refers to an OCI artifact rather than a local file
+func isOCIReference(source string) bool {
+	return strings.HasPrefix(source, ociScheme)
+}
+
+// pullOCIServerJSON fetches the server.json layer of the OCI artifact at source,
+// oci://registry/repository[:tag|@digest]. Registry credentials are looked up the way
+// docker does, and requests are sent through client's transport.
+func pullOCIServerJSON(client *http.Client, source string) ([]byte, error) {
+	ref, err := name.ParseReference(strings.TrimPrefix(source, ociScheme))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %s: %w", source, err)
+	}
+
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	opts := []remote.Option{
+		remote.WithContext(context.Background()),
+		remote.WithAuthFromKeychain(authn.DefaultKeychain),
+		remote.WithTransport(transport),
+	}
+
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest for %s: %w", source, err)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest for %s: %w", source, err)
+	}
+
+	layer, err := findServerJSONLayer(manifest.Layers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if layer.Size > maxServerJSONSize {
+		return nil, fmt.Errorf("%s: server.json layer is more than the limit of %d bytes", source, maxServerJSONSize)
+	}
+
+	blob, err := remote.Layer(ref.Context().Digest(layer.Digest.String()), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch server.json layer for %s: %w", source, err)
+	}
+	// The reader verifies the digest of the layer once it is read to the end
+	rc, err := blob.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch server.json layer for %s: %w", source, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxServerJSONSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server.json layer for %s: %w", source, err)
+	}
+	// A blob larger than its declared size is cut off before the digest is verified
+	if len(data) > maxServerJSONSize {
+		return nil, fmt.Errorf("%s: server.json layer is more than the limit of %d bytes", source, maxServerJSONSize)
+	}
+	return data, nil
+}
+
+// findServerJSONLayer picks the layer titled server.json, or the only layer if there is just one
+func findServerJSONLayer(layers []v1.Descriptor) (*v1.Descriptor, error) {
+	for i := range layers {
+		if layers[i].Annotations[ociTitleAnnotation] == "server.json" {
+			return &layers[i], nil
+		}
+	}
+	if len(layers) == 1 {
+		return &layers[0], nil
+	}
+	return nil, fmt.Errorf("artifact has %d layers and none is titled server.json", len(layers))
+}
@@ -0,0 +1,223 @@
+package commands_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockOCIUsername = "oci-user"
+	mockOCIPassword = "oci-pass"
+	mockOCIToken    = "oci-token"
+)
+
+// mockOCIRegistry serves a single artifact whose layer is layer. Manifest and blob
+// requests require a bearer token, which is issued for mockOCIUsername/mockOCIPassword.
+type mockOCIRegistry struct {
+	server       *httptest.Server
+	layerDigest  string
+	blobRequests int
+}
+
+func setupMockOCIRegistry(t *testing.T, layer []byte, servedLayer []byte) *mockOCIRegistry {
+	t.Helper()
+
+	sum := sha256.Sum256(layer)
+	reg := &mockOCIRegistry{layerDigest: "sha256:" + hex.EncodeToString(sum[:])}
+	if servedLayer == nil {
+		servedLayer = layer
+	}
+
+	mux := http.NewServeMux()
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") == "Bearer "+mockOCIToken {
+			return true
+		}
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="mock-registry",scope="repository:mcp/weather:pull"`, reg.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != mockOCIUsername || password != mockOCIPassword {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "mock-registry", r.URL.Query().Get("service"))
+		assert.Equal(t, "repository:mcp/weather:pull", r.URL.Query().Get("scope"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": mockOCIToken})
+	})
+	mux.HandleFunc("/v2/mcp/weather/manifests/1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		assert.Contains(t, r.Header.Get("Accept"), "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"schemaVersion": 2,
+			"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+			"config":        map[string]any{"mediaType": "application/vnd.oci.empty.v1+json", "digest": "sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a", "size": 2},
+			"layers": []map[string]any{
+				{"mediaType": "text/markdown", "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000000", "size": 10, "annotations": map[string]string{"org.opencontainers.image.title": "README.md"}},
+				{"mediaType": "application/json", "digest": reg.layerDigest, "size": len(layer), "annotations": map[string]string{"org.opencontainers.image.title": "server.json"}},
+			},
+		})
+	})
+	mux.HandleFunc("/v2/mcp/weather/blobs/"+reg.layerDigest, func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		reg.blobRequests++
+		_, _ = w.Write(servedLayer)
+	})
+
+	reg.server = httptest.NewServer(mux)
+	t.Cleanup(reg.server.Close)
+	return reg
+}
+
+// reference returns the oci:// reference of the mock artifact
+func (reg *mockOCIRegistry) reference() string {
+	return "oci://" + strings.TrimPrefix(reg.server.URL, "http://") + "/mcp/weather:1.0.0"
+}
+
+// setupDockerConfig writes a docker config.json and points DOCKER_CONFIG at it
+func setupDockerConfig(t *testing.T, config map[string]any) {
+	t.Helper()
+
+	dir := t.TempDir()
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), data, 0600))
+	t.Setenv("DOCKER_CONFIG", dir)
+}
+
+func serverJSONLayer(t *testing.T) []byte {
+	t.Helper()
+	data, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	return data
+}
+
+func TestValidateCommand_OCIReference(t *testing.T) {
+	reg := setupMockOCIRegistry(t, serverJSONLayer(t), nil)
+	host := strings.TrimPrefix(reg.server.URL, "http://")
+	setupDockerConfig(t, map[string]any{
+		"auths": map[string]any{
+			host: map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte(mockOCIUsername + ":" + mockOCIPassword))},
+		},
+	})
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", reg.reference()})
+	})
+	require.NoError(t, err)
+	assert.Contains(t, output, "✅ server.json is valid")
+	assert.Equal(t, 1, reg.blobRequests)
+}
+
+func TestValidateCommand_OCIReferenceCredentialHelper(t *testing.T) {
+	reg := setupMockOCIRegistry(t, serverJSONLayer(t), nil)
+	host := strings.TrimPrefix(reg.server.URL, "http://")
+
+	// Fake docker-credential-mock helper on PATH
+	binDir := t.TempDir()
+	script := fmt.Sprintf("#!/bin/sh\nread server\n[ \"$server\" = %q ] || exit 1\necho '{\"Username\":%q,\"Secret\":%q}'\n", host, mockOCIUsername, mockOCIPassword)
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "docker-credential-mock"), []byte(script), 0700)) //nolint:gosec // Test helper must be executable
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	setupDockerConfig(t, map[string]any{"credHelpers": map[string]string{host: "mock"}})
+
+	err := commands.ValidateCommand([]string{"--offline", reg.reference()})
+	require.NoError(t, err)
+}
+
+func TestPublishCommand_OCIReference(t *testing.T) {
+	reg := setupMockOCIRegistry(t, serverJSONLayer(t), nil)
+	host := strings.TrimPrefix(reg.server.URL, "http://")
+	setupDockerConfig(t, map[string]any{
+		"auths": map[string]any{
+			host: map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte(mockOCIUsername + ":" + mockOCIPassword))},
+		},
+	})
+
+	var published apiv0.ServerJSON
+	registry := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&published)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: published})
+	}, nil)
+	SetupTestToken(t, registry.URL, "test-token")
+
+	err := commands.PublishCommand([]string{reg.reference()})
+	require.NoError(t, err)
+	assert.Equal(t, "com.example/test-server", published.Name)
+}
+
+func TestValidateCommand_OCIReferenceErrors(t *testing.T) {
+	layer := serverJSONLayer(t)
+
+	t.Run("missing credentials", func(t *testing.T) {
+		reg := setupMockOCIRegistry(t, layer, nil)
+		setupDockerConfig(t, map[string]any{})
+
+		err := commands.ValidateCommand([]string{"--offline", reg.reference()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "401 Unauthorized")
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		reg := setupMockOCIRegistry(t, layer, []byte(`{"tampered": true}`))
+		host := strings.TrimPrefix(reg.server.URL, "http://")
+		setupDockerConfig(t, map[string]any{
+			"auths": map[string]any{
+				host: map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte(mockOCIUsername + ":" + mockOCIPassword))},
+			},
+		})
+
+		err := commands.ValidateCommand([]string{"--offline", reg.reference()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error verifying sha256 checksum")
+	})
+
+	t.Run("blob larger than declared", func(t *testing.T) {
+		reg := setupMockOCIRegistry(t, layer, bytes.Repeat([]byte(" "), 2<<20))
+		host := strings.TrimPrefix(reg.server.URL, "http://")
+		setupDockerConfig(t, map[string]any{
+			"auths": map[string]any{
+				host: map[string]string{"auth": base64.StdEncoding.EncodeToString([]byte(mockOCIUsername + ":" + mockOCIPassword))},
+			},
+		})
+
+		err := commands.ValidateCommand([]string{"--offline", reg.reference()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "server.json layer is more than the limit of 1048576 bytes")
+	})
+
+	t.Run("invalid reference", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "oci://registry.example.com/Upper-Case"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid OCI reference")
+	})
+}
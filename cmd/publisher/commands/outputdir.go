@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// reportFileSuffix is appended to the relative path of a validated file to name its report
+// in the --output-dir directory
+const reportFileSuffix = ".report.json"
+
+// reportDir writes a report file for each item of a directory or lockfile validation, for
+// --output-dir. The reports mirror the layout of the validated items below dir. Its methods
+// do nothing on a nil reportDir so callers need not check whether the reports are enabled.
+type reportDir struct {
+	dir string
+	// sarif writes each report as a SARIF log instead of the JSON of --format json
+	sarif bool
+}
+
+// write writes the report of the item at relPath, a slash- or OS-separated path relative
+// to the validated directory or a lockfile server name. source is the validated file, used
+// to find the line of each issue in SARIF reports.
+func (d *reportDir) write(relPath, file string, source []byte, result *validators.ValidationResult) error {
+	if d == nil {
+		return nil
+	}
+	relPath = filepath.FromSlash(relPath)
+	if !filepath.IsLocal(relPath) {
+		return fmt.Errorf("cannot write the report of %s: %s is not a relative path below --output-dir", file, relPath)
+	}
+	path := filepath.Join(d.dir, relPath+reportFileSuffix)
+
+	var data bytes.Buffer
+	if d.sarif {
+		report := newSARIFReport()
+		report.add(file, source, "", result.Issues)
+		if err := report.write(&data); err != nil {
+			return err
+		}
+	} else {
+		encoded, err := json.MarshalIndent(validationReport{
+			Valid:       result.Valid,
+			Issues:      result.Issues,
+			TotalIssues: len(result.Issues),
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize validation result: %w", err)
+		}
+		data.Write(encoded)
+		data.WriteByte('\n')
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	if err := os.WriteFile(path, data.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// serverOverrides holds the --name and --version flags that replace fields of a templated
+// server.json before it is validated or published
+type serverOverrides struct {
+	name    string
+	version string
+}
+
+// register adds the override flags to fs
+func (o *serverOverrides) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.name, "name", "", "Override the name field of server.json")
+	fs.StringVar(&o.version, "version", "", "Override the version field of server.json")
+}
+
+// apply sets the overridden fields on serverJSON and returns the document re-serialized
+// so that the overrides are part of what is sent to the registry. Without overrides,
+// serverData is returned unchanged.
+func (o *serverOverrides) apply(serverData []byte, serverJSON *apiv0.ServerJSON) ([]byte, error) {
+	if o.name == "" && o.version == "" {
+		return serverData, nil
+	}
+
+	if o.version != "" {
+		if result := validators.ValidateVersion(o.version); !result.Valid {
+			return nil, fmt.Errorf("invalid --version: %s", result.Issues[0].Message)
+		}
+		serverJSON.Version = o.version
+	}
+	if o.name != "" {
+		serverJSON.Name = o.name
+	}
+
+	data, err := json.Marshal(serverJSON)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing server.json: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,70 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishCommand_Overrides(t *testing.T) {
+	var sent apiv0.ServerJSON
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: sent})
+	}, nil)
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Name = "com.example/template"
+		s.Version = "0.0.0-template"
+	}))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--name", "com.example/built-server", "--version", "2.1.0"})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "com.example/built-server", sent.Name)
+	assert.Equal(t, "2.1.0", sent.Version)
+	assert.Equal(t, "A test server", sent.Description, "other fields should be sent unchanged")
+	assert.Contains(t, output, "Server com.example/built-server version 2.1.0")
+}
+
+func TestValidateCommand_Overrides(t *testing.T) {
+	var sent apiv0.ServerJSON
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion))
+
+	t.Run("overridden fields are validated", func(t *testing.T) {
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--version", "1.2.3", "--name", "com.example/other"})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3", sent.Version)
+		assert.Equal(t, "com.example/other", sent.Name)
+	})
+
+	t.Run("version must be specific", func(t *testing.T) {
+		for _, version := range []string{"^1.0.0", "1.x", "latest"} {
+			err := commands.ValidateCommand([]string{"--version", version})
+			require.Error(t, err, version)
+			assert.Contains(t, err.Error(), "invalid --version", version)
+		}
+
+		err := commands.PublishCommand([]string{"--version", ">=2.0.0"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --version")
+	})
+}
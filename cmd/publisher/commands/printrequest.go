@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// requestPrinter is an http.RoundTripper that prints an equivalent curl command for each
+// request before sending it, so that issues can be reproduced outside the CLI
+type requestPrinter struct {
+	next     http.RoundTripper
+	out      io.Writer
+	bodyFile string
+}
+
+// printRequests makes client print every request it sends to out as a curl command.
+// Request bodies are referenced as bodyFile rather than inlined.
+func printRequests(client *http.Client, out io.Writer, bodyFile string) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &requestPrinter{next: next, out: out, bodyFile: bodyFile}
+}
+
+// requestBodyFile returns the file that printed requests reference as their body
+func requestBodyFile(serverFile string) string {
+	if isOCIReference(serverFile) {
+		return "server.json"
+	}
+	return serverFile
+}
+
+func (p *requestPrinter) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, _ = fmt.Fprintln(p.out, curlCommand(req, p.bodyFile))
+	return p.next.RoundTrip(req)
+}
+
+// curlCommand renders req as a curl command line with the Authorization header redacted
+func curlCommand(req *http.Request, bodyFile string) string {
+	parts := []string{"curl -X " + req.Method + " " + shellQuote(req.URL.String())}
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			if strings.EqualFold(name, "Authorization") {
+				value = redactCredentials(value)
+			}
+			parts = append(parts, "-H "+shellQuote(name+": "+value))
+		}
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		parts = append(parts, "--data-binary "+shellQuote("@"+bodyFile))
+	}
+
+	return strings.Join(parts, " \\\n  ")
+}
+
+// redactCredentials hides the credentials of an Authorization header value, keeping the
+// scheme (e.g. "Bearer <redacted>")
+func redactCredentials(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " <redacted>"
+	}
+	return "<redacted>"
+}
+
+// shellQuote quotes s for POSIX shells
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,43 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishCommand_PrintRequest(t *testing.T) {
+	server := SetupMockRegistryServer(t, nil, nil)
+	SetupTestToken(t, server.URL, "secret-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--print-request"})
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "curl -X POST '"+server.URL+"/v0/publish'")
+	assert.Contains(t, output, "-H 'Authorization: Bearer <redacted>'")
+	assert.Contains(t, output, "-H 'Content-Type: application/json'")
+	assert.Contains(t, output, "--data-binary '@server.json'")
+	assert.NotContains(t, output, "secret-token")
+}
+
+func TestValidateCommand_PrintRequest(t *testing.T) {
+	server := SetupMockRegistryServer(t, nil, nil)
+	SetupTestToken(t, server.URL, "secret-token")
+	_, serverFile := CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--print-request", serverFile})
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "curl -X POST '"+server.URL+"/v0/validate'")
+	assert.Contains(t, output, "--data-binary '@"+serverFile+"'")
+	assert.NotContains(t, output, "secret-token")
+}
@@ -1,139 +1,623 @@
 package commands
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/modelcontextprotocol/registry/internal/validators"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 )
 
 func PublishCommand(args []string) error {
-	// Check for server.json file
-	serverFile := "server.json"
-	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
-		serverFile = args[0]
-	}
+	// Parse command flags
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	proxy := fs.String("proxy", "", "Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+	p := &publisher{stdin: bufio.NewReader(os.Stdin)}
+	fs.BoolVar(&p.update, "update", false, "Replace the already published version instead of publishing a new one (requires edit permission)")
+	fs.BoolVar(&p.yes, "yes", false, "Skip the confirmation prompt when publishing to a production registry")
+	fs.BoolVar(&p.yes, "y", false, "Skip the confirmation prompt when publishing to a production registry (shorthand)")
+	fs.BoolVar(&p.noPrerelease, "no-prerelease", false, "Refuse to publish a pre-release version (e.g. 1.0.0-rc.1) to a production registry")
+	fs.BoolVar(&p.checkDowngrade, "check-downgrade", false, "Refuse to publish a version lower than the latest published version of the server")
+	fs.BoolVar(&p.authValidate, "auth-validate", false, "Send the token with the validate request that explains a rejected publish")
+	printRequest := fs.Bool("print-request", false, "Print each registry request as an equivalent curl command")
+	fs.BoolVar(&p.wait, "wait", false, "Wait until the published version can be read back from the registry")
+	timeout := fs.Duration("timeout", defaultRequestTimeout, "Timeout for each registry request (0 for none)")
+	apiPrefixFlag := fs.String("api-prefix", "", "Path below the registry URL where its API is mounted (default: v0)")
+	fs.DurationVar(&p.registryTimeout, "registry-timeout", defaultRegistryTimeout, "Overall time allowed for --wait (0 for none)")
+	registryFlag := fs.String("registry", "", "Publish to this registry URL or alias from the config file (default: the logged-in registry)")
+	continueOnError := fs.Bool("continue-on-error", false, "When publishing several files, keep going after one fails")
+	resume := fs.String("resume", "", "Record published files in this state file and skip files it already lists")
+	p.tokens.register(fs)
+	p.overrides.register(fs)
+	p.template.register(fs)
+	p.notes.register(fs)
+	p.dump.register(fs)
+	p.webhook.register(fs)
+	p.archive.register(fs)
+	p.input.register(fs)
+	p.merge.register(fs)
 
-	// Read server.json
-	serverData, err := os.ReadFile(serverFile)
+	positional, err := parseFlags(fs, args)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("server.json not found. Run 'mcp-publisher init' to create one")
+		return err
+	}
+	if err := p.input.init(&p.template); err != nil {
+		return err
+	}
+	if err := checkTimeouts(*timeout, p.registryTimeout); err != nil {
+		return err
+	}
+	if err := p.webhook.init(*proxy, *timeout); err != nil {
+		return err
+	}
+	if err := p.notes.load(p.update); err != nil {
+		return err
+	}
+	if p.apiPrefix, err = resolveAPIPrefix(*apiPrefixFlag); err != nil {
+		return err
+	}
+	if *registryFlag != "" {
+		if p.registry, err = resolveRegistryURL(*registryFlag); err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to read server.json: %w", err)
 	}
-	if err := validateJSONUnicode(serverFile, serverData); err != nil {
+	if positional, err = p.serverFiles(positional); err != nil {
 		return err
 	}
 
-	// Validate JSON
-	var serverJSON apiv0.ServerJSON
-	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
-		return fmt.Errorf("invalid server.json: %w", err)
+	p.client, err = newHTTPClient(*proxy)
+	if err != nil {
+		return err
+	}
+	p.client.Timeout = *timeout
+	if *printRequest {
+		printRequests(p.client, os.Stdout, "")
 	}
 
-	// Load saved token
-	tokenPath, err := tokenFilePath()
-	if err != nil {
+	var state *publishState
+	if *resume != "" {
+		if state, err = loadPublishState(*resume); err != nil {
+			return err
+		}
+	}
+
+	if len(positional) == 1 && state == nil {
+		_, err := p.publish(positional[0])
 		return err
 	}
+	return p.publishAll(positional, *continueOnError, state)
+}
+
+// publisher publishes server.json files with the settings of one publish command
+type publisher struct {
+	client          *http.Client
+	apiPrefix       string
+	tokens          tokenSource
+	registry        string
+	update          bool
+	yes             bool
+	noPrerelease    bool
+	checkDowngrade  bool
+	authValidate    bool
+	wait            bool
+	registryTimeout time.Duration
+	overrides       serverOverrides
+	template        serverTemplate
+	notes           releaseNotes
+	dump            bodyDump
+	webhook         publishWebhook
+	archive         serverArchive
+	input           inputFormat
+	merge           serverMerge
+	// stdin reads the answers to confirmation prompts. Every file of the command shares it,
+	// so answers piped for several files are not lost in the buffer of a discarded reader.
+	stdin *bufio.Reader
+
+	// token and registryURL are loaded on first use, after the first server.json is read
+	token       string
+	registryURL string
+}
+
+// serverFiles returns the server.json files to publish given as positional arguments, or
+// ./server.json if there are none. With --merge, the arguments are fragments of a single
+// server.json, which is published under the name of the first.
+func (p *publisher) serverFiles(positional []string) ([]string, error) {
+	if err := p.merge.init(positional); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(positional) == 0:
+		return []string{"server.json"}, nil
+	case p.merge.enabled:
+		return positional[:1], nil
+	case len(positional) > 1 && p.overrides.name != "":
+		return nil, errors.New("--name cannot be used when publishing several files")
+	case len(positional) > 1 && p.dump.enabled():
+		return nil, errors.New("--dump-body and --dump-body-redacted cannot be used when publishing several files")
+	default:
+		return positional, nil
+	}
+}
 
-	tokenData, err := os.ReadFile(tokenPath)
+// publish publishes a single server.json and returns the published server
+func (p *publisher) publish(serverFile string) (*apiv0.ServerResponse, error) {
+	if printer, ok := p.client.Transport.(*requestPrinter); ok {
+		printer.bodyFile = requestBodyFile(serverFile)
+		if p.dump.path != "" {
+			// The dumped body is the exact body sent, including release notes
+			printer.bodyFile = p.dump.path
+		}
+	}
+
+	// Read server.json
+	serverData, serverJSON, err := p.readServer(serverFile)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return notAuthenticatedError()
+		return nil, err
+	}
+
+	// Load saved token
+	if p.token == "" {
+		if p.token, p.registryURL, err = p.tokens.load(); err != nil {
+			return nil, err
+		}
+		if p.registryURL, err = p.selectRegistry(p.registryURL); err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("failed to read token: %w", err)
 	}
+	client, registryURL, apiPrefix := p.client, p.registryURL, p.apiPrefix
 
-	var tokenInfo map[string]string
-	if err := json.Unmarshal(tokenData, &tokenInfo); err != nil {
-		return fmt.Errorf("invalid token data: %w", err)
+	// Warn if the name is already published with different casing
+	if issue := checkNameCasingConflict(client, registryURL, apiPrefix, serverJSON.Name); issue != nil {
+		printValidationWarning(*issue)
 	}
 
-	token := tokenInfo["token"]
-	registryURL := tokenInfo["registry"]
-	if registryURL == "" {
-		registryURL = DefaultRegistryURL
+	if err := checkProductionRules(serverJSON, registryURL, p.noPrerelease); err != nil {
+		return nil, err
+	}
+
+	// Replacing an older version with --update is not a downgrade
+	if p.checkDowngrade && !p.update {
+		if err := checkDowngrade(client, registryURL, apiPrefix, serverJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := confirmPublish(serverJSON, registryURL, p.yes, p.stdin); err != nil {
+		return nil, err
+	}
+
+	body, err := p.requestBody(serverData)
+	if err != nil {
+		return nil, err
 	}
 
 	// Publish to registry
 	_, _ = fmt.Fprintf(os.Stdout, "Publishing to %s...\n", registryURL)
-	response, statusCode, err := publishToRegistry(registryURL, serverData, token)
+	response, statusCode, err := publishToRegistry(client, registryURL, apiPrefix, body, serverJSON, p.token, p.update)
 	if err != nil {
+		if isVersionConflict(statusCode, err) {
+			return nil, fmt.Errorf("version %s of %s already exists; bump the version or use --update", serverJSON.Version, serverJSON.Name)
+		}
+
 		// If publish failed with 422, call validate endpoint to show detailed errors
 		if statusCode == http.StatusUnprocessableEntity {
-			_, _ = fmt.Fprintln(os.Stdout, "Validation failed. Checking detailed validation errors...")
-			_, _ = fmt.Fprintln(os.Stdout)
-
-			// Call validate endpoint (same as validate command does)
-			result, validateErr := validateViaAPI(registryURL, serverData)
-			if validateErr != nil {
-				// If validate also fails, return original publish error
-				return fmt.Errorf("publish failed: %w", err)
-			}
-
-			// Print validation results using shared formatting logic
-			formattedErrorMsg := printValidationIssues(result, &serverJSON)
-
-			if !result.Valid {
-				// Return error with formatted message if available
-				if formattedErrorMsg != "" {
-					return fmt.Errorf("%s", formattedErrorMsg)
-				}
-				return fmt.Errorf("validation failed")
-			}
+			return nil, explainRejectedPublish(client, registryURL, apiPrefix, p.validateToken(), serverData, serverJSON, err)
 		}
 
 		// For non-422 errors, return the original error
-		return fmt.Errorf("publish failed: %w", err)
+		return nil, operationFailed("publish", err)
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "✓ Successfully published")
 	_, _ = fmt.Fprintf(os.Stdout, "✓ Server %s version %s\n", response.Server.Name, response.Server.Version)
+	p.notifyPublished(response)
+
+	if p.wait {
+		_, _ = fmt.Fprintln(os.Stdout, "Waiting for the registry to index the new version...")
+		if err := waitForIndexing(client, registryURL, apiPrefix, serverJSON.Name, serverJSON.Version, p.registryTimeout); err != nil {
+			return nil, err
+		}
+		_, _ = fmt.Fprintln(os.Stdout, "✓ Version is available from the registry")
+	}
+
+	return response, nil
+}
+
+// requestBody returns the publish request body for serverData and saves it to the
+// --dump-body files. They are written before the request is sent, so that they are
+// available when the publish fails.
+func (p *publisher) requestBody(serverData []byte) ([]byte, error) {
+	body, err := newPublishBody(serverData, p.notes.text)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.dump.write(body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// selectRegistry returns the registry given by --registry, or tokenRegistry, the registry
+// the token is for, if it was not given. A saved login is only valid for the registry it
+// was issued for, so a different --registry requires the token to be passed on stdin.
+func (p *publisher) selectRegistry(tokenRegistry string) (string, error) {
+	if p.registry == "" || p.registry == strings.TrimRight(tokenRegistry, "/") {
+		return tokenRegistry, nil
+	}
+	if !p.tokens.stdin {
+		return "", fmt.Errorf("the saved token is for %s, not %s; run 'mcp-publisher login <method> --registry %s' or pass a token for it with --token-stdin", tokenRegistry, p.registry, p.registry)
+	}
+	return p.registry, nil
+}
+
+// publishAll publishes several server.json files in order and prints the outcome of each.
+// It stops at the first failure unless continueOnError is set. When some files were
+// published and others were not, the error has exit code ExitPartialSuccess. Files listed
+// in state were published by an earlier run and are skipped; each newly published file is
+// added to it.
+func (p *publisher) publishAll(serverFiles []string, continueOnError bool, state *publishState) error {
+	outcomes := make([]string, len(serverFiles))
+	published, resumed, failed := 0, 0, 0
+	var firstErr error
+	for i, serverFile := range serverFiles {
+		if state.done(serverFile) {
+			resumed++
+			outcomes[i] = "- already published (--resume)"
+			continue
+		}
+		if firstErr != nil && !continueOnError {
+			outcomes[i] = "- skipped"
+			continue
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "==> %s\n", serverFile)
+		response, err := p.publish(serverFile)
+		_, _ = fmt.Fprintln(os.Stdout)
+		if err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", serverFile, err)
+			}
+			if continueOnError {
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n\n", serverFile, err)
+			}
+			message, _, _ := strings.Cut(err.Error(), "\n")
+			outcomes[i] = "✗ " + message
+			continue
+		}
+		published++
+		outcomes[i] = fmt.Sprintf("✓ %s version %s", response.Server.Name, response.Server.Version)
+		if err := state.record(serverFile); err != nil {
+			return fmt.Errorf("%s was published, but %w", serverFile, err)
+		}
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "Summary:")
+	for i, serverFile := range serverFiles {
+		_, _ = fmt.Fprintf(os.Stdout, "  %s  %s\n", serverFile, outcomes[i])
+	}
+	if resumed > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Published %d of %d server(s), %d already published\n", published, len(serverFiles), resumed)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "Published %d of %d server(s)\n", published, len(serverFiles))
+	}
+
+	switch {
+	case failed == 0:
+		return nil
+	case published+resumed == 0:
+		if continueOnError {
+			return fmt.Errorf("failed to publish all %d server(s)", len(serverFiles))
+		}
+		return firstErr
+	default:
+		return &ExitError{
+			Code: ExitPartialSuccess,
+			Err:  fmt.Errorf("published %d of %d server(s); %d failed: %w", published+resumed, len(serverFiles), failed, firstErr),
+		}
+	}
+}
+
+// validateToken returns the token sent to the validate endpoint: the publish token with
+// --auth-validate, and none otherwise
+func (p *publisher) validateToken() string {
+	if !p.authValidate {
+		return ""
+	}
+	return p.token
+}
+
+// explainRejectedPublish calls the validate endpoint after the registry rejected a publish
+// as unprocessable, printing the detailed validation errors. publishErr is returned if the
+// errors cannot be determined.
+func explainRejectedPublish(client *http.Client, registryURL, apiPrefix, token string, serverData []byte, serverJSON *apiv0.ServerJSON, publishErr error) error {
+	_, _ = fmt.Fprintln(os.Stdout, "Validation failed. Checking detailed validation errors...")
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	result, err := validateRejectedPublish(client, registryURL, apiPrefix, token, serverData, serverJSON)
+	if err != nil {
+		// If validate also fails, return original publish error
+		return operationFailed("publish", publishErr)
+	}
+
+	// Print validation results using shared formatting logic
+	formattedErrorMsg := printValidationIssues(result, serverJSON, issueListOptions{})
+
+	if !result.Valid {
+		// Return error with formatted message if available
+		if formattedErrorMsg != "" {
+			return fmt.Errorf("%s", formattedErrorMsg)
+		}
+		return fmt.Errorf("validation failed")
+	}
+	return operationFailed("publish", publishErr)
+}
+
+// validateRejectedPublish validates server.json for explainRejectedPublish, keeping going
+// until it has issues to show: a validate request failing with a server error is retried
+// with the delays of a 429 response (see retryDelay), and registries without the endpoint
+// or whose endpoint keeps failing are replaced by local validation.
+func validateRejectedPublish(client *http.Client, registryURL, apiPrefix, token string, serverData []byte, serverJSON *apiv0.ServerJSON) (*validators.ValidationResult, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := validateViaAPI(client, registryURL, apiPrefix, token, nil, serverData)
+		if errors.Is(err, errValidateUnsupported) {
+			_, _ = fmt.Fprintln(os.Stdout, validateUnsupportedNotice)
+			return validateLocally(serverJSON)
+		}
+		var regErr *registryError
+		if !errors.As(err, &regErr) || regErr.StatusCode < http.StatusInternalServerError {
+			return result, err
+		}
+
+		delay, ok := retryDelay(regErr.RetryAfter, attempt, time.Now())
+		if !ok || attempt == maxRetries {
+			_, _ = fmt.Fprintln(os.Stdout, validateFailedNotice)
+			return validateLocally(serverJSON)
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "Validate request failed with status %d; retrying in %s...\n", regErr.StatusCode, delay)
+		_ = sleepContext(context.Background(), delay)
+	}
+}
+
+// readServer reads and parses the server.json to publish, merging the fragments given with
+// --merge, and applies any overrides
+func (p *publisher) readServer(serverFile string) ([]byte, *apiv0.ServerJSON, error) {
+	serverData, err := p.merge.read(serverFile, p.readServerData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Validate JSON
+	var serverJSON apiv0.ServerJSON
+	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
+		return nil, nil, fmt.Errorf("invalid server.json: %w", err)
+	}
+
+	serverData, err = p.overrides.apply(serverData, &serverJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serverData, &serverJSON, nil
+}
+
+// readServerData reads a server.json file, renders it if it is a template and converts it
+// to JSON if it is YAML or JSON Lines
+func (p *publisher) readServerData(serverFile string) ([]byte, error) {
+	if serverFile == stdinSource && p.tokens.stdin {
+		return nil, errors.New("--token-stdin cannot be combined with reading server.json from stdin")
+	}
+	serverData, err := readPublishSource(p.client, serverFile, &p.archive)
+	if err != nil {
+		return nil, err
+	}
+	if serverData, err = p.template.render(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if serverData, err = p.input.decode(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if serverData, err = prepareJSONInput(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if err := checkServerResponse(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	return serverData, nil
+}
+
+// readPublishSource reads the server.json to publish, either from a local file, stdin, a
+// member of a .tar.gz or .tgz archive or an oci:// artifact reference
+func readPublishSource(client *http.Client, serverFile string, archive *serverArchive) ([]byte, error) {
+	if err := archive.check(serverFile); err != nil {
+		return nil, err
+	}
+	if isOCIReference(serverFile) {
+		return pullOCIServerJSON(client, serverFile)
+	}
+	if isArchive(serverFile) {
+		return archive.read(serverFile)
+	}
+	if serverFile == stdinSource {
+		return readStdin()
+	}
+
+	serverData, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("server.json not found. Run 'mcp-publisher init' to create one")
+		}
+		return nil, fmt.Errorf("failed to read server.json: %w", err)
+	}
+	return serverData, nil
+}
+
+// checkProductionRules runs the checks that only apply when publishing to a production
+// registry, each of which returns early for other registries
+func checkProductionRules(serverJSON *apiv0.ServerJSON, registryURL string, noPrerelease bool) error {
+	if err := checkPrerelease(serverJSON, registryURL, noPrerelease); err != nil {
+		return err
+	}
+	if err := checkReservedNamespace(serverJSON, registryURL); err != nil {
+		return err
+	}
+	return checkPublicRemoteURLs(serverJSON, registryURL)
+}
+
+// checkPrerelease refuses pre-release versions for production registries when forbidden by
+// --no-prerelease or the noPrerelease config setting. Other registries accept them.
+func checkPrerelease(serverJSON *apiv0.ServerJSON, registryURL string, noPrerelease bool) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if (!noPrerelease && !config.NoPrerelease) || !config.isProductionRegistry(registryURL) {
+		return nil
+	}
+
+	result := validators.ValidatePrereleaseVersion(serverJSON)
+	if result.Valid {
+		return nil
+	}
+	issue := result.Issues[0]
+	return fmt.Errorf("%s [%s]; pre-releases cannot be published to the production registry %s", issue.Message, issue.Reference, registryURL)
+}
+
+// checkReservedNamespace refuses names under a reserved namespace, such as com.example, for
+// production registries. Other registries, e.g. a local one for testing, accept them.
+func checkReservedNamespace(serverJSON *apiv0.ServerJSON, registryURL string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if !config.isProductionRegistry(registryURL) {
+		return nil
+	}
+
+	result := validators.ValidateReservedNamespace(serverJSON, validators.DefaultReservedNamespaces)
+	if result.Valid {
+		return nil
+	}
+	issue := result.Issues[0]
+	return fmt.Errorf("%s [%s]; it cannot be published to the production registry %s", issue.Message, issue.Reference, registryURL)
+}
+
+// checkPublicRemoteURLs refuses remote URLs that point at localhost or resolve to a
+// loopback, private or link-local address for production registries, whose listings are
+// public. Each host name lookup is bounded by a timeout, and hosts that cannot be resolved
+// are accepted.
+func checkPublicRemoteURLs(serverJSON *apiv0.ServerJSON, registryURL string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if !config.isProductionRegistry(registryURL) {
+		return nil
+	}
+
+	result := validators.ValidateRemoteURLsPublic(context.Background(), serverJSON, net.DefaultResolver)
+	if result.Valid {
+		return nil
+	}
+	issue := result.Issues[0]
+	return fmt.Errorf("%s: %s [%s]; it cannot be published to the production registry %s", issue.Path, issue.Message, issue.Reference, registryURL)
+}
+
+// confirmPublish prints what is about to be published and, for production registries,
+// asks the user to confirm on stdin unless skipConfirm is set.
+func confirmPublish(serverJSON *apiv0.ServerJSON, registryURL string, skipConfirm bool, stdin *bufio.Reader) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "About to publish:")
+	_, _ = fmt.Fprintf(os.Stdout, "  Name:     %s\n", serverJSON.Name)
+	_, _ = fmt.Fprintf(os.Stdout, "  Version:  %s\n", serverJSON.Version)
+	_, _ = fmt.Fprintf(os.Stdout, "  Registry: %s\n", registryURL)
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	if skipConfirm || !config.isProductionRegistry(registryURL) {
+		return nil
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, "This is a production registry. Continue? [y/N] ")
+	response, err := stdin.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || response == "") {
+		return fmt.Errorf("failed to read response (use --yes to publish non-interactively): %w", err)
+	}
+	response = strings.TrimSpace(strings.ToLower(response))
+	if response != "y" && response != "yes" {
+		return errors.New("publish cancelled")
+	}
 
 	return nil
 }
 
-func publishToRegistry(registryURL string, serverData []byte, token string) (*apiv0.ServerResponse, int, error) {
+// isVersionConflict reports whether a failed publish was rejected because the version
+// already exists. Registries answer with 409, or with 400 and a duplicate version message.
+func isVersionConflict(statusCode int, err error) bool {
+	if statusCode == http.StatusConflict {
+		return true
+	}
+	return statusCode == http.StatusBadRequest && strings.Contains(err.Error(), "cannot publish duplicate version")
+}
+
+// newPublishBody returns the JSON body of the publish request for serverData, with the
+// release notes alongside the server.json fields
+func newPublishBody(serverData []byte, notes string) ([]byte, error) {
 	if err := validateJSONUnicode("server.json", serverData); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	// Parse the server JSON data
 	var serverJSON apiv0.ServerJSON
 	err := json.Unmarshal(serverData, &serverJSON)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error parsing server.json file: %w", err)
+		return nil, fmt.Errorf("error parsing server.json file: %w", err)
 	}
 
-	// Convert to JSON
-	jsonData, err := json.Marshal(serverJSON)
+	jsonData, err := json.Marshal(apiv0.PublishRequest{ServerJSON: serverJSON, ReleaseNotes: notes})
 	if err != nil {
-		return nil, 0, fmt.Errorf("error serializing request: %w", err)
+		return nil, fmt.Errorf("error serializing request: %w", err)
 	}
+	return jsonData, nil
+}
 
+// publishToRegistry sends requestBody, as returned by newPublishBody for serverJSON, to the
+// registry's publish endpoint, or to its edit endpoint with update
+func publishToRegistry(client *http.Client, registryURL, apiPrefix string, requestBody []byte, serverJSON *apiv0.ServerJSON, token string, update bool) (*apiv0.ServerResponse, int, error) {
 	// Ensure URL ends with the publish endpoint
 	if !strings.HasSuffix(registryURL, "/") {
 		registryURL += "/"
 	}
-	publishURL := registryURL + "v0/publish"
+	method := http.MethodPost
+	publishURL := registryURL + apiPrefix + "publish"
+	if update {
+		// Replace the existing version via the edit endpoint
+		method = http.MethodPut
+		publishURL = registryURL + apiPrefix + "servers/" + url.PathEscape(serverJSON.Name) + "/versions/" + url.PathEscape(serverJSON.Version)
+	}
 
 	// Create and send request
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, publishURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(context.Background(), method, publishURL, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, 0, fmt.Errorf("error creating request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error sending request: %w", err)
@@ -147,7 +631,7 @@ func publishToRegistry(registryURL string, serverData []byte, token string) (*ap
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return nil, resp.StatusCode, fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
+		return nil, resp.StatusCode, newRegistryError("publish", resp.StatusCode, body)
 	}
 
 	var serverResponse apiv0.ServerResponse
@@ -2,10 +2,13 @@ package commands_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
@@ -81,6 +84,129 @@ func TestPublishCommand_RejectsInvalidUTF8ServerJSON(t *testing.T) {
 	assert.Contains(t, err.Error(), "UTF-8")
 }
 
+func TestPublishCommand_RejectsServerResponse(t *testing.T) {
+	data, err := json.Marshal(apiv0.ServerResponse{Server: testServerJSON()})
+	require.NoError(t, err)
+	createRawServerJSON(t, data)
+
+	err = commands.PublishCommand([]string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server.json looks like a registry API response rather than a server.json")
+}
+
+func TestPublishCommand_StripsByteOrderMark(t *testing.T) {
+	var sent apiv0.ServerJSON
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: sent})
+	}, nil)
+	SetupTestToken(t, server.URL, "test-token")
+
+	data, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	createRawServerJSON(t, append([]byte{0xEF, 0xBB, 0xBF}, data...))
+
+	_ = CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "com.example/test-server", sent.Name)
+}
+
+func TestValidateCommand_InvalidUTF8Offset(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"invalid byte", []byte("{\"name\":\"bad \xff\"}"), "server.json contains invalid UTF-8 at offset 13"},
+		{"truncated sequence", []byte("{\"name\":\"\xe2\x80\"}"), "server.json contains invalid UTF-8 at offset 9"},
+		{"offset includes byte order mark", []byte("\xef\xbb\xbf{\"name\":\"\xff\"}"), "server.json contains invalid UTF-8 at offset 12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createRawServerJSON(t, tt.data)
+
+			err := commands.ValidateCommand([]string{"--offline"})
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+		})
+	}
+}
+
+func TestValidateCommand_TrailingData(t *testing.T) {
+	valid, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"clean file", append(append([]byte{}, valid...), "\n\n"...), ""},
+		{"second object", append(append([]byte{}, valid...), "\n{\"name\":\"other\"}\n"...), fmt.Sprintf("server.json has unexpected data after JSON object at offset %d", len(valid)+1)},
+		{"garbage", append(append([]byte{}, valid...), " oops"...), fmt.Sprintf("server.json has unexpected data after JSON object at offset %d", len(valid)+1)},
+		{"offset includes byte order mark", append(append([]byte("\xef\xbb\xbf"), valid...), "}"...), fmt.Sprintf("server.json has unexpected data after JSON object at offset %d", len(valid)+3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createRawServerJSON(t, tt.data)
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--offline"})
+			})
+			if tt.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+		})
+	}
+}
+
+func TestValidateCommand_TopLevelNotObject(t *testing.T) {
+	valid, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"array", []byte("[" + string(valid) + "]"), "invalid server.json: expected a JSON object at the top level, got an array"},
+		{"string", []byte(`"server.json"`), "invalid server.json: expected a JSON object at the top level, got a string"},
+		{"number", []byte(" 42\n"), "invalid server.json: expected a JSON object at the top level, got a number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createRawServerJSON(t, tt.data)
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--offline"})
+			})
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+		})
+	}
+
+	t.Run("publish", func(t *testing.T) {
+		server := SetupMockRegistryServer(t, nil, nil)
+		SetupTestToken(t, server.URL, "test-token")
+		createRawServerJSON(t, []byte("[]"))
+
+		err := commands.PublishCommand([]string{})
+		require.Error(t, err)
+		assert.Equal(t, "invalid server.json: expected a JSON object at the top level, got an array", err.Error())
+	})
+}
+
 func TestPublishCommand_RejectsUnpairedSurrogateEscape(t *testing.T) {
 	createRawServerJSON(t, []byte(`{"$schema":"","name":"com.example/test","description":"bad \udc94","version":"1.0.0"}`))
 
@@ -222,6 +348,102 @@ func TestPublishCommand_422WithMultipleIssues(t *testing.T) {
 	assert.Equal(t, 1, validateCallCount, "validate endpoint should be called")
 }
 
+func TestPublishCommand_422WithoutValidateEndpoint(t *testing.T) {
+	validateCallCount := 0
+	server := SetupMockRegistryServer(t,
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"Failed to publish server, invalid schema"}`))
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			validateCallCount++
+			http.NotFound(w, r)
+		},
+	)
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "^1.0.0", // Invalid version range
+	})
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{})
+	})
+
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "404")
+	assert.Equal(t, 1, validateCallCount, "validate endpoint should be called")
+	assert.Contains(t, output, "registry does not support validation; validated locally instead")
+	assert.Contains(t, output, "version-looks-like-range", "the offline checks should explain the rejection")
+}
+
+func TestPublishCommand_422WithValidateServerError(t *testing.T) {
+	rejectPublish := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message":"Failed to publish server, invalid schema"}`))
+	}
+	invalidServerJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "^1.0.0", // Invalid version range
+	}
+
+	t.Run("keeps failing", func(t *testing.T) {
+		validateCallCount := 0
+		server := SetupMockRegistryServer(t, rejectPublish, func(w http.ResponseWriter, _ *http.Request) {
+			validateCallCount++
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, invalidServerJSON)
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{})
+		})
+
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "503")
+		assert.Equal(t, 4, validateCallCount, "the validate request should be retried")
+		assert.Contains(t, output, "Validate request failed with status 503; retrying in 0s...")
+		assert.Contains(t, output, "registry validation kept failing; validated locally instead")
+		assert.Contains(t, output, "version-looks-like-range", "the offline checks should explain the rejection")
+	})
+
+	t.Run("recovers", func(t *testing.T) {
+		validateCallCount := 0
+		server := SetupMockRegistryServer(t, rejectPublish, func(w http.ResponseWriter, _ *http.Request) {
+			validateCallCount++
+			if validateCallCount == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: false, Issues: []validators.ValidationIssue{
+				validators.NewValidationIssue(validators.ValidationIssueTypeSemantic, "/version", "reported by the registry", validators.ValidationIssueSeverityError, "version-looks-like-range"),
+			}})
+		})
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, invalidServerJSON)
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{})
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, 2, validateCallCount)
+		assert.Contains(t, output, "reported by the registry")
+		assert.NotContains(t, output, "validated locally instead")
+	})
+}
+
 func TestPublishCommand_NoToken(t *testing.T) {
 	// Don't create a token file
 	serverJSON := apiv0.ServerJSON{
@@ -427,3 +649,916 @@ func TestPublishCommand_DeprecatedSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishCommand_NameCasingConflict(t *testing.T) {
+	tests := []struct {
+		name         string
+		existingName string
+		expectWarn   bool
+	}{
+		{
+			name:         "differently-cased existing name warns",
+			existingName: "Com.Example/Test-Server",
+			expectWarn:   true,
+		},
+		{
+			name:         "identically-cased existing name does not warn",
+			existingName: "com.example/test-server",
+			expectWarn:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v0/servers", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "com.example/test-server", r.URL.Query().Get("search"))
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(apiv0.ServerListResponse{
+					Servers: []apiv0.ServerResponse{
+						{Server: apiv0.ServerJSON{Name: tt.existingName, Version: "0.9.0"}},
+					},
+				})
+			})
+			mux.HandleFunc("/v0/publish", func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+				})
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+			})
+
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.PublishCommand([]string{})
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, publishCallCount, "publish should proceed despite the warning")
+			if tt.expectWarn {
+				assert.Contains(t, output, "name-casing-conflict")
+				assert.Contains(t, output, "Com.Example/Test-Server")
+			} else {
+				assert.NotContains(t, output, "name-casing-conflict")
+			}
+		})
+	}
+}
+
+func TestPublishCommand_ProductionConfirmationSeveralFiles(t *testing.T) {
+	var published []string
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var serverJSON apiv0.ServerJSON
+		_ = json.NewDecoder(r.Body).Decode(&serverJSON)
+		published = append(published, serverJSON.Name)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: serverJSON})
+	}, nil)
+	SetupTestToken(t, server.URL, "test-token")
+	SetupTestConfig(t, commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}})
+
+	dir := t.TempDir()
+	var files []string
+	for _, name := range []string{"a", "b"} {
+		data, err := json.Marshal(testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "io.github.example/" + name }))
+		require.NoError(t, err)
+		file := filepath.Join(dir, name+".json")
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		files = append(files, file)
+	}
+	SetStdin(t, "y\ny\n")
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.PublishCommand(files)
+	})
+	require.NoError(t, err, "each prompt should read its own piped answer")
+	assert.Equal(t, 2, strings.Count(output, "Continue? [y/N]"))
+	assert.Equal(t, []string{"io.github.example/a", "io.github.example/b"}, published)
+}
+
+// withUnreservedName names the server outside the reserved com.example namespace, which
+// registries configured as production refuse
+func withUnreservedName(s *apiv0.ServerJSON) {
+	s.Name = "io.github.example/test-server"
+}
+
+func TestPublishCommand_ProductionConfirmation(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          *commands.PublisherConfig
+		args            []string
+		stdin           string
+		expectError     string
+		expectPublished bool
+		expectPrompt    bool
+	}{
+		{
+			name:            "non-production registry is not prompted",
+			stdin:           "",
+			expectPublished: true,
+		},
+		{
+			name:            "confirmed prompt publishes",
+			config:          &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			stdin:           "y\n",
+			expectPublished: true,
+			expectPrompt:    true,
+		},
+		{
+			name:         "declined prompt aborts",
+			config:       &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			stdin:        "n\n",
+			expectError:  "publish cancelled",
+			expectPrompt: true,
+		},
+		{
+			name:         "empty answer aborts",
+			config:       &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			stdin:        "\n",
+			expectError:  "publish cancelled",
+			expectPrompt: true,
+		},
+		{
+			name:         "closed stdin aborts",
+			config:       &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			stdin:        "",
+			expectError:  "use --yes",
+			expectPrompt: true,
+		},
+		{
+			name:            "--yes skips prompt",
+			config:          &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			args:            []string{"--yes"},
+			stdin:           "",
+			expectPublished: true,
+		},
+		{
+			name:            "-y skips prompt",
+			config:          &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}},
+			args:            []string{"-y"},
+			stdin:           "",
+			expectPublished: true,
+		},
+		{
+			name:            "empty production host list disables prompt",
+			config:          &commands.PublisherConfig{ProductionHosts: []string{}},
+			stdin:           "",
+			expectPublished: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+				})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			if tt.config != nil {
+				SetupTestConfig(t, *tt.config)
+			}
+			SetStdin(t, tt.stdin)
+			CreateTestServerJSON(t, testServerJSON(withUnreservedName))
+
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "server.json"))
+			})
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Contains(t, output, "Name:     io.github.example/test-server")
+			assert.Contains(t, output, "Version:  1.0.0")
+			assert.Contains(t, output, "Registry: "+server.URL)
+			if tt.expectPrompt {
+				assert.Contains(t, output, "Continue? [y/N]")
+			} else {
+				assert.NotContains(t, output, "Continue? [y/N]")
+			}
+			if tt.expectPublished {
+				assert.Equal(t, 1, publishCallCount)
+			} else {
+				assert.Equal(t, 0, publishCallCount, "declined publish must not send a request")
+			}
+		})
+	}
+}
+
+func TestPublishCommand_NoPrerelease(t *testing.T) {
+	production := &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}
+	tests := []struct {
+		name            string
+		version         string
+		args            []string
+		config          *commands.PublisherConfig
+		expectPublished bool
+	}{
+		{"pre-release to production", "1.0.0-rc.1", []string{"--no-prerelease"}, production, false},
+		{"release to production", "1.0.0", []string{"--no-prerelease"}, production, true},
+		{"pre-release to non-production registry", "1.0.0-rc.1", []string{"--no-prerelease"}, &commands.PublisherConfig{ProductionHosts: []string{}}, true},
+		{"pre-release without the flag", "1.0.0-rc.1", nil, production, true},
+		{"pre-release forbidden by config", "1.0.0-rc.1", nil, &commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}, NoPrerelease: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: tt.version},
+				})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			SetupTestConfig(t, *tt.config)
+			CreateTestServerJSON(t, testServerJSON(withUnreservedName, func(s *apiv0.ServerJSON) {
+				s.Version = tt.version
+			}))
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", "server.json"))
+			})
+
+			if tt.expectPublished {
+				require.NoError(t, err)
+				assert.Equal(t, 1, publishCallCount)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "version-prerelease-forbidden")
+			assert.Contains(t, err.Error(), `"1.0.0-rc.1" has pre-release identifier "rc.1"`)
+			assert.Equal(t, 0, publishCallCount, "a forbidden pre-release must not be published")
+		})
+	}
+}
+
+func TestPublishCommand_ReservedNamespace(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverName      string
+		config          commands.PublisherConfig
+		expectPublished bool
+	}{
+		{"reserved namespace to production", "com.example/test-server", commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}, false},
+		{"reserved sub-namespace to production", "io.modelcontextprotocol.anonymous/test-server", commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}, false},
+		{"reserved namespace to non-production registry", "com.example/test-server", commands.PublisherConfig{ProductionHosts: []string{}}, true},
+		{"other namespace to production", "io.github.example/test-server", commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: tt.serverName, Version: "1.0.0"},
+				})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			SetupTestConfig(t, tt.config)
+			CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+				s.Name = tt.serverName
+			}))
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand([]string{"--yes", "server.json"})
+			})
+
+			if tt.expectPublished {
+				require.NoError(t, err)
+				assert.Equal(t, 1, publishCallCount)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "namespace-reserved")
+			assert.Contains(t, err.Error(), "cannot be published to the production registry "+server.URL)
+			assert.Equal(t, 0, publishCallCount, "a reserved namespace must not be published")
+		})
+	}
+}
+
+func TestPublishCommand_PublicRemoteURLs(t *testing.T) {
+	production := commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}
+	tests := []struct {
+		name          string
+		remoteURL     string
+		config        commands.PublisherConfig
+		expectedError string
+	}{
+		{"public address to production", "https://93.184.216.34/mcp", production, ""},
+		{"private address to production", "https://192.168.1.10/mcp", production, "points at the private address 192.168.1.10"},
+		{"loopback address to production", "https://[::1]:8080/mcp", production, "points at the loopback address ::1"},
+		{"localhost to production", "https://localhost:8080/mcp", production, "points at localhost"},
+		{"private address to non-production registry", "https://10.0.0.5/mcp", commands.PublisherConfig{ProductionHosts: []string{}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "io.github.example/test-server", Version: "1.0.0"},
+				})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			SetupTestConfig(t, tt.config)
+			CreateTestServerJSON(t, testServerJSON(withUnreservedName, func(s *apiv0.ServerJSON) {
+				s.Remotes = []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: tt.remoteURL}}
+			}))
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand([]string{"--yes", "server.json"})
+			})
+
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				assert.Equal(t, 1, publishCallCount)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.Contains(t, err.Error(), "/remotes/0/url")
+			assert.Contains(t, err.Error(), "[remote-url-private]")
+			assert.Contains(t, err.Error(), "cannot be published to the production registry "+server.URL)
+			assert.Equal(t, 0, publishCallCount, "a private remote must not be published to a production registry")
+		})
+	}
+}
+
+func TestPublishCommand_CheckDowngrade(t *testing.T) {
+	tests := []struct {
+		name            string
+		latestVersion   string // empty for a server that has not been published yet
+		args            []string
+		expectPublished bool
+	}{
+		{"lower than latest", "2.0.0", []string{"--check-downgrade"}, false},
+		{"higher than latest", "0.9.0", []string{"--check-downgrade"}, true},
+		{"same as latest", "1.0.0", []string{"--check-downgrade"}, true},
+		{"new server", "", []string{"--check-downgrade"}, true},
+		{"non-semver latest", "nightly", []string{"--check-downgrade"}, true},
+		{"lower than latest without the flag", "2.0.0", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishCallCount := 0
+			latestCallCount := 0
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v0/servers", func(w http.ResponseWriter, _ *http.Request) {
+				// The name casing check
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(apiv0.ServerListResponse{})
+			})
+			mux.HandleFunc("/v0/servers/", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v0/servers/com.example/test-server/versions/latest", r.URL.Path)
+				latestCallCount++
+				if tt.latestVersion == "" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: tt.latestVersion},
+				})
+			})
+			mux.HandleFunc("/v0/publish", func(w http.ResponseWriter, _ *http.Request) {
+				publishCallCount++
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+				})
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", "server.json"))
+			})
+
+			if tt.expectPublished {
+				require.NoError(t, err)
+				assert.Equal(t, 1, publishCallCount)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "version-downgrade")
+			assert.Contains(t, err.Error(), `"1.0.0" is lower than "2.0.0"`)
+			assert.Equal(t, 1, latestCallCount)
+			assert.Equal(t, 0, publishCallCount, "a downgrade must not be published")
+		})
+	}
+}
+
+func TestPublishCommand_CheckDowngrade_RegistryError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/servers/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"title":"Internal Server Error","status":500,"detail":"database unavailable"}`))
+	})
+	mux.HandleFunc("/v0/publish", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("publish should not be called when the latest version cannot be fetched")
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	_ = CaptureStdout(t, func() {
+		err = commands.PublishCommand([]string{"--check-downgrade", "--yes", "server.json"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database unavailable")
+}
+
+func TestPublishCommand_ReleaseNotes(t *testing.T) {
+	tests := []struct {
+		name          string
+		notesFile     string
+		args          []string
+		expectedNotes any
+	}{
+		{"notes text", "", []string{"--notes-text", "Adds the forecast tool"}, "Adds the forecast tool"},
+		{"notes file", "## 1.0.0\n\n- Adds the forecast tool\n\n", []string{"--notes", "NOTES.md"}, "## 1.0.0\n\n- Adds the forecast tool"},
+		{"no notes", "", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]any
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{
+					Server: apiv0.ServerJSON{Name: "com.example/test-server", Version: "1.0.0"},
+				})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+			if tt.notesFile != "" {
+				require.NoError(t, os.WriteFile("NOTES.md", []byte(tt.notesFile), 0600))
+			}
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", "server.json"))
+			})
+			require.NoError(t, err)
+
+			require.NotNil(t, body, "publish should have been called")
+			assert.Equal(t, "com.example/test-server", body["name"], "the server.json fields should be sent as before")
+			assert.Equal(t, tt.expectedNotes, body["releaseNotes"])
+		})
+	}
+}
+
+func TestPublishCommand_ReleaseNotesInvalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		notesFile     string
+		args          []string
+		expectedError string
+	}{
+		{"too long", "", []string{"--notes-text", strings.Repeat("é", apiv0.MaxReleaseNotesLength+1)}, "release notes are 5001 characters long; the registry accepts at most 5000"},
+		{"empty file", "  \n", []string{"--notes", "NOTES.md"}, "release notes are empty"},
+		{"missing file", "", []string{"--notes", "missing.md"}, "failed to read release notes"},
+		{"both flags", "", []string{"--notes", "NOTES.md", "--notes-text", "notes"}, "--notes and --notes-text cannot be used together"},
+		{"with --update", "", []string{"--notes-text", "notes", "--update"}, "not with --update"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := SetupMockRegistryServer(t, func(_ http.ResponseWriter, _ *http.Request) {
+				t.Error("publish should not be called with invalid release notes")
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+			if tt.notesFile != "" {
+				require.NoError(t, os.WriteFile("NOTES.md", []byte(tt.notesFile), 0600))
+			}
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", "server.json"))
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestPublishCommand_VersionConflict(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+	}{
+		{"409 conflict", http.StatusConflict, `{"title":"Conflict","status":409,"detail":"version already exists"}`},
+		{"400 duplicate version", http.StatusBadRequest, `{"title":"Bad Request","status":400,"detail":"Failed to publish server","errors":[{"message":"invalid version: cannot publish duplicate version"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validateCallCount := 0
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}, func(w http.ResponseWriter, _ *http.Request) {
+				validateCallCount++
+				_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+			})
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Version = "2.3.4" }))
+
+			err := commands.PublishCommand([]string{})
+			require.Error(t, err)
+			assert.Equal(t, "version 2.3.4 of com.example/test-server already exists; bump the version or use --update", err.Error())
+			assert.Equal(t, 0, validateCallCount)
+		})
+	}
+
+	t.Run("other 400 errors are reported", func(t *testing.T) {
+		server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"detail":"Failed to publish server"}`))
+		}, nil)
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.PublishCommand([]string{})
+		require.Error(t, err)
+		assert.Equal(t, "publish failed (status 400): Failed to publish server", err.Error())
+	})
+}
+
+func TestPublishCommand_Update(t *testing.T) {
+	var method, path, auth string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/servers/{name}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		method, path, auth = r.Method, r.URL.EscapedPath(), r.Header.Get("Authorization")
+		var body apiv0.ServerJSON
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: body})
+	})
+	mux.HandleFunc("/v0/publish", func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("--update must not call the publish endpoint")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	err := commands.PublishCommand([]string{"--update"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/v0/servers/com.example%2Ftest-server/versions/1.0.0", path)
+	assert.Equal(t, "Bearer test-token", auth)
+}
+
+func TestPublishCommand_ErrorBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected string
+	}{
+		{
+			name:     "structured body with code",
+			status:   http.StatusForbidden,
+			body:     `{"message":"you don't own com.example","code":"NAMESPACE_FORBIDDEN"}`,
+			expected: "publish failed (code=NAMESPACE_FORBIDDEN): you don't own com.example",
+		},
+		{
+			name:     "structured body with message and detail",
+			status:   http.StatusForbidden,
+			body:     `{"message":"permission denied","detail":"token lacks publish permission for com.example/*","code":"FORBIDDEN"}`,
+			expected: "publish failed (code=FORBIDDEN): permission denied: token lacks publish permission for com.example/*",
+		},
+		{
+			name:     "unstructured body",
+			status:   http.StatusBadGateway,
+			body:     "upstream unavailable",
+			expected: "publish failed: server returned status 502: upstream unavailable",
+		},
+		{
+			name:     "json body without message",
+			status:   http.StatusBadRequest,
+			body:     `{"title":"Bad Request"}`,
+			expected: `publish failed: server returned status 400: {"title":"Bad Request"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+
+			err := commands.PublishCommand([]string{})
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+		})
+	}
+}
+
+func TestPublishCommand_SeveralFiles(t *testing.T) {
+	// The registry accepts servers whose name ends in -ok and rejects the others as duplicates
+	setup := func(t *testing.T, names ...string) []string {
+		t.Helper()
+		server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var serverJSON apiv0.ServerJSON
+			_ = json.NewDecoder(r.Body).Decode(&serverJSON)
+			if !strings.HasSuffix(serverJSON.Name, "-ok") {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"detail":"version already exists"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: serverJSON})
+		}, nil)
+		SetupTestToken(t, server.URL, "test-token")
+
+		dir := t.TempDir()
+		files := make([]string, 0, len(names))
+		for _, name := range names {
+			data, err := json.Marshal(testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "com.example/" + name }))
+			require.NoError(t, err)
+			file := filepath.Join(dir, name+".json")
+			require.NoError(t, os.WriteFile(file, data, 0600))
+			files = append(files, file)
+		}
+		return files
+	}
+
+	t.Run("all published", func(t *testing.T) {
+		files := setup(t, "a-ok", "b-ok")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand(files)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, commands.ExitCode(err))
+		assert.Contains(t, out, "✓ com.example/a-ok version 1.0.0")
+		assert.Contains(t, out, "✓ com.example/b-ok version 1.0.0")
+		assert.Contains(t, out, "Published 2 of 2 server(s)")
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		files := setup(t, "a", "b")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand(append([]string{"--continue-on-error"}, files...))
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, commands.ExitCode(err))
+		assert.Contains(t, out, "✗ version 1.0.0 of com.example/a already exists")
+		assert.Contains(t, out, "✗ version 1.0.0 of com.example/b already exists")
+		assert.Contains(t, out, "Published 0 of 2 server(s)")
+	})
+
+	t.Run("some published with --continue-on-error", func(t *testing.T) {
+		files := setup(t, "a", "b-ok", "c")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand(append([]string{"--continue-on-error"}, files...))
+		})
+		require.Error(t, err)
+		assert.Equal(t, commands.ExitPartialSuccess, commands.ExitCode(err))
+		assert.Contains(t, err.Error(), "published 1 of 3 server(s); 2 failed")
+		assert.Contains(t, out, "✓ com.example/b-ok version 1.0.0")
+		assert.Contains(t, out, "Published 1 of 3 server(s)")
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		files := setup(t, "a-ok", "b", "c-ok")
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand(files)
+		})
+		require.Error(t, err)
+		assert.Equal(t, commands.ExitPartialSuccess, commands.ExitCode(err))
+		assert.Contains(t, out, files[2]+"  - skipped")
+		assert.Contains(t, out, "Published 1 of 3 server(s)")
+	})
+
+	t.Run("first failure with nothing published", func(t *testing.T) {
+		files := setup(t, "a", "b-ok")
+		err := commands.PublishCommand(files)
+		require.Error(t, err)
+		assert.Equal(t, 1, commands.ExitCode(err))
+		assert.Contains(t, err.Error(), "version 1.0.0 of com.example/a already exists")
+	})
+}
+
+func TestPublishCommand_Resume(t *testing.T) {
+	// The registry goes down after the first publish until restored, interrupting the batch
+	var published []string
+	down := false
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var serverJSON apiv0.ServerJSON
+		_ = json.NewDecoder(r.Body).Decode(&serverJSON)
+		published = append(published, serverJSON.Name)
+		down = len(published) == 1
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: serverJSON})
+	}, nil)
+	SetupTestToken(t, server.URL, "test-token")
+
+	dir := t.TempDir()
+	var files []string
+	for _, name := range []string{"a", "b", "c"} {
+		data, err := json.Marshal(testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "com.example/" + name }))
+		require.NoError(t, err)
+		file := filepath.Join(dir, name+".json")
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		files = append(files, file)
+	}
+	stateFile := filepath.Join(dir, "publish-state.json")
+	args := append([]string{"--resume", stateFile}, files...)
+
+	var err error
+	out := CaptureStdout(t, func() {
+		err = commands.PublishCommand(args)
+	})
+	require.Error(t, err)
+	assert.Equal(t, commands.ExitPartialSuccess, commands.ExitCode(err))
+	assert.Contains(t, out, "Published 1 of 3 server(s)")
+	assert.Equal(t, []string{"com.example/a"}, published)
+
+	var state struct {
+		Published []string `json:"published"`
+	}
+	data, err := os.ReadFile(stateFile)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &state))
+	assert.Equal(t, []string{files[0]}, state.Published)
+
+	// Resuming once the registry is back publishes only the remaining files
+	down = false
+	out = CaptureStdout(t, func() {
+		err = commands.PublishCommand(args)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"com.example/a", "com.example/b", "com.example/c"}, published)
+	assert.Contains(t, out, files[0]+"  - already published (--resume)")
+	assert.Contains(t, out, "✓ com.example/b version 1.0.0")
+	assert.Contains(t, out, "Published 2 of 3 server(s), 1 already published")
+
+	data, err = os.ReadFile(stateFile)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &state))
+	assert.Equal(t, files, state.Published)
+
+	// A run with nothing left to publish succeeds without contacting the registry
+	out = CaptureStdout(t, func() {
+		err = commands.PublishCommand(args)
+	})
+	require.NoError(t, err)
+	assert.Len(t, published, 3)
+	assert.Contains(t, out, "Published 0 of 3 server(s), 3 already published")
+}
+
+func TestPublishCommand_ResumeInvalidState(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "publish-state.json")
+	require.NoError(t, os.WriteFile(stateFile, []byte("not json"), 0600))
+
+	err := commands.PublishCommand([]string{"--resume", stateFile, "a.json", "b.json"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid resume file "+stateFile)
+}
+
+func TestPublishCommand_TokenStdin(t *testing.T) {
+	setup := func(t *testing.T, authorization *string) {
+		t.Helper()
+		server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+			*authorization = r.Header.Get("Authorization")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+		}, nil)
+		// The saved login only provides the registry; its token must not be used
+		SetupTestToken(t, server.URL, "saved-token")
+		CreateTestServerJSON(t, testServerJSON())
+	}
+
+	t.Run("uses the piped token", func(t *testing.T) {
+		var authorization string
+		setup(t, &authorization)
+		SetStdin(t, "piped-token\n")
+
+		err := commands.PublishCommand([]string{"--token-stdin"})
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer piped-token", authorization)
+	})
+
+	t.Run("empty stdin", func(t *testing.T) {
+		var authorization string
+		setup(t, &authorization)
+		SetStdin(t, "")
+
+		err := commands.PublishCommand([]string{"--token-stdin"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "stdin is empty")
+		assert.Empty(t, authorization)
+	})
+
+	t.Run("conflicts with --token-file", func(t *testing.T) {
+		var authorization string
+		setup(t, &authorization)
+		SetStdin(t, "piped-token")
+
+		err := commands.PublishCommand([]string{"--token-stdin", "--token-file", "token.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be used together")
+	})
+}
+
+func TestPublishCommand_AuthValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		args          []string
+		authorization string
+	}{
+		{name: "enabled", args: []string{"--auth-validate"}, authorization: "Bearer test-token"},
+		{name: "disabled by default", args: []string{}, authorization: ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var authorization []string
+			server := SetupMockRegistryServer(t,
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"message":"invalid schema: call /validate for details"}`))
+				},
+				func(w http.ResponseWriter, r *http.Request) {
+					authorization = append(authorization, r.Header.Get("Authorization"))
+					w.Header().Set("Content-Type", "application/json")
+					_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: false, Issues: []validators.ValidationIssue{{
+						Type:      validators.ValidationIssueTypeSemantic,
+						Path:      "/version",
+						Message:   "version is invalid",
+						Severity:  validators.ValidationIssueSeverityError,
+						Reference: "invalid-version",
+					}}})
+				},
+			)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+
+			var err error
+			CaptureStdout(t, func() {
+				err = commands.PublishCommand(tt.args)
+			})
+			require.Error(t, err)
+			assert.Equal(t, []string{tt.authorization}, authorization)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryAliases(t *testing.T) {
+	// setup saves a login for an unrelated registry and returns a mock registry that is
+	// configured as the "staging" alias, counting its validate and publish requests
+	setup := func(t *testing.T) (string, *int) {
+		t.Helper()
+		requests := 0
+		server := SetupMockRegistryServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"server": testServerJSON()})
+		}, func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+			_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+		})
+		SetupTestToken(t, "https://saved.example.com", "saved-token")
+		SetupTestConfig(t, commands.PublisherConfig{
+			RegistryAliases: map[string]string{"staging": server.URL + "/"},
+		})
+		CreateTestServerJSON(t, testServerJSON())
+		return server.URL, &requests
+	}
+
+	t.Run("validate expands an alias", func(t *testing.T) {
+		_, requests := setup(t)
+		err := commands.ValidateCommand([]string{"--registry", "staging"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, *requests)
+	})
+
+	t.Run("validate passes a literal URL through", func(t *testing.T) {
+		serverURL, requests := setup(t)
+		err := commands.ValidateCommand([]string{"--registry", serverURL})
+		require.NoError(t, err)
+		assert.Equal(t, 1, *requests)
+	})
+
+	t.Run("rejects a value that is neither", func(t *testing.T) {
+		setup(t)
+		err := commands.ValidateCommand([]string{"--registry", "prod"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid registry "prod"`)
+	})
+
+	t.Run("login stores the expanded URL", func(t *testing.T) {
+		setup(t)
+		loginServer := setupNoneAuthServer(t)
+		SetupTestConfig(t, commands.PublisherConfig{
+			RegistryAliases: map[string]string{"local": loginServer.URL},
+		})
+
+		require.NoError(t, commands.LoginCommand([]string{"none", "--registry", "local"}))
+
+		home, err := os.UserHomeDir()
+		require.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(home, ".config", "mcp-publisher", "token.json"))
+		require.NoError(t, err)
+		var tokenInfo map[string]string
+		require.NoError(t, json.Unmarshal(data, &tokenInfo))
+		assert.Equal(t, loginServer.URL, tokenInfo["registry"])
+	})
+
+	t.Run("publish needs a token for the selected registry", func(t *testing.T) {
+		serverURL, requests := setup(t)
+
+		err := commands.PublishCommand([]string{"--registry", "staging"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the saved token is for https://saved.example.com, not "+serverURL)
+
+		SetStdin(t, "staging-token")
+		err = commands.PublishCommand([]string{"--registry", "staging", "--token-stdin"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, *requests)
+	})
+}
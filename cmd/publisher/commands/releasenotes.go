@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// releaseNotes holds the --notes and --notes-text flags that attach release notes to a
+// publish request. The registry receives them in the releaseNotes field alongside the
+// server.json fields.
+type releaseNotes struct {
+	file string
+	text string
+}
+
+// register adds the release notes flags to fs
+func (n *releaseNotes) register(fs *flag.FlagSet) {
+	fs.StringVar(&n.file, "notes", "", "Attach the release notes in this file to the published version")
+	fs.StringVar(&n.text, "notes-text", "", "Attach these release notes to the published version")
+}
+
+// load reads the --notes file into text and checks the notes against the registry's
+// limits. Without either flag there are no notes and text stays empty.
+func (n *releaseNotes) load(update bool) error {
+	if n.file == "" && n.text == "" {
+		return nil
+	}
+	if n.file != "" && n.text != "" {
+		return errors.New("--notes and --notes-text cannot be used together")
+	}
+	if update {
+		return errors.New("release notes can only be attached when publishing a new version, not with --update")
+	}
+
+	if n.file != "" {
+		data, err := os.ReadFile(n.file)
+		if err != nil {
+			return fmt.Errorf("failed to read release notes: %w", err)
+		}
+		if !utf8.Valid(data) {
+			return fmt.Errorf("release notes file %s is not valid UTF-8", n.file)
+		}
+		n.text = string(data)
+	}
+
+	n.text = strings.TrimSpace(n.text)
+	if n.text == "" {
+		return errors.New("release notes are empty")
+	}
+	if length := utf8.RuneCountInString(n.text); length > apiv0.MaxReleaseNotesLength {
+		return fmt.Errorf("release notes are %d characters long; the registry accepts at most %d", length, apiv0.MaxReleaseNotesLength)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// fetchRemoteSchema fetches the server.json schema the registry enforces from its schema
+// endpoint, so that validation can run locally against exactly that schema. It returns the
+// schema document and its $id.
+func fetchRemoteSchema(client *http.Client, registryURL, apiPrefix string) ([]byte, string, error) {
+	if !strings.HasSuffix(registryURL, "/") {
+		registryURL += "/"
+	}
+	schemaURL := registryURL + apiPrefix + "schema"
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, schemaURL, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch the registry's schema from %s: %w", schemaURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read the registry's schema from %s: %w", schemaURL, err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, "", fmt.Errorf("the registry does not serve its schema at %s (status 404); it may predate the schema endpoint, so validate without --remote-schema", schemaURL)
+	case resp.StatusCode != http.StatusOK:
+		return nil, "", newRegistryError("schema fetch", resp.StatusCode, body)
+	}
+
+	var schema struct {
+		ID string `json:"$id"`
+	}
+	if err := json.Unmarshal(body, &schema); err != nil {
+		return nil, "", fmt.Errorf("the registry returned an invalid schema from %s: %w", schemaURL, err)
+	}
+	if schema.ID == "" {
+		return nil, "", fmt.Errorf("the registry returned an invalid schema from %s: missing $id", schemaURL)
+	}
+	return body, schema.ID, nil
+}
+
+// loadRemoteSchema fetches the registry's schema for --remote-schema, reporting which
+// schema version will be validated against
+func (o *validateOptions) loadRemoteSchema(client *http.Client) error {
+	if !o.remoteSchema {
+		return nil
+	}
+	registryURL := o.registry
+	if registryURL == "" {
+		registryURL = validateRegistryURL()
+	}
+	schema, id, err := fetchRemoteSchema(client, registryURL, o.apiPrefix)
+	if err != nil {
+		return err
+	}
+	o.schema = schema
+	_, _ = fmt.Fprintf(o.progressWriter(), "Using the schema served by %s: %s\n", registryURL, id)
+	return nil
+}
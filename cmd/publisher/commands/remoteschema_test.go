@@ -0,0 +1,183 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaWithShortVersions returns the current schema limited to versions of at most three
+// characters, standing in for a registry deployment that enforces a stricter schema than
+// the CLI's
+func schemaWithShortVersions(t *testing.T) []byte {
+	t.Helper()
+
+	data, err := validators.EmbeddedSchema(model.CurrentSchemaVersion)
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	serverDetail := schema["definitions"].(map[string]any)["ServerDetail"].(map[string]any)
+	serverDetail["properties"].(map[string]any)["version"].(map[string]any)["maxLength"] = 3
+
+	data, err = json.Marshal(schema)
+	require.NoError(t, err)
+	return data
+}
+
+// setupSchemaServer starts a registry that serves schemaHandler at /v0/schema and fails
+// the test if the validate endpoint is used
+func setupSchemaServer(t *testing.T, schemaHandler http.HandlerFunc) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/schema", schemaHandler)
+	mux.HandleFunc("/v0/validate", func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("--remote-schema should validate locally instead of calling the validate endpoint")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	SetupTestToken(t, server.URL, "test-token")
+}
+
+func TestValidateCommand_RemoteSchema(t *testing.T) {
+	schema := schemaWithShortVersions(t)
+	setupSchemaServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(schema)
+	})
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--remote-schema", "--format", "json"})
+	})
+	require.Error(t, err, "the registry's schema rejects version 1.0.0")
+
+	var result validators.ValidationResult
+	require.NoError(t, json.Unmarshal([]byte(output), &result), "stdout should only contain the JSON result: %s", output)
+	assert.False(t, result.Valid)
+	require.NotEmpty(t, result.Issues)
+	assert.Equal(t, validators.ValidationIssueTypeSchema, result.Issues[0].Type)
+	assert.Equal(t, "/version", result.Issues[0].Path)
+
+	// The CLI's own schema accepts the version
+	result, err = runValidateJSON(t)
+	require.NoError(t, err, "%+v", result.Issues)
+	assert.True(t, result.Valid)
+}
+
+func TestValidateCommand_RemoteSchemaFetchFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedError string
+	}{
+		{
+			name:          "endpoint not served",
+			handler:       http.NotFound,
+			expectedError: "does not serve its schema at",
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			expectedError: "schema fetch failed",
+		},
+		{
+			name: "not a schema",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("<html>maintenance</html>"))
+			},
+			expectedError: "returned an invalid schema",
+		},
+		{
+			name: "schema without $id",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"type": "object"}`))
+			},
+			expectedError: "missing $id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupSchemaServer(t, tt.handler)
+			CreateTestServerJSON(t, testServerJSON())
+
+			err := commands.ValidateCommand([]string{"--remote-schema"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+
+	err := commands.ValidateCommand([]string{"--remote-schema", "--offline"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be combined with --offline")
+}
+
+func TestValidateCommand_SchemaRefDir(t *testing.T) {
+	const versionRef = "https://schemas.example.com/version.json"
+	data, err := validators.EmbeddedSchema(model.CurrentSchemaVersion)
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	serverDetail := schema["definitions"].(map[string]any)["ServerDetail"].(map[string]any)
+	serverDetail["properties"].(map[string]any)["version"] = map[string]any{"$ref": versionRef}
+	data, err = json.Marshal(schema)
+	require.NoError(t, err)
+
+	setupSchemaServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	})
+	CreateTestServerJSON(t, testServerJSON())
+
+	t.Run("unresolved", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--remote-schema", "--format", "json"})
+		})
+		require.Error(t, err)
+
+		var result validators.ValidationResult
+		require.NoError(t, json.Unmarshal([]byte(output), &result), output)
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "schema-external-ref-unresolved", result.Issues[0].Reference)
+		assert.Contains(t, result.Issues[0].Message, versionRef)
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		dir := t.TempDir()
+		versionSchema := `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "string", "maxLength": 3}`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "version.json"), []byte(versionSchema), 0600))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--remote-schema", "--schema-ref-dir", dir, "--format", "json"})
+		})
+		require.Error(t, err, "the referenced schema rejects version 1.0.0")
+
+		var result validators.ValidationResult
+		require.NoError(t, json.Unmarshal([]byte(output), &result), output)
+		require.NotEmpty(t, result.Issues)
+		assert.Equal(t, "/version", result.Issues[0].Path)
+	})
+
+	t.Run("requires local validation", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--schema-ref-dir", t.TempDir()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--schema-ref-dir requires local validation")
+	})
+}
@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// Output formats for the validate command
+const (
+	formatText  = "text"
+	formatTable = "table"
+	formatJSON  = "json"
+	// formatGitHub prints GitHub Actions workflow commands that annotate the file
+	formatGitHub = "github"
+	// formatEvents streams JSON progress events while validating a directory or lockfile
+	formatEvents = "events"
+	// formatSARIF writes a SARIF log, merging every file of a directory or lockfile
+	formatSARIF = "sarif"
+)
+
+// Values of the validate command's --severity flag
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityAll     = "all"
+)
+
+// Values of the validate command's --fail-on flag
+const (
+	failOnError   = "error"
+	failOnWarning = "warning"
+	failOnNone    = "none"
+)
+
+// validationFailedError reports that the validated content has issues, as opposed to the
+// command being unable to validate it. --exit-zero only suppresses these errors.
+type validationFailedError struct {
+	err error
+}
+
+func (e *validationFailedError) Error() string { return e.err.Error() }
+
+func (e *validationFailedError) Unwrap() error { return e.err }
+
+// validationFailure returns a validationFailedError with the given message
+func validationFailure(format string, args ...any) error {
+	return &validationFailedError{err: fmt.Errorf(format, args...)}
+}
+
+// validationReport is the JSON output of the validate command. Issues may be cut short by
+// --max-issues, in which case Truncated is set and TotalIssues still counts all of them.
+type validationReport struct {
+	Valid       bool                         `json:"valid"`
+	Issues      []validators.ValidationIssue `json:"issues"`
+	Truncated   bool                         `json:"truncated"`
+	TotalIssues int                          `json:"totalIssues"`
+}
+
+// limitIssues returns at most maxIssues of issues, or all of them if maxIssues is 0
+func limitIssues(issues []validators.ValidationIssue, maxIssues int) []validators.ValidationIssue {
+	if maxIssues > 0 && len(issues) > maxIssues {
+		return issues[:maxIssues]
+	}
+	return issues
+}
+
+// filterIssuesBySeverity returns the issues at or above the given --severity level
+func filterIssuesBySeverity(issues []validators.ValidationIssue, severity string) []validators.ValidationIssue {
+	filtered := []validators.ValidationIssue{}
+	for _, issue := range issues {
+		switch {
+		case severity == severityAll,
+			issue.Severity == validators.ValidationIssueSeverityError,
+			severity == severityWarning && issue.Severity == validators.ValidationIssueSeverityWarning:
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered
+}
+
+// strictReferences are the warnings that validate --strict reports as errors
+var strictReferences = []string{"url-insecure-scheme"}
+
+// escalateIssues returns result with the warnings whose reference is listed in references
+// turned into errors
+func escalateIssues(result *validators.ValidationResult, references []string) *validators.ValidationResult {
+	escalated := &validators.ValidationResult{Valid: true, Issues: []validators.ValidationIssue{}}
+	for _, issue := range result.Issues {
+		if issue.Severity == validators.ValidationIssueSeverityWarning && slices.Contains(references, issue.Reference) {
+			issue.Severity = validators.ValidationIssueSeverityError
+		}
+		escalated.AddIssue(issue)
+	}
+	escalated.Valid = escalated.Valid && result.Valid
+	return escalated
+}
+
+// filterIssuesByReference keeps the issues whose reference is listed in only (all of them
+// when only is empty) and then drops those listed in ignore. Valid is recomputed from the
+// remaining errors, so the filters also decide whether the command fails.
+func filterIssuesByReference(result *validators.ValidationResult, only, ignore []string) *validators.ValidationResult {
+	if len(only) == 0 && len(ignore) == 0 {
+		return result
+	}
+
+	filtered := &validators.ValidationResult{Valid: true, Issues: []validators.ValidationIssue{}}
+	for _, issue := range result.Issues {
+		if len(only) > 0 && !slices.Contains(only, issue.Reference) {
+			continue
+		}
+		if slices.Contains(ignore, issue.Reference) {
+			continue
+		}
+		filtered.AddIssue(issue)
+	}
+	return filtered
+}
+
+// splitReferences splits a comma-separated list of issue references
+func splitReferences(value string) []string {
+	var references []string
+	for _, reference := range strings.Split(value, ",") {
+		if reference = strings.TrimSpace(reference); reference != "" {
+			references = append(references, reference)
+		}
+	}
+	return references
+}
+
+// validationFailed reports whether result should fail the command for the given --fail-on level.
+// Filtering the reported issues with --severity does not affect this.
+func validationFailed(result *validators.ValidationResult, failOn string) bool {
+	switch failOn {
+	case failOnNone:
+		return false
+	case failOnWarning:
+		for _, issue := range result.Issues {
+			if issue.Severity == validators.ValidationIssueSeverityWarning {
+				return true
+			}
+		}
+	}
+	return !result.Valid
+}
+
+// issuePolicy holds the options that decide whether a result fails validation and which of
+// its issues are listed, for validating the many server.json files of a directory or lockfile
+type issuePolicy struct {
+	strict   bool
+	failOn   string
+	severity string
+	list     issueListOptions
+}
+
+// apply returns result with the warnings escalated by --strict, and whether it fails
+// validation under --fail-on
+func (p issuePolicy) apply(result *validators.ValidationResult) (*validators.ValidationResult, bool) {
+	if p.strict {
+		result = escalateIssues(result, strictReferences)
+	}
+	return result, validationFailed(result, p.failOn)
+}
+
+// shownIssues returns the issues of result that --severity and --max-issues let through
+func (p issuePolicy) shownIssues(result *validators.ValidationResult) []validators.ValidationIssue {
+	return limitIssues(filterIssuesBySeverity(result.Issues, p.severity), p.list.maxIssues)
+}
+
+// print lists the issues of result that --severity lets through, as reportValidation does
+// for the text format
+func (p issuePolicy) print(result *validators.ValidationResult, serverJSON *apiv0.ServerJSON, failed bool) {
+	issues := filterIssuesBySeverity(result.Issues, p.severity)
+	printValidationIssues(&validators.ValidationResult{Valid: result.Valid && !failed, Issues: issues}, serverJSON, p.list)
+}
+
+// reportValidation prints result for serverFile in the requested format and returns an
+// error if it should fail the command
+func reportValidation(result *validators.ValidationResult, serverJSON *apiv0.ServerJSON, serverFile string, opts *validateOptions) error {
+	issues := filterIssuesBySeverity(result.Issues, opts.severity)
+	failed := validationFailed(result, opts.failOn)
+
+	var formattedErrorMsg string
+	switch opts.format {
+	case formatJSON:
+		shown := limitIssues(issues, opts.maxIssues)
+		data, err := json.MarshalIndent(validationReport{
+			Valid:       result.Valid,
+			Issues:      shown,
+			Truncated:   len(shown) < len(issues),
+			TotalIssues: len(issues),
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize validation result: %w", err)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(data))
+	case formatGitHub:
+		printGitHubAnnotations(limitIssues(issues, opts.maxIssues), serverFile, annotationSource(serverFile), opts.extractKey)
+	case formatSARIF:
+		report := newSARIFReport()
+		report.add(serverFile, annotationSource(serverFile), opts.extractKey, limitIssues(issues, opts.maxIssues))
+		if err := opts.writeSARIF(report); err != nil {
+			return err
+		}
+	case formatTable:
+		printValidationTable(issues, !opts.noTruncate, opts.maxIssues)
+	default:
+		// Print validation results using shared formatting logic. Issues are listed
+		// whenever the document is invalid or the command fails.
+		formattedErrorMsg = printValidationIssues(&validators.ValidationResult{Valid: result.Valid && !failed, Issues: issues}, serverJSON, opts.issueList())
+	}
+
+	if result.Valid && !failed && opts.format != formatJSON && opts.format != formatSARIF {
+		_, _ = fmt.Fprintln(os.Stdout, "✅ server.json is valid")
+	}
+
+	if !failed {
+		return nil
+	}
+
+	// Return error with formatted message if available
+	if formattedErrorMsg != "" {
+		return validationFailure("%s", formattedErrorMsg)
+	}
+	if opts.format == formatText {
+		return validationFailure("validation failed")
+	}
+	return validationFailure("validation failed with %d issue(s)", len(result.Issues))
+}
@@ -0,0 +1,649 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withMismatchedPackage adds a package whose npm scope doesn't match the repository
+// owner, producing a repository-package-mismatch warning
+func withMismatchedPackage(s *apiv0.ServerJSON) {
+	s.Repository = &model.Repository{URL: "https://github.com/acme/weather-mcp", Source: "github"}
+	s.Packages = []model.Package{
+		{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@globex/weather-mcp",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio},
+		},
+	}
+}
+
+// withRangeVersion produces a version-looks-like-range error
+func withRangeVersion(s *apiv0.ServerJSON) {
+	s.Version = "^1.0.0"
+}
+
+func runValidateJSON(t *testing.T, args ...string) (validators.ValidationResult, error) {
+	t.Helper()
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand(append([]string{"--offline", "--format", "json"}, args...))
+	})
+
+	var result validators.ValidationResult
+	require.NoError(t, json.Unmarshal([]byte(output), &result), "stdout should only contain the JSON result: %s", output)
+	return result, err
+}
+
+func issueReferences(issues []validators.ValidationIssue) []string {
+	references := []string{}
+	for _, issue := range issues {
+		references = append(references, issue.Reference)
+	}
+	return references
+}
+
+func TestValidateCommand_SeverityFilter(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage))
+
+	tests := []struct {
+		severity           string
+		expectedReferences []string
+	}{
+		{"all", []string{"version-looks-like-range", "repository-package-mismatch"}},
+		{"warning", []string{"version-looks-like-range", "repository-package-mismatch"}},
+		{"error", []string{"version-looks-like-range"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.severity, func(t *testing.T) {
+			result, err := runValidateJSON(t, "--severity", tt.severity)
+
+			require.Error(t, err, "errors should fail regardless of the filter")
+			assert.False(t, result.Valid)
+			assert.Equal(t, tt.expectedReferences, issueReferences(result.Issues))
+		})
+	}
+
+	t.Run("text output respects filter", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--severity", "error"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, output, "version-looks-like-range")
+		assert.NotContains(t, output, "repository-package-mismatch")
+	})
+}
+
+func TestValidateCommand_FailOn(t *testing.T) {
+	t.Run("warnings only", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(withMismatchedPackage))
+
+		tests := []struct {
+			args        []string
+			expectError bool
+			expected    []string
+		}{
+			{[]string{}, false, []string{"repository-package-mismatch"}},
+			{[]string{"--severity", "error"}, false, []string{}},
+			{[]string{"--fail-on", "warning"}, true, []string{"repository-package-mismatch"}},
+			{[]string{"--fail-on", "warning", "--severity", "error"}, true, []string{}},
+			{[]string{"--fail-on", "none"}, false, []string{"repository-package-mismatch"}},
+		}
+
+		for _, tt := range tests {
+			result, err := runValidateJSON(t, tt.args...)
+			if tt.expectError {
+				require.Error(t, err, "args %v", tt.args)
+			} else {
+				require.NoError(t, err, "args %v", tt.args)
+			}
+			assert.True(t, result.Valid, "args %v", tt.args)
+			assert.Equal(t, tt.expected, issueReferences(result.Issues), "args %v", tt.args)
+		}
+	})
+
+	t.Run("errors with fail-on none", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(withRangeVersion))
+
+		result, err := runValidateJSON(t, "--fail-on", "none")
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, []string{"version-looks-like-range"}, issueReferences(result.Issues))
+	})
+
+	t.Run("text output lists warnings when failing on them", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(withMismatchedPackage))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--fail-on", "warning"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, output, "repository-package-mismatch")
+		assert.NotContains(t, output, "✅ server.json is valid")
+	})
+}
+
+func TestValidateCommand_InvalidSeverityAndFailOn(t *testing.T) {
+	err := commands.ValidateCommand([]string{"--severity", "info"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid severity 'info'")
+
+	err = commands.ValidateCommand([]string{"--fail-on", "always"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid fail-on 'always'")
+}
+
+func TestValidateCommand_RecommendedFields(t *testing.T) {
+	withoutRecommended := func(s *apiv0.ServerJSON) {
+		s.Repository = nil
+		s.WebsiteURL = ""
+	}
+
+	t.Run("missing fields are warnings", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		CreateTestServerJSON(t, testServerJSON(withoutRecommended))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err, "recommended fields must not fail validation by default")
+		assert.True(t, result.Valid)
+		assert.Equal(t, []string{"recommended-field-missing:repository", "recommended-field-missing:websiteUrl"}, issueReferences(result.Issues))
+
+		_, err = runValidateJSON(t, "--fail-on", "warning")
+		require.Error(t, err)
+	})
+
+	t.Run("configured set", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{RecommendedFields: []string{"title"}})
+		CreateTestServerJSON(t, testServerJSON(withoutRecommended))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"recommended-field-missing:title"}, issueReferences(result.Issues))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{RecommendedFields: []string{}})
+		CreateTestServerJSON(t, testServerJSON(withoutRecommended))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err)
+		assert.Empty(t, result.Issues)
+	})
+}
+
+func TestValidateCommand_MaxPackages(t *testing.T) {
+	withPackages := func(s *apiv0.ServerJSON) {
+		for _, identifier := range []string{"@example/test-server", "@example/test-server-cli", "@example/test-server-http"} {
+			s.Packages = append(s.Packages, model.Package{
+				RegistryType: model.RegistryTypeNPM,
+				Identifier:   identifier,
+				Version:      "1.0.0",
+				Transport:    model.Transport{Type: model.TransportTypeStdio},
+			})
+		}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		CreateTestServerJSON(t, testServerJSON(withPackages))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("configured maximum", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{MaxPackages: 2})
+		CreateTestServerJSON(t, testServerJSON(withPackages))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err, "too-many-packages is a warning")
+		assert.Equal(t, []string{"too-many-packages"}, issueReferences(result.Issues))
+	})
+}
+
+func TestValidateCommand_SizeLimits(t *testing.T) {
+	withArguments := func(s *apiv0.ServerJSON) {
+		pkg := model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@example/test-server",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio},
+		}
+		for _, name := range []string{"--port", "--host", "--verbose"} {
+			pkg.PackageArguments = append(pkg.PackageArguments, model.Argument{Type: model.ArgumentTypeNamed, Name: name})
+		}
+		s.Packages = []model.Package{pkg}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		CreateTestServerJSON(t, testServerJSON(withArguments))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err, "%+v", result.Issues)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("configured maximums", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{MaxArguments: 2, MaxServerJSONSize: 100})
+		CreateTestServerJSON(t, testServerJSON(withArguments))
+
+		result, err := runValidateJSON(t)
+		require.Error(t, err)
+		assert.Equal(t, []string{"arguments-too-many", "server-json-too-large"}, issueReferences(result.Issues))
+	})
+}
+
+func TestValidateCommand_EnvNamePattern(t *testing.T) {
+	withEnvironmentVariable := func(s *apiv0.ServerJSON) {
+		s.Packages = []model.Package{{
+			RegistryType:         model.RegistryTypeNPM,
+			Identifier:           "@example/test-server",
+			Version:              "1.0.0",
+			Transport:            model.Transport{Type: model.TransportTypeStdio},
+			EnvironmentVariables: []model.KeyValueInput{{Name: "API_KEY"}},
+		}}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		CreateTestServerJSON(t, testServerJSON(withEnvironmentVariable))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("configured pattern", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{EnvNamePattern: "^EXAMPLE_[A-Z_]+$"})
+		CreateTestServerJSON(t, testServerJSON(withEnvironmentVariable))
+
+		result, err := runValidateJSON(t)
+		require.NoError(t, err, "env-name-nonstandard is a warning")
+		assert.Equal(t, []string{"env-name-nonstandard"}, issueReferences(result.Issues))
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+		SetupTestConfig(t, commands.PublisherConfig{EnvNamePattern: "^[A-Z"})
+		CreateTestServerJSON(t, testServerJSON(withEnvironmentVariable))
+
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid envNamePattern in config")
+	})
+}
+
+func TestValidateCommand_MaxIssues(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage))
+
+	t.Run("text output at the boundary", func(t *testing.T) {
+		tests := []struct {
+			maxIssues string
+			truncated bool
+		}{
+			{"0", false},
+			{"1", true},
+			{"2", false},
+			{"3", false},
+		}
+
+		for _, tt := range tests {
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--offline", "--max-issues", tt.maxIssues})
+			})
+			require.Error(t, err, "exit code must reflect every issue (max-issues %s)", tt.maxIssues)
+			assert.Contains(t, output, "Validation failed with 2 issue(s)", "max-issues %s", tt.maxIssues)
+			assert.Contains(t, output, "version-looks-like-range", "max-issues %s", tt.maxIssues)
+			if tt.truncated {
+				assert.NotContains(t, output, "repository-package-mismatch", "max-issues %s", tt.maxIssues)
+				assert.Contains(t, output, "… and 1 more", "max-issues %s", tt.maxIssues)
+			} else {
+				assert.Contains(t, output, "repository-package-mismatch", "max-issues %s", tt.maxIssues)
+				assert.NotContains(t, output, "… and", "max-issues %s", tt.maxIssues)
+			}
+		}
+	})
+
+	t.Run("json output reports the full count", func(t *testing.T) {
+		var report struct {
+			Issues      []validators.ValidationIssue `json:"issues"`
+			Truncated   bool                         `json:"truncated"`
+			TotalIssues int                          `json:"totalIssues"`
+		}
+
+		for _, tt := range []struct {
+			maxIssues string
+			shown     int
+			truncated bool
+		}{
+			{"1", 1, true},
+			{"2", 2, false},
+		} {
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--offline", "--format", "json", "--max-issues", tt.maxIssues})
+			})
+			require.Error(t, err)
+			require.NoError(t, json.Unmarshal([]byte(output), &report))
+			assert.Len(t, report.Issues, tt.shown, "max-issues %s", tt.maxIssues)
+			assert.Equal(t, tt.truncated, report.Truncated, "max-issues %s", tt.maxIssues)
+			assert.Equal(t, 2, report.TotalIssues, "max-issues %s", tt.maxIssues)
+		}
+	})
+
+	t.Run("table output", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--format", "table", "--max-issues", "1"})
+		})
+		require.Error(t, err)
+		assert.NotContains(t, output, "repository-package-mismatch")
+		assert.Contains(t, output, "… and 1 more")
+	})
+
+	t.Run("negative value", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--max-issues", "-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid max-issues -1")
+	})
+}
+
+func TestValidateCommand_ExitZero(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage))
+
+	t.Run("text output", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--exit-zero"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Validation failed with 2 issue(s)")
+		assert.Contains(t, output, "version-looks-like-range")
+		assert.Contains(t, output, "repository-package-mismatch")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		result, err := runValidateJSON(t, "--exit-zero")
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, []string{"version-looks-like-range", "repository-package-mismatch"}, issueReferences(result.Issues))
+	})
+
+	t.Run("fail-on warning", func(t *testing.T) {
+		_, err := runValidateJSON(t, "--exit-zero", "--fail-on", "warning")
+		require.NoError(t, err)
+	})
+
+	t.Run("errors other than validation failures", func(t *testing.T) {
+		err := commands.ValidateCommand([]string{"--offline", "--exit-zero", "missing.json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing.json not found")
+	})
+}
+
+func TestValidateCommand_ShowReferences(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage, func(s *apiv0.ServerJSON) {
+		s.Schema = "https://static.modelcontextprotocol.io/schemas/2025-10-17/server.schema.json"
+	}))
+
+	result, _ := runValidateJSON(t)
+	references := issueReferences(result.Issues)
+	require.Contains(t, references, "schema-version-deprecated")
+	require.Contains(t, references, "version-looks-like-range")
+	require.Contains(t, references, "repository-package-mismatch")
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--show-references"})
+	})
+	require.Error(t, err)
+	for i, reference := range references {
+		assert.Contains(t, output, fmt.Sprintf("%d. [%s] ", i+1, reference))
+	}
+
+	t.Run("without the flag schema issues are not listed", func(t *testing.T) {
+		output := CaptureStdout(t, func() {
+			_ = commands.ValidateCommand([]string{"--offline"})
+		})
+		assert.NotContains(t, output, "[schema-version-deprecated]")
+		assert.NotContains(t, output, "Reference: schema-version-deprecated")
+	})
+}
+
+func TestValidateCommand_ReferenceFilters(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withRangeVersion, withMismatchedPackage))
+
+	tests := []struct {
+		name               string
+		args               []string
+		expectedReferences []string
+		expectError        bool
+	}{
+		{"no filter", nil, []string{"version-looks-like-range", "repository-package-mismatch"}, true},
+		{"only the error", []string{"--only", "version-looks-like-range"}, []string{"version-looks-like-range"}, true},
+		{"only the warning", []string{"--only", "repository-package-mismatch"}, []string{"repository-package-mismatch"}, false},
+		{"only both", []string{"--only", "version-looks-like-range, repository-package-mismatch"}, []string{"version-looks-like-range", "repository-package-mismatch"}, true},
+		{"ignore the error", []string{"--ignore", "version-looks-like-range"}, []string{"repository-package-mismatch"}, false},
+		{"ignore after only", []string{"--only", "version-looks-like-range,repository-package-mismatch", "--ignore", "version-looks-like-range"}, []string{"repository-package-mismatch"}, false},
+		{"unmatched only", []string{"--only", "invalid-server-name"}, []string{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runValidateJSON(t, tt.args...)
+			assert.Equal(t, tt.expectedReferences, issueReferences(result.Issues))
+			assert.Equal(t, !tt.expectError, result.Valid)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("fail-on warning sees only the filtered issues", func(t *testing.T) {
+		_, err := runValidateJSON(t, "--fail-on", "warning", "--ignore", "version-looks-like-range")
+		require.Error(t, err)
+
+		_, err = runValidateJSON(t, "--fail-on", "warning", "--ignore", "version-looks-like-range,repository-package-mismatch")
+		require.NoError(t, err)
+	})
+
+	t.Run("text output", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--ignore", "version-looks-like-range"})
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, output, "version-looks-like-range")
+		assert.Contains(t, output, "✅ server.json is valid")
+	})
+}
+
+func TestValidateCommand_Strict(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Repository = &model.Repository{URL: "http://github.com/example/test-server", Source: "github"}
+	}))
+
+	result, err := runValidateJSON(t)
+	require.NoError(t, err, "url-insecure-scheme is a warning by default")
+	assert.True(t, result.Valid)
+	require.Equal(t, []string{"url-insecure-scheme"}, issueReferences(result.Issues))
+	assert.Equal(t, validators.ValidationIssueSeverityWarning, result.Issues[0].Severity)
+
+	result, err = runValidateJSON(t, "--strict")
+	require.Error(t, err)
+	assert.False(t, result.Valid)
+	require.Equal(t, []string{"url-insecure-scheme"}, issueReferences(result.Issues))
+	assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+}
+
+func TestValidateCommand_Profile(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Repository = &model.Repository{URL: "http://github.com/example/test-server", Source: "github"}
+	}))
+
+	result, err := runValidateJSON(t, "--profile", "strict")
+	require.Error(t, err)
+	assert.False(t, result.Valid)
+	require.Equal(t, []string{"url-insecure-scheme"}, issueReferences(result.Issues))
+	assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+
+	result, err = runValidateJSON(t, "--profile", "lenient")
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	require.Equal(t, []string{"url-insecure-scheme"}, issueReferences(result.Issues))
+	assert.Equal(t, validators.ValidationIssueSeverityWarning, result.Issues[0].Severity)
+
+	err = commands.ValidateCommand([]string{"--offline", "--profile", "paranoid"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown validation profile 'paranoid'")
+
+	t.Run("sent to the registry", func(t *testing.T) {
+		var queries []string
+		server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true, Issues: []validators.ValidationIssue{}})
+		})
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		for _, profile := range []string{"default", "lenient"} {
+			CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--profile", profile})
+			})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []string{"", "profile=lenient"}, queries)
+	})
+}
+
+func TestValidateCommand_CheckNamespaceDomain(t *testing.T) {
+	// .invalid is reserved by RFC 2606 and never resolves
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Name = "invalid.acme/test-server" }))
+
+	result, err := runValidateJSON(t)
+	require.NoError(t, err)
+	assert.NotContains(t, issueReferences(result.Issues), "namespace-domain-unresolvable", "the check is opt-in")
+
+	result, err = runValidateJSON(t, "--check-namespace-domain")
+	require.NoError(t, err, "an unresolvable namespace is only a warning")
+	require.Contains(t, issueReferences(result.Issues), "namespace-domain-unresolvable")
+	for _, issue := range result.Issues {
+		if issue.Reference == "namespace-domain-unresolvable" {
+			assert.Equal(t, "/name", issue.Path)
+			assert.Contains(t, issue.Message, "acme.invalid")
+		}
+	}
+}
+
+func TestValidateCommand_CheckPackageVersions(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Version = "1.2.0"
+		s.Packages = []model.Package{{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@example/test-server",
+			Version:      "1.1.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio},
+		}}
+	}))
+
+	result, err := runValidateJSON(t)
+	require.NoError(t, err)
+	assert.Empty(t, result.Issues, "package versions are only checked when asked for")
+
+	result, err = runValidateJSON(t, "--check-package-versions")
+	require.NoError(t, err, "package-version-mismatch is a warning")
+	require.Equal(t, []string{"package-version-mismatch"}, issueReferences(result.Issues))
+	assert.Equal(t, "/packages/0/version", result.Issues[0].Path)
+
+	SetupTestConfig(t, commands.PublisherConfig{CheckPackageVersions: true})
+	result, err = runValidateJSON(t)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"package-version-mismatch"}, issueReferences(result.Issues),
+		"the checkPackageVersions config setting should enable the check")
+}
+
+func TestValidateCommand_RequireLicense(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	result, err := runValidateJSON(t)
+	require.NoError(t, err, "the license is only checked when required")
+	assert.Empty(t, result.Issues)
+
+	result, err = runValidateJSON(t, "--require-license")
+	require.Error(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, []string{"license-invalid"}, issueReferences(result.Issues))
+
+	SetupTestConfig(t, commands.PublisherConfig{RequireLicense: true})
+	result, err = runValidateJSON(t)
+	require.Error(t, err, "the requireLicense config setting should enable the check")
+	assert.Equal(t, []string{"license-invalid"}, issueReferences(result.Issues))
+
+	serverJSON, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	createRawServerJSON(t, append(serverJSON[:len(serverJSON)-1], []byte(`,"license":"Apache-2.0"}`)...))
+	result, err = runValidateJSON(t, "--require-license")
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Empty(t, result.Issues)
+}
+
+func TestValidateCommand_TextWarnings(t *testing.T) {
+	t.Run("warnings of a valid document are listed", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+			s.Repository = &model.Repository{URL: "http://github.com/example/test-server", Source: "github"}
+		}))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "⚠️  Validation passed with 1 issue(s):")
+		assert.Contains(t, output, "1. [warning] /repository/url (semantic)")
+		assert.Contains(t, output, "Reference: url-insecure-scheme")
+		assert.Contains(t, output, "✅ server.json is valid")
+		assert.NotContains(t, output, "❌")
+	})
+
+	t.Run("no issues", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline"})
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, output, "Validation passed with")
+		assert.Contains(t, output, "✅ server.json is valid")
+	})
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+func init() {
+	for _, rule := range []validators.Rule{
+		{Reference: "name-field-required", Type: validators.ValidationIssueTypeSemantic, Severity: validators.ValidationIssueSeverityError, Description: "name field must be present (checked locally before validation)"},
+		{Reference: "version-field-required", Type: validators.ValidationIssueTypeSemantic, Severity: validators.ValidationIssueSeverityError, Description: "version field must be present (checked locally before validation)"},
+		{Reference: "description-field-required", Type: validators.ValidationIssueTypeSemantic, Severity: validators.ValidationIssueSeverityError, Description: "description field must be present (checked locally before validation)"},
+	} {
+		if err := validators.RegisterRule(rule); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// checkRequiredFields performs a quick local check that the fields every server.json
+// needs are non-empty. It produces issues in the same shape as the registry's validator
+// so the most common omissions can be reported without a round-trip.
+func checkRequiredFields(serverJSON *apiv0.ServerJSON) *validators.ValidationResult {
+	result := &validators.ValidationResult{Valid: true, Issues: []validators.ValidationIssue{}}
+
+	required := []struct {
+		path      string
+		value     string
+		message   string
+		reference string
+	}{
+		{"/$schema", serverJSON.Schema, "$schema field is required", "schema-field-required"},
+		{"/name", serverJSON.Name, "name field is required", "name-field-required"},
+		{"/version", serverJSON.Version, "version field is required", "version-field-required"},
+		{"/description", serverJSON.Description, "description field is required", "description-field-required"},
+	}
+
+	for _, field := range required {
+		if field.value != "" {
+			continue
+		}
+		result.AddIssue(validators.NewValidationIssue(
+			validators.ValidationIssueTypeSemantic,
+			field.path,
+			field.message,
+			validators.ValidationIssueSeverityError,
+			field.reference,
+		))
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRequiredFields(t *testing.T) {
+	complete := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+	}
+
+	tests := []struct {
+		name              string
+		mutate            func(s *apiv0.ServerJSON)
+		expectedPath      string
+		expectedReference string
+	}{
+		{"missing schema", func(s *apiv0.ServerJSON) { s.Schema = "" }, "/$schema", "schema-field-required"},
+		{"missing name", func(s *apiv0.ServerJSON) { s.Name = "" }, "/name", "name-field-required"},
+		{"missing version", func(s *apiv0.ServerJSON) { s.Version = "" }, "/version", "version-field-required"},
+		{"missing description", func(s *apiv0.ServerJSON) { s.Description = "" }, "/description", "description-field-required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := complete
+			tt.mutate(&serverJSON)
+
+			result := checkRequiredFields(&serverJSON)
+
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, tt.expectedPath, result.Issues[0].Path)
+			assert.Equal(t, tt.expectedReference, result.Issues[0].Reference)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+		})
+	}
+
+	t.Run("complete document has no issues", func(t *testing.T) {
+		result := checkRequiredFields(&complete)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("empty document reports every field", func(t *testing.T) {
+		result := checkRequiredFields(&apiv0.ServerJSON{})
+		assert.False(t, result.Valid)
+		assert.Len(t, result.Issues, 4)
+	})
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// publishState is the --resume file of a batch publish: the server.json files it has
+// published so far, so that re-running the same command after an interruption skips them.
+// Files are recorded by absolute path. A nil state records nothing.
+type publishState struct {
+	path      string
+	Published []string `json:"published"`
+}
+
+// loadPublishState reads the state file at path, returning an empty state if it does not
+// exist yet
+func loadPublishState(path string) (*publishState, error) {
+	state := &publishState{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resume file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("invalid resume file %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// stateKey returns the path serverFile is recorded under
+func stateKey(serverFile string) string {
+	if abs, err := filepath.Abs(serverFile); err == nil {
+		return abs
+	}
+	return filepath.Clean(serverFile)
+}
+
+// done reports whether serverFile was published by an earlier run
+func (s *publishState) done(serverFile string) bool {
+	return s != nil && slices.Contains(s.Published, stateKey(serverFile))
+}
+
+// record adds serverFile to the published files and saves the state file. The file is
+// replaced with a rename so an interruption cannot leave it half written.
+func (s *publishState) record(serverFile string) error {
+	if s == nil {
+		return nil
+	}
+	s.Published = append(s.Published, stateKey(serverFile))
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize resume file: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write resume file %s: %w", s.path, err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write resume file %s: %w", s.path, err)
+	}
+	return nil
+}
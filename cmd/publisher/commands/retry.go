@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxRetries is how many times a request rejected with 429 Too Many Requests is retried
+	maxRetries = 3
+	// defaultRetryDelay is the wait before the first retry of a 429 response without a
+	// usable Retry-After header; it doubles with every further retry
+	defaultRetryDelay = time.Second
+	// maxRetryDelay is the longest Retry-After delay that is waited for. Responses asking
+	// for more are returned as they are.
+	maxRetryDelay = time.Minute
+)
+
+// retryTransport is an http.RoundTripper that retries requests the registry rejected with
+// 429 Too Many Requests, waiting as long as its Retry-After header asks. Waits count
+// towards the client's timeout, which ends the retries when it runs out.
+type retryTransport struct {
+	next http.RoundTripper
+	// sleep waits for d unless ctx is done first
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt == maxRetries {
+			return resp, err
+		}
+		// A body that cannot be recreated cannot be sent again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+		delay, ok := retryDelay(resp.Header.Get("Retry-After"), attempt, time.Now())
+		if !ok || !fitsDeadline(req.Context(), delay) {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryDelay returns how long to wait before retrying a 429 response whose Retry-After
+// header is retryAfter, given in seconds or as an HTTP date, after attempt earlier retries.
+// Without a usable header the delay doubles from defaultRetryDelay. It reports false if
+// the delay exceeds maxRetryDelay.
+func retryDelay(retryAfter string, attempt int, now time.Time) (time.Duration, bool) {
+	delay := defaultRetryDelay << attempt
+	retryAfter = strings.TrimSpace(retryAfter)
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(retryAfter); err == nil {
+		delay = max(date.Sub(now), 0)
+	}
+	return delay, delay <= maxRetryDelay
+}
+
+// fitsDeadline reports whether waiting for delay leaves time before ctx's deadline
+func fitsDeadline(ctx context.Context, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > delay
+}
+
+// sleepContext waits for d unless ctx is done first, returning ctx's error in that case
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
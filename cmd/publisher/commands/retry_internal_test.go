@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		expected   time.Duration
+		ok         bool
+	}{
+		{name: "seconds", retryAfter: "7", expected: 7 * time.Second, ok: true},
+		{name: "zero seconds", retryAfter: "0", expected: 0, ok: true},
+		{name: "http date", retryAfter: "Fri, 02 Jan 2026 15:04:35 GMT", expected: 30 * time.Second, ok: true},
+		{name: "http date in the past", retryAfter: "Fri, 02 Jan 2026 15:00:00 GMT", expected: 0, ok: true},
+		{name: "missing", expected: time.Second, ok: true},
+		{name: "missing on a later attempt", attempt: 2, expected: 4 * time.Second, ok: true},
+		{name: "invalid", retryAfter: "soon", attempt: 1, expected: 2 * time.Second, ok: true},
+		{name: "negative seconds", retryAfter: "-5", expected: time.Second, ok: true},
+		{name: "too long", retryAfter: "3600", expected: time.Hour, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := retryDelay(tt.retryAfter, tt.attempt, now)
+			assert.Equal(t, tt.expected, delay)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+// setupRateLimitedServer returns a server that rejects the first limited requests with
+// 429 and retryAfter, recording the body of every request it receives
+func setupRateLimitedServer(t *testing.T, limited int, retryAfter func() string, bodies *[]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, string(body))
+		if len(*bodies) <= limited {
+			if value := retryAfter(); value != "" {
+				w.Header().Set("Retry-After", value)
+			}
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newRecordingRetryClient returns a client with a retryTransport that records its waits
+// instead of sleeping
+func newRecordingRetryClient(delays *[]time.Duration) *http.Client {
+	return &http.Client{Transport: &retryTransport{
+		next: http.DefaultTransport,
+		sleep: func(_ context.Context, d time.Duration) error {
+			*delays = append(*delays, d)
+			return nil
+		},
+	}}
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("seconds", func(t *testing.T) {
+		var bodies []string
+		var delays []time.Duration
+		server := setupRateLimitedServer(t, 2, func() string { return "3" }, &bodies)
+
+		resp, err := newRecordingRetryClient(&delays).Post(server.URL, "application/json", strings.NewReader(`{"name":"a"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second}, delays)
+		assert.Equal(t, []string{`{"name":"a"}`, `{"name":"a"}`, `{"name":"a"}`}, bodies, "the body should be sent again")
+	})
+
+	t.Run("http date", func(t *testing.T) {
+		var bodies []string
+		var delays []time.Duration
+		retryAfter := func() string { return time.Now().Add(20 * time.Second).UTC().Format(http.TimeFormat) }
+		server := setupRateLimitedServer(t, 1, retryAfter, &bodies)
+
+		resp, err := newRecordingRetryClient(&delays).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, delays, 1)
+		assert.InDelta(t, 20*time.Second, delays[0], float64(2*time.Second))
+	})
+
+	t.Run("gives up after the maximum retries", func(t *testing.T) {
+		var bodies []string
+		var delays []time.Duration
+		server := setupRateLimitedServer(t, maxRetries+1, func() string { return "" }, &bodies)
+
+		resp, err := newRecordingRetryClient(&delays).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Len(t, bodies, maxRetries+1)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}, delays)
+	})
+
+	t.Run("delay longer than the timeout", func(t *testing.T) {
+		var bodies []string
+		var delays []time.Duration
+		server := setupRateLimitedServer(t, 1, func() string { return "30" }, &bodies)
+		client := newRecordingRetryClient(&delays)
+		client.Timeout = 5 * time.Second
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "a wait past the timeout should not be attempted")
+		assert.Empty(t, delays)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		var delays []time.Duration
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+
+		resp, err := newRecordingRetryClient(&delays).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Empty(t, delays)
+	})
+}
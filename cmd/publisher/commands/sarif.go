@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// Identification of the SARIF format version written by --format sarif
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// sarifLog is a SARIF 2.1.0 log with a single run
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool      sarifTool       `json:"tool"`
+	Artifacts []sarifArtifact `json:"artifacts"`
+	Results   []sarifResult   `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifArtifact struct {
+	Location sarifArtifactLocation `json:"location"`
+}
+
+type sarifArtifactLocation struct {
+	URI   string `json:"uri"`
+	Index *int   `json:"index,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// sarifReport collects the issues of one or more validated files into a single SARIF log,
+// with one artifact per file. Its methods are safe for concurrent use, and add does nothing
+// on a nil report so callers need not check whether SARIF output is enabled.
+type sarifReport struct {
+	mu        sync.Mutex
+	artifacts []sarifArtifact
+	results   []sarifResult
+	rules     map[string]bool
+}
+
+func newSARIFReport() *sarifReport {
+	return &sarifReport{rules: map[string]bool{}}
+}
+
+// add records issues of file as a new artifact. source is the validated file, used to find
+// the line of each issue's JSON path; pathPrefix locates the server.json inside it when it
+// was extracted with --extract-key.
+func (r *sarifReport) add(file string, source []byte, pathPrefix string, issues []validators.ValidationIssue) {
+	if r == nil {
+		return
+	}
+	lines := jsonPathLines(source)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	index := len(r.artifacts)
+	uri := sarifURI(file)
+	r.artifacts = append(r.artifacts, sarifArtifact{Location: sarifArtifactLocation{URI: uri}})
+
+	for _, issue := range issues {
+		location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: uri, Index: &index}}
+		if line := issueLine(lines, joinJSONPath(pathPrefix, issue.Path)); line > 0 {
+			location.Region = &sarifRegion{StartLine: line}
+		}
+
+		message := issue.Message
+		if issue.Path != "" {
+			message = issue.Path + ": " + message
+		}
+		r.results = append(r.results, sarifResult{
+			RuleID:    issue.Reference,
+			Level:     sarifLevel(issue.Severity),
+			Message:   sarifMessage{Text: message},
+			Locations: []sarifLocation{{PhysicalLocation: location}},
+		})
+		if issue.Reference != "" {
+			r.rules[issue.Reference] = true
+		}
+	}
+}
+
+// write writes the collected log as indented JSON
+func (r *sarifReport) write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Describe the rules that were reported, in the order of the rule catalog
+	rules := []sarifRule{}
+	for _, rule := range validators.Rules() {
+		if r.rules[rule.Reference] {
+			rules = append(rules, sarifRule{ID: rule.Reference, ShortDescription: sarifMessage{Text: rule.Description}})
+		}
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "mcp-publisher",
+				InformationURI: "https://github.com/modelcontextprotocol/registry",
+				Rules:          rules,
+			}},
+			Artifacts: append([]sarifArtifact{}, r.artifacts...),
+			Results:   append([]sarifResult{}, r.results...),
+		}},
+	}
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize SARIF report: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// sarifLevel maps a validation issue severity to a SARIF result level
+func sarifLevel(severity validators.ValidationIssueSeverity) string {
+	switch severity {
+	case validators.ValidationIssueSeverityWarning:
+		return "warning"
+	case validators.ValidationIssueSeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+// sarifURI returns the artifact URI of file: relative paths use forward slashes so that
+// code scanning tools resolve them against the repository root, absolute paths become file
+// URIs, and URLs (e.g. lockfile entries) are kept as they are.
+func sarifURI(file string) string {
+	if u, err := url.Parse(file); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return file
+	}
+	if filepath.IsAbs(file) {
+		path := filepath.ToSlash(file)
+		if !strings.HasPrefix(path, "/") {
+			// A Windows drive letter, e.g. file:///C:/servers/server.json
+			path = "/" + path
+		}
+		return (&url.URL{Scheme: "file", Path: path}).String()
+	}
+	return filepath.ToSlash(filepath.Clean(file))
+}
+
+// writeSARIF writes report to the --report file, or to stdout if none was given
+func (o *validateOptions) writeSARIF(report *sarifReport) error {
+	if o.reportPath == "" {
+		return report.write(os.Stdout)
+	}
+	f, err := os.Create(o.reportPath)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	if err := report.write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+	return nil
+}
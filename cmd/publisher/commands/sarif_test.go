@@ -0,0 +1,108 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sarifOutput struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name  string `json:"name"`
+				Rules []struct {
+					ID string `json:"id"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Artifacts []struct {
+			Location struct {
+				URI string `json:"uri"`
+			} `json:"location"`
+		} `json:"artifacts"`
+		Results []struct {
+			RuleID    string `json:"ruleId"`
+			Level     string `json:"level"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI   string `json:"uri"`
+						Index int    `json:"index"`
+					} `json:"artifactLocation"`
+					Region struct {
+						StartLine int `json:"startLine"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+func writeIndentedServerJSON(t *testing.T, path string, serverJSON any) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	data, err := json.MarshalIndent(serverJSON, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+func TestValidateCommand_DirectorySARIF(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeIndentedServerJSON(t, filepath.Join("servers", "a", "server.json"), testServerJSON(withRangeVersion))
+	writeIndentedServerJSON(t, filepath.Join("servers", "b", "server.json"), testServerJSON(func(s *apiv0.ServerJSON) {
+		s.Name = "com.example/other-server"
+	}))
+	writeIndentedServerJSON(t, filepath.Join("servers", "c", "server.json"), testServerJSON(withRangeVersion))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--format", "sarif", "--report", "out.sarif", "servers"})
+	})
+	require.Error(t, err)
+	assert.Equal(t, "2 of 3 server.json file(s) in servers failed validation", err.Error())
+	assert.Empty(t, output, "the SARIF report should go to the --report file")
+
+	data, err := os.ReadFile("out.sarif")
+	require.NoError(t, err)
+	var log sarifOutput
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1, "every file should be merged into one run")
+	run := log.Runs[0]
+	assert.Equal(t, "mcp-publisher", run.Tool.Driver.Name)
+
+	var uris []string
+	for _, artifact := range run.Artifacts {
+		uris = append(uris, artifact.Location.URI)
+	}
+	assert.Equal(t, []string{"servers/a/server.json", "servers/b/server.json", "servers/c/server.json"}, uris)
+
+	require.Len(t, run.Results, 2)
+	for i, artifactIndex := range []int{0, 2} {
+		result := run.Results[i]
+		assert.Equal(t, "version-looks-like-range", result.RuleID)
+		assert.Equal(t, "error", result.Level)
+		require.Len(t, result.Locations, 1)
+		location := result.Locations[0].PhysicalLocation
+		assert.Equal(t, artifactIndex, location.ArtifactLocation.Index)
+		assert.Equal(t, uris[artifactIndex], location.ArtifactLocation.URI)
+		assert.Equal(t, 9, location.Region.StartLine, "the version field follows the repository object")
+	}
+	require.Len(t, run.Tool.Driver.Rules, 1)
+	assert.Equal(t, "version-looks-like-range", run.Tool.Driver.Rules[0].ID)
+}
+
+func TestValidateCommand_SARIFFlags(t *testing.T) {
+	err := commands.ValidateCommand([]string{"--offline", "--report", "out.sarif", "server.json"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--report requires --format sarif")
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+func init() {
+	if err := validators.RegisterRule(validators.Rule{
+		Reference:   "schema-url-unreachable",
+		Type:        validators.ValidationIssueTypeSchema,
+		Severity:    validators.ValidationIssueSeverityWarning,
+		Description: "$schema URL should be retrievable over a trusted connection (checked by online validation)",
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// schemaURLProblems caches the outcome of checkSchemaURL per URL, so that validating a
+// directory or lockfile fetches each schema once. An empty string means reachable.
+var schemaURLProblems sync.Map
+
+// checkSchemaURL fetches the $schema URL and returns a warning when it cannot be retrieved,
+// e.g. because the host is unreachable, its certificate is invalid or it answers with an
+// error status. Non-HTTP URLs are left to schema validation.
+func checkSchemaURL(client *http.Client, schemaURL string) *validators.ValidationIssue {
+	if !strings.HasPrefix(schemaURL, "https://") && !strings.HasPrefix(schemaURL, "http://") {
+		return nil
+	}
+
+	problem, ok := schemaURLProblems.Load(schemaURL)
+	if !ok {
+		problem, _ = schemaURLProblems.LoadOrStore(schemaURL, fetchSchemaURL(client, schemaURL))
+	}
+	if problem == "" {
+		return nil
+	}
+
+	issue := validators.NewValidationIssue(
+		validators.ValidationIssueTypeSchema,
+		"/$schema",
+		fmt.Sprintf("$schema URL %s could not be retrieved: %s", schemaURL, problem),
+		validators.ValidationIssueSeverityWarning,
+		"schema-url-unreachable",
+	)
+	return &issue
+}
+
+// fetchSchemaURL requests schemaURL with HEAD, falling back to GET for servers that do not
+// support it, and describes why it could not be retrieved or returns "" if it could
+func fetchSchemaURL(client *http.Client, schemaURL string) string {
+	statusCode, err := requestSchemaURL(client, http.MethodHead, schemaURL)
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = requestSchemaURL(client, http.MethodGet, schemaURL)
+	}
+	switch {
+	case err != nil:
+		return err.Error()
+	case statusCode != http.StatusOK:
+		return fmt.Sprintf("status %d %s", statusCode, http.StatusText(statusCode))
+	default:
+		return ""
+	}
+}
+
+func requestSchemaURL(client *http.Client, method, schemaURL string) (int, error) {
+	req, err := http.NewRequestWithContext(context.Background(), method, schemaURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,76 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommand_SchemaURLUnreachable(t *testing.T) {
+	schemaHandler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/schemas/2025-12-11/server.schema.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}
+	plainHost := httptest.NewServer(http.HandlerFunc(schemaHandler))
+	t.Cleanup(plainHost.Close)
+	// The test client does not trust the certificate of a TLS test server
+	tlsHost := httptest.NewTLSServer(http.HandlerFunc(schemaHandler))
+	t.Cleanup(tlsHost.Close)
+
+	tests := []struct {
+		name            string
+		schemaURL       string
+		expectedMessage string
+	}{
+		{"reachable", plainHost.URL + "/schemas/2025-12-11/server.schema.json", ""},
+		{"not found", plainHost.URL + "/schemas/2099-01-01/server.schema.json", "status 404 Not Found"},
+		{"invalid certificate", tlsHost.URL + "/schemas/2025-12-11/server.schema.json", "certificate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := SetupMockRegistryServer(t, nil, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) { s.Schema = tt.schemaURL }))
+
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{"--format", "json"})
+			})
+			require.NoError(t, err, "an unreachable $schema is only a warning")
+
+			var result validators.ValidationResult
+			require.NoError(t, json.Unmarshal([]byte(output), &result), output)
+			assert.True(t, result.Valid)
+			if tt.expectedMessage == "" {
+				assert.Empty(t, result.Issues)
+				return
+			}
+			require.Len(t, result.Issues, 1)
+			issue := result.Issues[0]
+			assert.Equal(t, "schema-url-unreachable", issue.Reference)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+			assert.Equal(t, "/$schema", issue.Path)
+			assert.Contains(t, issue.Message, tt.expectedMessage)
+		})
+	}
+
+	t.Run("not checked offline", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+			s.Schema = plainHost.URL + "/schemas/2099-01-01/server.schema.json"
+		}))
+		result, _ := runValidateJSON(t)
+		assert.NotContains(t, issueReferences(result.Issues), "schema-url-unreachable")
+	})
+}
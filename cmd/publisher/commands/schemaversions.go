@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// schemaVersionResult is the validation result for one --schema-versions entry
+type schemaVersionResult struct {
+	SchemaVersion string                       `json:"schemaVersion"`
+	Valid         bool                         `json:"valid"`
+	Issues        []validators.ValidationIssue `json:"issues"`
+}
+
+// parseSchemaVersions splits a comma-separated --schema-versions value and checks that
+// every version is known to the validator
+func parseSchemaVersions(value string) ([]string, error) {
+	available := validators.SchemaVersions()
+
+	var versions []string
+	for _, version := range strings.Split(value, ",") {
+		version = strings.TrimSpace(version)
+		if version == "" {
+			continue
+		}
+		if !slices.Contains(available, version) {
+			return nil, fmt.Errorf("unknown schema version '%s'. Available versions: %s", version, strings.Join(available, ", "))
+		}
+		if !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("--schema-versions requires at least one schema version")
+	}
+	return versions, nil
+}
+
+// validateSchemaVersions validates serverJSON locally against each of the requested schema
+// versions and reports the outcome per version
+func validateSchemaVersions(serverJSON *apiv0.ServerJSON, opts *validateOptions) error {
+	results := make([]schemaVersionResult, 0, len(opts.schemaVersions))
+	var failedVersions []string
+	for _, version := range opts.schemaVersions {
+		result := opts.profile.Apply(validators.ValidateServerJSONForSchemaVersion(serverJSON, version, validators.ValidationAll))
+		if validationFailed(result, opts.failOn) {
+			failedVersions = append(failedVersions, version)
+		}
+		results = append(results, schemaVersionResult{
+			SchemaVersion: version,
+			Valid:         result.Valid,
+			Issues:        filterIssuesBySeverity(result.Issues, opts.severity),
+		})
+	}
+
+	if opts.format == formatJSON {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize validation result: %w", err)
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(data))
+	} else {
+		for _, result := range results {
+			printSchemaVersionResult(result, slices.Contains(failedVersions, result.SchemaVersion), serverJSON, opts)
+		}
+	}
+
+	if len(failedVersions) > 0 {
+		return validationFailure("validation failed for schema version(s): %s", strings.Join(failedVersions, ", "))
+	}
+	return nil
+}
+
+// printSchemaVersionResult prints the text or table output for one schema version
+func printSchemaVersionResult(result schemaVersionResult, failed bool, serverJSON *apiv0.ServerJSON, opts *validateOptions) {
+	_, _ = fmt.Fprintf(os.Stdout, "Schema version %s:\n", result.SchemaVersion)
+	if opts.format == formatTable {
+		printValidationTable(result.Issues, !opts.noTruncate, opts.maxIssues)
+	} else if failed {
+		printValidationIssues(&validators.ValidationResult{Valid: false, Issues: result.Issues}, serverJSON, opts.issueList())
+	}
+	if failed {
+		_, _ = fmt.Fprintf(os.Stdout, "❌ server.json is invalid under schema version %s\n", result.SchemaVersion)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "✅ server.json is valid under schema version %s\n", result.SchemaVersion)
+	}
+	_, _ = fmt.Fprintln(os.Stdout)
+}
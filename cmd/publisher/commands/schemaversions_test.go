@@ -0,0 +1,80 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withNPMPackage adds a package serialized with camelCase field names, which the
+// 2025-07-09 schema does not accept
+func withNPMPackage(s *apiv0.ServerJSON) {
+	s.Packages = []model.Package{
+		{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@example/test-server",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio},
+		},
+	}
+}
+
+func TestValidateCommand_SchemaVersions(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON(withNPMPackage))
+	versions := "2025-07-09," + model.CurrentSchemaVersion
+
+	t.Run("text", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--schema-versions", versions})
+		})
+		require.Error(t, err)
+		assert.Equal(t, "validation failed for schema version(s): 2025-07-09", err.Error())
+		assert.Contains(t, output, "❌ server.json is invalid under schema version 2025-07-09")
+		assert.Contains(t, output, "✅ server.json is valid under schema version "+model.CurrentSchemaVersion)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--schema-versions", versions, "--format", "json"})
+		})
+		require.Error(t, err)
+
+		var results []struct {
+			SchemaVersion string `json:"schemaVersion"`
+			Valid         bool   `json:"valid"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &results))
+		require.Len(t, results, 2)
+		assert.Equal(t, "2025-07-09", results[0].SchemaVersion)
+		assert.False(t, results[0].Valid)
+		assert.Equal(t, model.CurrentSchemaVersion, results[1].SchemaVersion)
+		assert.True(t, results[1].Valid)
+	})
+
+	t.Run("all valid", func(t *testing.T) {
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--schema-versions", model.CurrentSchemaVersion})
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestValidateCommand_SchemaVersionsInvalid(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+
+	err := commands.ValidateCommand([]string{"--schema-versions", "1999-01-01"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown schema version '1999-01-01'")
+
+	err = commands.ValidateCommand([]string{"--schema-versions", model.CurrentSchemaVersion, "--baseline", "baseline.json"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be combined")
+}
@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// checkServerResponse reports data read from filename that is a registry API response,
+// as saved from GET /v0/servers/{serverName}/versions/{version}, rather than a server.json.
+// Such a response wraps the server.json in its "server" field next to the registry's
+// "_meta", so decoding it as a server.json would only report missing fields. Data that
+// is not a JSON object is left to the other checks.
+func checkServerResponse(filename string, data []byte) error {
+	// Syntax errors are reported when the server.json is decoded
+	var top map[string]json.RawMessage
+	if json.Unmarshal(data, &top) != nil {
+		return nil
+	}
+	server, ok := top["server"]
+	if !ok || top["name"] != nil || top["$schema"] != nil {
+		return nil
+	}
+
+	var inner map[string]json.RawMessage
+	if json.Unmarshal(server, &inner) != nil || (inner["name"] == nil && inner["$schema"] == nil) {
+		return nil
+	}
+	return fmt.Errorf("%s looks like a registry API response rather than a server.json: the server.json is its \"server\" field. Extract it first, e.g. with: jq .server %s", filename, filename)
+}
@@ -13,6 +13,8 @@ import (
 	"net/url"
 	"os"
 	"strings"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 )
 
 // StatusUpdateRequest represents the request body for status update endpoints
@@ -58,6 +60,8 @@ func StatusCommand(args []string) error {
 	status := fs.String("status", "", "New status: active, deprecated, or deleted (required)")
 	message := fs.String("message", "", "Optional status message explaining the change")
 	allVersions := fs.Bool("all-versions", false, "Apply status change to all versions of the server")
+	var tokens tokenSource
+	tokens.register(fs)
 	yes := fs.Bool("yes", false, "Skip confirmation prompt for bulk operations")
 	fs.BoolVar(yes, "y", false, "Skip confirmation prompt for bulk operations (shorthand)")
 
@@ -94,30 +98,11 @@ func StatusCommand(args []string) error {
 	}
 
 	// Load saved token
-	tokenPath, err := tokenFilePath()
+	token, registryURL, err := tokens.load()
 	if err != nil {
 		return err
 	}
 
-	tokenData, err := os.ReadFile(tokenPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return notAuthenticatedError()
-		}
-		return fmt.Errorf("failed to read token: %w", err)
-	}
-
-	var tokenInfo map[string]string
-	if err := json.Unmarshal(tokenData, &tokenInfo); err != nil {
-		return fmt.Errorf("invalid token data: %w", err)
-	}
-
-	token := tokenInfo["token"]
-	registryURL := tokenInfo["registry"]
-	if registryURL == "" {
-		registryURL = DefaultRegistryURL
-	}
-
 	// Update status
 	if *allVersions {
 		return updateAllVersionsStatus(registryURL, serverName, *status, *message, token, *yes)
@@ -134,7 +119,7 @@ func updateVersionStatus(registryURL, serverName, version, status, statusMessage
 
 	_, _ = fmt.Fprintf(os.Stdout, "Updating %s version %s: %s → %s\n", serverName, version, currentStatus, status)
 
-	if err := updateServerStatus(registryURL, serverName, version, status, statusMessage, token); err != nil {
+	if _, err := updateServerStatus(&http.Client{}, registryURL, defaultAPIPrefix+"/", serverName, version, status, statusMessage, token); err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
@@ -229,7 +214,9 @@ func updateAllVersionsStatus(registryURL, serverName, status, statusMessage, tok
 	return nil
 }
 
-func updateServerStatus(registryURL, serverName, version, status, statusMessage, token string) error {
+// updateServerStatus sets the status of version of serverName, with statusMessage if set,
+// and returns the updated version
+func updateServerStatus(client *http.Client, registryURL, apiPrefix, serverName, version, status, statusMessage, token string) (*apiv0.ServerResponse, error) {
 	if !strings.HasSuffix(registryURL, "/") {
 		registryURL += "/"
 	}
@@ -244,38 +231,41 @@ func updateServerStatus(registryURL, serverName, version, status, statusMessage,
 
 	jsonData, err := json.Marshal(requestBody)
 	if err != nil {
-		return fmt.Errorf("error serializing request: %w", err)
+		return nil, fmt.Errorf("error serializing request: %w", err)
 	}
 
 	// URL encode the server name and version
 	encodedServerName := url.PathEscape(serverName)
 	encodedVersion := url.PathEscape(version)
-	statusURL := registryURL + "v0/servers/" + encodedServerName + "/versions/" + encodedVersion + "/status"
+	statusURL := registryURL + apiPrefix + "servers/" + encodedServerName + "/versions/" + encodedVersion + "/status"
 
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, statusURL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("error sending request: %w", err)
+		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("error reading response: %w", err)
+		return nil, fmt.Errorf("error reading response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
+		return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
 	}
 
-	return nil
+	var response apiv0.ServerResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("invalid response from registry: %w", err)
+	}
+	return &response, nil
 }
 
 func fetchVersionStatus(registryURL, serverName, version, token string) (string, error) {
@@ -287,26 +277,13 @@ func fetchVersionStatus(registryURL, serverName, version, token string) (string,
 	encodedVersion := url.PathEscape(version)
 	fetchURL := registryURL + "v0/servers/" + encodedServerName + "/versions/" + encodedVersion + "?include_deleted=true"
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, fetchURL, nil)
-	if err != nil {
-		return "", fmt.Errorf("error creating request: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+token)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	body, statusCode, err := registryGet(&http.Client{}, fetchURL, token)
 	if err != nil {
-		return "", fmt.Errorf("error sending request: %w", err)
+		return "", err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
+	if statusCode != http.StatusOK {
+		return "", fmt.Errorf("server returned status %d: %s", statusCode, body)
 	}
 
 	// Parse the response to extract status
@@ -330,26 +307,13 @@ func fetchAllVersionsStatus(registryURL, serverName, token string) ([]VersionInf
 	encodedServerName := url.PathEscape(serverName)
 	fetchURL := registryURL + "v0/servers/" + encodedServerName + "/versions?include_deleted=true"
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, fetchURL, nil)
+	body, statusCode, err := registryGet(&http.Client{}, fetchURL, token)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+token)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned status %d: %s", statusCode, body)
 	}
 
 	var response ServerListResponse
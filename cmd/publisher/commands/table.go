@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+)
+
+// maxTableMessageLength is the number of characters of a message shown in table output
+// before it is truncated
+const maxTableMessageLength = 60
+
+// printValidationTable prints issues as an aligned table. Messages longer than
+// maxTableMessageLength are truncated unless truncate is false. At most maxIssues rows
+// are printed, followed by a count of the rest; 0 prints them all.
+func printValidationTable(issues []validators.ValidationIssue, truncate bool, maxIssues int) {
+	if len(issues) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SEVERITY\tTYPE\tPATH\tREFERENCE\tMESSAGE")
+	shown := limitIssues(issues, maxIssues)
+	for _, issue := range shown {
+		// Keep each issue on one line
+		message := strings.Join(strings.Fields(issue.Message), " ")
+		if truncate {
+			message = truncateMessage(message, maxTableMessageLength)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", issue.Severity, issue.Type, issue.Path, issue.Reference, message)
+	}
+	_ = w.Flush()
+	if omitted := len(issues) - len(shown); omitted > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "… and %d more\n", omitted)
+	}
+	_, _ = fmt.Fprintln(os.Stdout)
+}
+
+// truncateMessage shortens message to at most maxLength characters, marking the cut with "..."
+func truncateMessage(message string, maxLength int) string {
+	runes := []rune(message)
+	if len(runes) <= maxLength {
+		return message
+	}
+	return string(runes[:maxLength-3]) + "..."
+}
@@ -0,0 +1,87 @@
+package commands_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+func TestValidateCommand_FormatTable(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		golden string
+	}{
+		{"truncated", []string{"--offline", "--format", "table"}, "validate_table.golden"},
+		{"no truncate", []string{"--offline", "--format=table", "--no-truncate"}, "validate_table_no_truncate.golden"},
+	}
+
+	goldenDir, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+				s.Version = "^1.0.0"
+				s.WebsiteURL = "http://example.com"
+				s.Packages = []model.Package{
+					{
+						RegistryType: model.RegistryTypeNPM,
+						Identifier:   "@globex/weather-mcp",
+						Version:      "1.0.0",
+						Transport:    model.Transport{Type: model.TransportTypeStdio},
+						PackageArguments: []model.Argument{
+							{
+								Type:               model.ArgumentTypePositional,
+								InputWithVariables: model.InputWithVariables{Input: model.Input{Value: "{port"}},
+							},
+						},
+					},
+				}
+				s.Repository = &model.Repository{URL: "https://github.com/acme/weather-mcp", Source: "github"}
+			}))
+
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand(tt.args)
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "validation failed with 4 issue(s)")
+
+			goldenPath := filepath.Join(goldenDir, tt.golden)
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(output), 0600))
+			}
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), output)
+		})
+	}
+}
+
+func TestValidateCommand_FormatTableValid(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--format", "table"})
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, output, "SEVERITY")
+	assert.Contains(t, output, "✅ server.json is valid")
+}
+
+func TestValidateCommand_InvalidFormat(t *testing.T) {
+	err := commands.ValidateCommand([]string{"--format", "yaml"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid format 'yaml'")
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// templateExtension marks a server.json that is rendered as a template without --template
+const templateExtension = ".tmpl"
+
+// templateVars collects repeated --var key=value flags
+type templateVars map[string]string
+
+func (v templateVars) String() string {
+	pairs := make([]string, 0, len(v))
+	for key, value := range v {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (v templateVars) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	v[key] = val
+	return nil
+}
+
+// serverTemplate holds the --template, --var and --values flags that render server.json
+// as a Go template (e.g. "version": "{{.Version}}") before it is parsed
+type serverTemplate struct {
+	enabled    bool
+	vars       templateVars
+	valuesFile string
+	// ignoreExtension stops the .tmpl extension from implying --template, for an
+	// --input-format other than template
+	ignoreExtension bool
+}
+
+// register adds the template flags to fs
+func (t *serverTemplate) register(fs *flag.FlagSet) {
+	t.vars = templateVars{}
+	fs.BoolVar(&t.enabled, "template", false, "Render server.json as a Go template before parsing (implied by a .tmpl extension)")
+	fs.Var(t.vars, "var", "Set a template variable as key=value (repeatable)")
+	fs.StringVar(&t.valuesFile, "values", "", "Read template variables from this JSON object file")
+}
+
+// used reports whether any template flag was given
+func (t *serverTemplate) used() bool {
+	return t.enabled || len(t.vars) > 0 || t.valuesFile != ""
+}
+
+// render returns data rendered as a template when --template is set or filename has the
+// .tmpl extension, and data unchanged otherwise. Every placeholder must resolve to a
+// variable from --values or --var, which take precedence.
+func (t *serverTemplate) render(filename string, data []byte) ([]byte, error) {
+	if !t.enabled && (t.ignoreExtension || !strings.HasSuffix(filename, templateExtension)) {
+		if len(t.vars) > 0 || t.valuesFile != "" {
+			return nil, errors.New("--var and --values require --template or a server.json with the .tmpl extension")
+		}
+		return data, nil
+	}
+
+	values, err := t.values()
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(filename).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", filename, err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, values); err != nil {
+		return nil, fmt.Errorf("failed to render template %s (set every variable with --var or --values): %w", filename, err)
+	}
+	return rendered.Bytes(), nil
+}
+
+// values returns the template variables from the values file overlaid with --var
+func (t *serverTemplate) values() (map[string]any, error) {
+	values := map[string]any{}
+	if t.valuesFile != "" {
+		data, err := os.ReadFile(t.valuesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values file: %w", err)
+		}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("invalid values file %s: expected a JSON object: %w", t.valuesFile, err)
+		}
+		if values == nil {
+			values = map[string]any{}
+		}
+	}
+	for key, value := range t.vars {
+		values[key] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,127 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serverJSONTemplate has placeholders for the name suffix and version
+const serverJSONTemplate = `{
+  "$schema": "` + model.CurrentSchemaURL + `",
+  "name": "com.example/{{.Server}}",
+  "description": "A test server",
+  "version": "{{.Version}}"
+}
+`
+
+func TestPublishCommand_Template(t *testing.T) {
+	tests := []struct {
+		name            string
+		file            string
+		args            []string
+		values          string
+		expectedName    string
+		expectedVersion string
+		expectError     string
+	}{
+		{
+			name:            "vars with .tmpl extension",
+			file:            "server.json.tmpl",
+			args:            []string{"--var", "Server=weather", "--var", "Version=2.0.0"},
+			expectedName:    "com.example/weather",
+			expectedVersion: "2.0.0",
+		},
+		{
+			name:            "values file with --template",
+			file:            "server.json",
+			args:            []string{"--template", "--values", "values.json"},
+			values:          `{"Server": "weather", "Version": "2.1.0"}`,
+			expectedName:    "com.example/weather",
+			expectedVersion: "2.1.0",
+		},
+		{
+			name:            "vars override the values file",
+			file:            "server.json.tmpl",
+			args:            []string{"--values", "values.json", "--var", "Version=3.0.0"},
+			values:          `{"Server": "weather", "Version": "2.1.0"}`,
+			expectedName:    "com.example/weather",
+			expectedVersion: "3.0.0",
+		},
+		{
+			name:        "unresolved variable",
+			file:        "server.json.tmpl",
+			args:        []string{"--var", "Server=weather"},
+			expectError: `map has no entry for key "Version"`,
+		},
+		{
+			name:        "vars without a template",
+			file:        "server.json",
+			args:        []string{"--var", "Server=weather"},
+			expectError: "--var and --values require --template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var published apiv0.ServerJSON
+			server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &published)
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: published})
+			}, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			CreateTestServerJSON(t, testServerJSON())
+			require.NoError(t, os.WriteFile(tt.file, []byte(serverJSONTemplate), 0600))
+			if tt.values != "" {
+				require.NoError(t, os.WriteFile("values.json", []byte(tt.values), 0600))
+			}
+
+			var err error
+			_ = CaptureStdout(t, func() {
+				err = commands.PublishCommand(append(tt.args, "--yes", tt.file))
+			})
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				assert.Empty(t, published.Name, "nothing should be published")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, published.Name)
+			assert.Equal(t, tt.expectedVersion, published.Version)
+		})
+	}
+}
+
+func TestValidateCommand_Template(t *testing.T) {
+	CreateTestServerJSON(t, testServerJSON())
+	require.NoError(t, os.WriteFile("server.json.tmpl", []byte(serverJSONTemplate), 0600))
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--var", "Server=weather", "--var", "Version=^1.0.0", "server.json.tmpl"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, output, "version-looks-like-range", "the rendered document should be validated")
+
+	err = commands.ValidateCommand([]string{"--offline", "server.json.tmpl"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `map has no entry for key "Server"`)
+
+	_ = CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--offline", "--var", "Version", "server.json.tmpl"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `expected key=value, got "Version"`)
+}
@@ -2,14 +2,17 @@ package commands_test
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
 	"github.com/modelcontextprotocol/registry/internal/validators"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -72,6 +75,7 @@ func SetupTestToken(t *testing.T, registryURL, token string) string {
 	tempHome := t.TempDir()
 	t.Setenv("HOME", tempHome)
 	t.Setenv("USERPROFILE", tempHome)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tempHome, ".cache"))
 
 	dir := filepath.Join(tempHome, ".config", "mcp-publisher")
 	require.NoError(t, os.MkdirAll(dir, 0700))
@@ -91,6 +95,60 @@ func SetupTestToken(t *testing.T, registryURL, token string) string {
 	return tokenPath
 }
 
+// CaptureStdout runs fn with os.Stdout redirected and returns everything written to it
+func CaptureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	originalStdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = originalStdout }()
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+
+	fn()
+
+	require.NoError(t, w.Close())
+	return string(<-done)
+}
+
+// SetStdin replaces os.Stdin with a file containing input for the duration of the test
+func SetStdin(t *testing.T, input string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "stdin")
+	require.NoError(t, os.WriteFile(path, []byte(input), 0600))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	originalStdin := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = originalStdin
+		_ = f.Close()
+	})
+}
+
+// SetupTestConfig writes the publisher config file into the (test) home directory
+func SetupTestConfig(t *testing.T, config commands.PublisherConfig) {
+	t.Helper()
+
+	homeDir, err := os.UserHomeDir()
+	require.NoError(t, err)
+	dir := filepath.Join(homeDir, ".config", "mcp-publisher")
+	require.NoError(t, os.MkdirAll(dir, 0700))
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), data, 0600))
+}
+
 // CreateTestServerJSON creates a server.json file in a temp directory and changes to it
 func CreateTestServerJSON(t *testing.T, serverJSON apiv0.ServerJSON) (string, string) {
 	t.Helper()
@@ -116,3 +174,21 @@ func CreateTestServerJSON(t *testing.T, serverJSON apiv0.ServerJSON) (string, st
 
 	return tempDir, serverFile
 }
+
+// testServerJSON returns a valid server.json, with the recommended fields set so that it
+// produces no issues, and the given modifications applied
+func testServerJSON(mutators ...func(s *apiv0.ServerJSON)) apiv0.ServerJSON {
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Repository:  &model.Repository{URL: "https://github.com/example/test-server", Source: "github"},
+		WebsiteURL:  "https://example.com/test-server",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/test-server/mcp"}},
+	}
+	for _, mutate := range mutators {
+		mutate(&serverJSON)
+	}
+	return serverJSON
+}
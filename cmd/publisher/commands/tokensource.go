@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// maxStdinTokenSize bounds how much of stdin is read as a token
+const maxStdinTokenSize = 64 * 1024
+
+// tokenSource holds the --token-file, --token-stdin and --registry-auth-command flags that
+// select the registry token used by a command
+type tokenSource struct {
+	file  string
+	stdin bool
+	// command is a credential helper whose output is the token, in the token file format
+	command string
+}
+
+// register adds the token flags to fs
+func (s *tokenSource) register(fs *flag.FlagSet) {
+	fs.StringVar(&s.file, "token-file", "", "Read the registry token from this file instead of ~/.config/mcp-publisher/token.json")
+	fs.BoolVar(&s.stdin, "token-stdin", false, "Read the registry token from stdin and keep it in memory only")
+	fs.StringVar(&s.command, "registry-auth-command", "", "Run this credential helper and read the registry token from its output, in the token file format")
+}
+
+// load returns the selected token and the registry it is for. A token read from stdin
+// is for the registry of the saved login, if any, or the default registry.
+func (s *tokenSource) load() (token, registryURL string, err error) {
+	if s.command != "" {
+		if s.stdin || s.file != "" {
+			return "", "", errors.New("--registry-auth-command cannot be combined with --token-file or --token-stdin")
+		}
+		return tokenFromCommand(s.command)
+	}
+	if !s.stdin {
+		return loadSavedToken(s.file)
+	}
+	if s.file != "" {
+		return "", "", errors.New("--token-stdin and --token-file cannot be used together")
+	}
+	if token, err = readTokenFromStdin(); err != nil {
+		return "", "", err
+	}
+	return token, validateRegistryURL(), nil
+}
+
+// readTokenFromStdin reads a token piped to stdin, such as a CI secret, so that it never
+// appears in the process arguments, the environment or on disk
+func readTokenFromStdin() (string, error) {
+	data, err := io.ReadAll(io.LimitReader(os.Stdin, maxStdinTokenSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read token from stdin: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.New("--token-stdin was given but stdin is empty")
+	}
+	return token, nil
+}
+
+// tokenFromCommand runs command, a credential helper such as "my-cred-helper get", and
+// returns the token and registry from its output, which has the format of the token file.
+// The command is split on whitespace and run without a shell. The token is only held in
+// memory, and an expired one is an error, since refreshing it is up to the helper.
+func tokenFromCommand(command string) (token, registryURL string, err error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return "", "", errors.New("--registry-auth-command is empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(context.Background(), args[0], args[1:]...) //nolint:gosec // The command is the user's own credential helper
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("registry auth command %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	var tokenInfo map[string]string
+	if err := json.Unmarshal(stdout.Bytes(), &tokenInfo); err != nil {
+		return "", "", fmt.Errorf("invalid output from registry auth command %s, expected the token file format: %w", args[0], err)
+	}
+	if strings.TrimSpace(tokenInfo["token"]) == "" {
+		return "", "", fmt.Errorf("output of registry auth command %s has no token", args[0])
+	}
+	if savedTokenExpired(tokenInfo) {
+		return "", "", fmt.Errorf("the token from registry auth command %s expired at %s", args[0], tokenInfo["expires_at"])
+	}
+	return tokenInfo["token"], savedRegistryURL(tokenInfo), nil
+}
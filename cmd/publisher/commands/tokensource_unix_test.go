@@ -0,0 +1,99 @@
+//go:build unix
+
+package commands_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCredentialHelper writes a shell script that prints output, writes stderr to stderr
+// and exits with exitCode, and returns its path
+func writeCredentialHelper(t *testing.T, output, stderr string, exitCode int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cred-helper")
+	script := "#!/bin/sh\ncat <<'EOF'\n" + output + "\nEOF\necho '" + stderr + "' >&2\nexit " + strconv.Itoa(exitCode) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(script), 0700)) //nolint:gosec // The helper must be executable
+	return path
+}
+
+func TestPublishCommand_RegistryAuthCommand(t *testing.T) {
+	var authorization string
+	server := SetupMockRegistryServer(t, func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+	}, nil)
+	// The saved login must not be used, and points at another registry
+	SetupTestToken(t, "https://registry.invalid", "saved-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	t.Run("token from the helper", func(t *testing.T) {
+		authorization = ""
+		helper := writeCredentialHelper(t, `{"token": "helper-token", "method": "github", "registry": "`+server.URL+`"}`, "", 0)
+
+		var err error
+		out := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--registry-auth-command", helper + " get --profile prod"})
+		})
+		require.NoError(t, err, out)
+		assert.Equal(t, "Bearer helper-token", authorization)
+	})
+
+	tests := []struct {
+		name          string
+		output        string
+		stderr        string
+		exitCode      int
+		args          []string
+		expectedError string
+	}{
+		{name: "output is not JSON", output: "helper-token", expectedError: "invalid output from registry auth command"},
+		{name: "output has no token", output: `{"registry": "` + server.URL + `"}`, expectedError: "has no token"},
+		{name: "expired token", output: `{"token": "old", "registry": "` + server.URL + `", "expires_at": "2020-01-01T00:00:00Z"}`, expectedError: "expired at 2020-01-01T00:00:00Z"},
+		{name: "helper fails", stderr: "vault is sealed", exitCode: 1, expectedError: "vault is sealed"},
+		{name: "combined with token-stdin", output: `{"token": "t"}`, args: []string{"--token-stdin"}, expectedError: "cannot be combined with --token-file or --token-stdin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorization = ""
+			helper := writeCredentialHelper(t, tt.output, tt.stderr, tt.exitCode)
+			err := commands.PublishCommand(append([]string{"--registry-auth-command", helper}, tt.args...))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.Empty(t, authorization, "nothing should be published")
+		})
+	}
+}
+
+func TestAuthCommand_TestRegistryAuthCommand(t *testing.T) {
+	var auth string
+	server := setupWhoAmIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"authMethod":"github-at","authMethodSubject":"octocat","permissions":[]}`))
+	})
+	SetupTestToken(t, "https://registry.invalid", "saved-token")
+	helper := writeCredentialHelper(t, `{"token": "helper-token", "registry": "`+server.URL+`"}`, "", 0)
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.AuthCommand([]string{"test", "--registry-auth-command", helper})
+	})
+	require.NoError(t, err, output)
+	assert.Equal(t, "Bearer helper-token", auth)
+	assert.Contains(t, output, "Registry: "+server.URL)
+
+	err = commands.AuthCommand([]string{"test", "--registry-auth-command", helper + "-missing"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "registry auth command")
+}
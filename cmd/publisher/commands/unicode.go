@@ -8,9 +8,93 @@ import (
 	"unicode/utf8"
 )
 
+// utf8BOM is the byte order mark some editors prepend to UTF-8 files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// prepareJSONInput checks that data read from filename is valid UTF-8 holding a single JSON
+// object and strips a leading byte order mark, so that encoding problems and appended
+// content are reported clearly instead of as JSON syntax errors or not at all
+func prepareJSONInput(filename string, data []byte) ([]byte, error) {
+	if offset := invalidUTF8Offset(data); offset >= 0 {
+		return nil, fmt.Errorf("%s contains invalid UTF-8 at offset %d", filename, offset)
+	}
+
+	trimmed := bytes.TrimPrefix(data, utf8BOM)
+	if offset := trailingDataOffset(trimmed); offset >= 0 {
+		// Report the offset in the file as read, including any byte order mark
+		offset += int64(len(data) - len(trimmed))
+		return nil, fmt.Errorf("%s has unexpected data after JSON object at offset %d", filename, offset)
+	}
+
+	if kind := topLevelKind(trimmed); kind != "" && kind != "an object" {
+		return nil, fmt.Errorf("invalid %s: expected a JSON object at the top level, got %s", filename, kind)
+	}
+
+	data = trimmed
+	if err := validateJSONUnicode(filename, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// invalidUTF8Offset returns the byte offset of the first invalid UTF-8 sequence in data,
+// or -1 if data is valid UTF-8
+func invalidUTF8Offset(data []byte) int {
+	for offset := 0; offset < len(data); {
+		r, size := utf8.DecodeRune(data[offset:])
+		if r == utf8.RuneError && size <= 1 {
+			return offset
+		}
+		offset += size
+	}
+	return -1
+}
+
+// trailingDataOffset returns the byte offset of the first non-whitespace byte after the
+// JSON value at the start of data, or -1 if there is none. Data that does not start with a
+// valid JSON value is left for the JSON parser to report.
+func trailingDataOffset(data []byte) int64 {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var value json.RawMessage
+	if err := dec.Decode(&value); err != nil {
+		return -1
+	}
+
+	end := dec.InputOffset()
+	rest := data[end:]
+	trailing := bytes.TrimLeft(rest, " \t\r\n")
+	if len(trailing) == 0 {
+		return -1
+	}
+	return end + int64(len(rest)-len(trailing))
+}
+
+// topLevelKind describes the kind of JSON value data holds, e.g. "an array", or returns ""
+// if data is not valid JSON, which is left for the JSON parser to report
+func topLevelKind(data []byte) string {
+	var value json.RawMessage
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&value); err != nil || len(value) == 0 {
+		return ""
+	}
+	switch value[0] {
+	case '{':
+		return "an object"
+	case '[':
+		return "an array"
+	case '"':
+		return "a string"
+	case 't', 'f':
+		return "a boolean"
+	case 'n':
+		return "null"
+	default:
+		return "a number"
+	}
+}
+
 func validateJSONUnicode(filename string, data []byte) error {
-	if !utf8.Valid(data) {
-		return fmt.Errorf("%s must be encoded as UTF-8", filename)
+	if offset := invalidUTF8Offset(data); offset >= 0 {
+		return fmt.Errorf("%s contains invalid UTF-8 at offset %d", filename, offset)
 	}
 
 	if path, ok := findUnpairedSurrogate(data, "$"); ok {
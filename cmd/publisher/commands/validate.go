@@ -4,11 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/modelcontextprotocol/registry/internal/validators"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
@@ -65,6 +71,20 @@ func printSchemaValidationErrors(result *validators.ValidationResult, serverJSON
 			_, _ = fmt.Fprintf(&formattedMsg, "%s. deprecated schema detected: %s. Expected current schema: %s. Migrate to the current schema format for new servers. 📋 Migration checklist: %s 📖 Full changelog with examples: %s", issue.Message, serverJSON.Schema, currentSchemaURL, checklistURL, migrationURL)
 			return formattedMsg.String() // Only one schema error at a time
 
+		case "schema-version-ahead":
+			// Schema newer than this binary
+			if issue.Severity == validators.ValidationIssueSeverityWarning {
+				_, _ = fmt.Fprintf(os.Stdout, "⚠️  Newer schema detected: %s\n", serverJSON.Schema)
+			} else {
+				_, _ = fmt.Fprintf(os.Stdout, "newer schema detected: %s.\n", serverJSON.Schema)
+			}
+			_, _ = fmt.Fprintln(os.Stdout)
+			_, _ = fmt.Fprintf(os.Stdout, "%s.\n", issue.Message)
+			_, _ = fmt.Fprintln(os.Stdout)
+
+			_, _ = fmt.Fprintf(&formattedMsg, "%s. newer schema detected: %s", issue.Message, serverJSON.Schema)
+			return formattedMsg.String() // Only one schema error at a time
+
 		case "schema-version-extraction-error":
 			// Invalid schema URL format - also include migration links for consistency
 			// Build formatted error message with migration links
@@ -76,26 +96,65 @@ func printSchemaValidationErrors(result *validators.ValidationResult, serverJSON
 	return ""
 }
 
-// printValidationIssues prints schema validation errors and all other validation issues.
+// friendlySchemaIssues are the references printSchemaValidationErrors describes in full
+var friendlySchemaIssues = []string{"schema-field-required", "schema-version-deprecated", "schema-version-ahead"}
+
+// issueListOptions controls how printValidationIssues lists issues
+type issueListOptions struct {
+	// maxIssues is the number of issues listed before the rest are summarized; 0 lists them all
+	maxIssues int
+	// showReferences starts every issue line with its reference code and also lists the
+	// schema issues that are otherwise only described by printSchemaValidationErrors
+	showReferences bool
+}
+
+// printValidationIssues prints schema validation errors and all other validation issues,
+// including the warnings of a valid document.
 // Returns the formatted error message string for schema validation errors (empty string if none).
-func printValidationIssues(result *validators.ValidationResult, serverJSON *apiv0.ServerJSON) string {
+func printValidationIssues(result *validators.ValidationResult, serverJSON *apiv0.ServerJSON, list issueListOptions) string {
 	// Print schema validation errors/warnings with friendly messages
 	formattedErrorMsg := printSchemaValidationErrors(result, serverJSON)
 
 	if result.Valid {
-		return formattedErrorMsg
+		listed := 0
+		for _, issue := range result.Issues {
+			if !isFriendlySchemaIssue(issue, list) {
+				listed++
+			}
+		}
+		if listed == 0 {
+			return formattedErrorMsg
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "⚠️  Validation passed with %d issue(s):\n", listed)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "❌ Validation failed with %d issue(s):\n", len(result.Issues))
 	}
-
-	// Print all issues
-	_, _ = fmt.Fprintf(os.Stdout, "❌ Validation failed with %d issue(s):\n", len(result.Issues))
 	_, _ = fmt.Fprintln(os.Stdout)
 
 	// Track which schema issues we've already printed to avoid duplicates
 	issueNum := 1
+	omitted := 0
 
 	for _, issue := range result.Issues {
 		// Skip schema issues that were already printed (they're printed by printSchemaValidationErrors above)
-		if issue.Reference == "schema-field-required" || issue.Reference == "schema-version-deprecated" {
+		if isFriendlySchemaIssue(issue, list) {
+			continue
+		}
+
+		if list.maxIssues > 0 && issueNum > list.maxIssues {
+			omitted++
+			continue
+		}
+
+		if list.showReferences {
+			reference := issue.Reference
+			if reference == "" {
+				reference = "-"
+			}
+			_, _ = fmt.Fprintf(os.Stdout, "%d. [%s] [%s] %s (%s)\n", issueNum, reference, issue.Severity, issue.Path, issue.Type)
+			_, _ = fmt.Fprintf(os.Stdout, "   %s\n", issue.Message)
+			_, _ = fmt.Fprintln(os.Stdout)
+			issueNum++
 			continue
 		}
 
@@ -109,50 +168,762 @@ func printValidationIssues(result *validators.ValidationResult, serverJSON *apiv
 		issueNum++
 	}
 
+	if omitted > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "… and %d more\n", omitted)
+		_, _ = fmt.Fprintln(os.Stdout)
+	}
+
 	return formattedErrorMsg
 }
 
-func ValidateCommand(args []string) error {
-	// Parse arguments
-	serverFile := "server.json"
+// isFriendlySchemaIssue reports whether issue is only described by printSchemaValidationErrors
+// rather than listed with the other issues
+func isFriendlySchemaIssue(issue validators.ValidationIssue, list issueListOptions) bool {
+	return !list.showReferences && slices.Contains(friendlySchemaIssues, issue.Reference)
+}
 
-	for _, arg := range args {
-		if arg == "--help" || arg == "-h" {
-			_, _ = fmt.Fprintln(os.Stdout, "Usage: mcp-publisher validate [file]")
-			_, _ = fmt.Fprintln(os.Stdout)
-			_, _ = fmt.Fprintln(os.Stdout, "Validate a server.json file without publishing.")
-			_, _ = fmt.Fprintln(os.Stdout)
-			_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
-			_, _ = fmt.Fprintln(os.Stdout, "  file    Path to server.json file (default: ./server.json)")
-			_, _ = fmt.Fprintln(os.Stdout)
-			_, _ = fmt.Fprintln(os.Stdout, "The validate command performs exhaustive validation, reporting all issues at once.")
-			_, _ = fmt.Fprintln(os.Stdout, "It validates JSON syntax, schema compliance, and semantic rules.")
-			return nil
+func printValidateUsage() {
+	_, _ = fmt.Fprintln(os.Stdout, "Usage: mcp-publisher validate [options] [file]")
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "Validate a server.json file without publishing.")
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
+	_, _ = fmt.Fprintln(os.Stdout, "  file    Path to server.json file, a .tar.gz or .tgz archive containing it,")
+	_, _ = fmt.Fprintln(os.Stdout, "          oci://registry/repository:tag or - for stdin (default: ./server.json).")
+	_, _ = fmt.Fprintln(os.Stdout, "          If a directory is given, every server.json below it is validated.")
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "The validate command performs exhaustive validation, reporting all issues at once.")
+	_, _ = fmt.Fprintln(os.Stdout, "It validates JSON syntax, schema compliance, and semantic rules.")
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "Options:")
+	_, _ = fmt.Fprintln(os.Stdout, "  --api-prefix path")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Path below the registry URL where its API is mounted (default: v0)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --auth-validate  Send the saved registry token with the validate request, for registries that require it")
+	_, _ = fmt.Fprintln(os.Stdout, "  --baseline path  Only fail on issues not present in this saved validation result")
+	_, _ = fmt.Fprintln(os.Stdout, "  --check-namespace-domain")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Warn if the domain of the name's reverse-DNS namespace does not resolve")
+	_, _ = fmt.Fprintln(os.Stdout, "  --check-package-versions")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Warn about packages whose version differs from the server version")
+	_, _ = fmt.Fprintln(os.Stdout, "  --dump path      Validate every server.json in a registry export, a JSON array of server.json documents")
+	_, _ = fmt.Fprintln(os.Stdout, "  --entry path     Validate this member of a .tar.gz or .tgz archive (default: server.json)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --events-fd int  Also write JSON progress events of directory or lockfile validation to this file descriptor")
+	_, _ = fmt.Fprintln(os.Stdout, "  --exit-zero      Exit with status 0 even if validation fails, still reporting every issue")
+	_, _ = fmt.Fprintln(os.Stdout, "  --explain-all    Print every validation rule as JSON and exit")
+	_, _ = fmt.Fprintln(os.Stdout, "  --extract-key string")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Validate the object under this top-level key of the file (e.g. mcp in package.json)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --fail-on string Fail on issues of at least this severity: error, warning or none (default: error)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --fix-schema     Update the $schema field of the file in place to the current schema, then validate")
+	_, _ = fmt.Fprintln(os.Stdout, "  --format string  Output format: text, table, json, github, events or sarif (default: text)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --ignore list    Do not report issues with these comma-separated references")
+	_, _ = fmt.Fprintln(os.Stdout, "  --input-format string")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Parse server.json as json, yaml, jsonl or template instead of detecting it from the file extension")
+	_, _ = fmt.Fprintln(os.Stdout, "  --lockfile path  Fetch and validate every server.json listed in a name-to-URL lockfile")
+	_, _ = fmt.Fprintln(os.Stdout, "  --max-issues int List at most this many issues, summarizing the rest (default: unlimited)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --merge          Deep-merge the given files into one server.json, later files overriding earlier ones")
+	_, _ = fmt.Fprintln(os.Stdout, "  --merge-arrays string")
+	_, _ = fmt.Fprintln(os.Stdout, "                   How --merge combines arrays: replace (default) or append")
+	_, _ = fmt.Fprintln(os.Stdout, "  --name string    Override the name field of server.json")
+	_, _ = fmt.Fprintln(os.Stdout, "  --no-truncate    Do not truncate long messages in table output")
+	_, _ = fmt.Fprintln(os.Stdout, "  --offline        Validate locally without contacting the registry")
+	_, _ = fmt.Fprintln(os.Stdout, "  --only list      Only report issues with these comma-separated references")
+	_, _ = fmt.Fprintln(os.Stdout, "  --output-dir path")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Write a <path>.report.json per file of a directory or lockfile validation below this directory")
+	_, _ = fmt.Fprintln(os.Stdout, "  --parallel-validate int")
+	_, _ = fmt.Fprintf(os.Stdout, "                   Validate this many files of a directory at once via the registry (default: %d, at most %d)\n", defaultParallelValidate, maxParallelValidate)
+	_, _ = fmt.Fprintln(os.Stdout, "  --path path      Read server.json from this path of a GitHub repository URL (default: server.json)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --print-request  Print each registry request as an equivalent curl command (token redacted)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --profile string Validation profile selecting which rules are reported and at what severity:")
+	_, _ = fmt.Fprintln(os.Stdout, "                   default, strict or lenient (default: default)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --proxy string   Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --registry string")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Validate against this registry URL or alias from the config file (default: logged-in registry)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --remote-schema  Validate locally against the schema served by the registry instead of the built-in one")
+	_, _ = fmt.Fprintln(os.Stdout, "  --report path    Write the --format sarif report to this file instead of stdout")
+	_, _ = fmt.Fprintln(os.Stdout, "  --require-license")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Require the license field to be a recognized SPDX license identifier")
+	_, _ = fmt.Fprintln(os.Stdout, "  --schema-ref-dir path")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Resolve external $refs of the schema from files in this directory instead of failing")
+	_, _ = fmt.Fprintln(os.Stdout, "  --schema-versions list")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Validate locally against each comma-separated schema version and report per-version results")
+	_, _ = fmt.Fprintln(os.Stdout, "  --show-references")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Start every issue line in text output with its reference code, including schema issues")
+	_, _ = fmt.Fprintln(os.Stdout, "  --severity string")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Only report issues of at least this severity: error, warning or all (default: all)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --strict         Report security warnings such as url-insecure-scheme as errors")
+	_, _ = fmt.Fprintln(os.Stdout, "  --summary-only   Only print failing files of a directory or lockfile validation and the summary")
+	_, _ = fmt.Fprintln(os.Stdout, "  --template       Render server.json as a Go template before parsing (implied by a .tmpl extension)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --update-baseline")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Write the current issues to the --baseline file and exit")
+	_, _ = fmt.Fprintln(os.Stdout, "  --use-embedded-schema")
+	_, _ = fmt.Fprintln(os.Stdout, "                   Validate offline against the schema built into this binary and print its version")
+	_, _ = fmt.Fprintln(os.Stdout, "  --values path    Read template variables from this JSON object file")
+	_, _ = fmt.Fprintln(os.Stdout, "  --var key=value  Set a template variable (repeatable)")
+	_, _ = fmt.Fprintln(os.Stdout, "  --version string Override the version field of server.json (must be a specific version)")
+}
+
+// validateOptions holds the parsed flags of the validate command
+type validateOptions struct {
+	explainAll     bool
+	proxy          string
+	offline        bool
+	lockfile       string
+	dump           string
+	baselinePath   string
+	updateBaseline bool
+	format         string
+	reportPath     string
+	// outputDir is the directory --output-dir writes a report per validated item to
+	outputDir      string
+	summaryOnly    bool
+	noTruncate     bool
+	maxIssues      int
+	showReferences bool
+	severity       string
+	failOn         string
+	schemaVersions []string
+	printRequest   bool
+	exitZero       bool
+	extractKey     string
+	embedded       bool
+	remoteSchema   bool
+	// schema is the registry's schema fetched for --remote-schema
+	schema          []byte
+	schemaRefDir    string
+	eventsFD        int
+	apiPrefix       string
+	registry        string
+	only            []string
+	ignore          []string
+	strict          bool
+	requireLicense  bool
+	packageVersions bool
+	namespaceDomain bool
+	authValidate    bool
+	fixSchema       bool
+	// parallelValidate is how many files of a directory are validated at once via the registry
+	parallelValidate int
+	// profile is the validation profile selected with --profile
+	profile   *validators.Profile
+	template  serverTemplate
+	overrides serverOverrides
+	archive   serverArchive
+	github    serverGitHubRepo
+	input     inputFormat
+	merge     serverMerge
+}
+
+// parseValidateFlags parses the validate command's flags and checks that they are consistent.
+// It returns the options and the positional arguments.
+func parseValidateFlags(args []string) (*validateOptions, []string, error) {
+	opts := &validateOptions{}
+
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.Usage = printValidateUsage
+	fs.BoolVar(&opts.explainAll, "explain-all", false, "Print every validation rule as JSON and exit")
+	fs.StringVar(&opts.proxy, "proxy", "", "Proxy URL for registry requests")
+	fs.BoolVar(&opts.offline, "offline", false, "Validate locally without contacting the registry")
+	fs.StringVar(&opts.lockfile, "lockfile", "", "Fetch and validate every server.json listed in a name-to-URL lockfile")
+	fs.StringVar(&opts.dump, "dump", "", "Validate every server.json in a registry export, a JSON array of server.json documents")
+	fs.StringVar(&opts.baselinePath, "baseline", "", "Only fail on issues not present in this saved validation result")
+	fs.BoolVar(&opts.updateBaseline, "update-baseline", false, "Write the current issues to the --baseline file and exit")
+	fs.StringVar(&opts.format, "format", formatText, "Output format: text, table, json, github, events or sarif")
+	fs.StringVar(&opts.reportPath, "report", "", "Write the --format sarif report to this file instead of stdout")
+	fs.BoolVar(&opts.summaryOnly, "summary-only", false, "Only print failing files of a directory or lockfile validation and the summary")
+	fs.StringVar(&opts.outputDir, "output-dir", "", "Write a report for each file of a directory or lockfile validation below this directory")
+	fs.StringVar(&opts.apiPrefix, "api-prefix", "", "Path below the registry URL where its API is mounted (default: v0)")
+	fs.StringVar(&opts.registry, "registry", "", "Validate against this registry URL or alias from the config file (default: the logged-in registry)")
+	fs.IntVar(&opts.eventsFD, "events-fd", 0, "Also write JSON progress events of directory or lockfile validation to this file descriptor")
+	fs.BoolVar(&opts.noTruncate, "no-truncate", false, "Do not truncate long messages in table output")
+	fs.IntVar(&opts.maxIssues, "max-issues", 0, "List at most this many issues (0 for unlimited)")
+	fs.BoolVar(&opts.showReferences, "show-references", false, "Start every issue line in text output with its reference code")
+	fs.StringVar(&opts.severity, "severity", severityAll, "Only report issues of at least this severity: error, warning or all")
+	fs.StringVar(&opts.failOn, "fail-on", failOnError, "Fail on issues of at least this severity: error, warning or none")
+	fs.BoolVar(&opts.strict, "strict", false, "Report security warnings such as url-insecure-scheme as errors")
+	profile := fs.String("profile", validators.ProfileDefault, "Validation profile: default, strict or lenient")
+	fs.BoolVar(&opts.requireLicense, "require-license", false, "Require the license field to be a recognized SPDX license identifier")
+	fs.BoolVar(&opts.packageVersions, "check-package-versions", false, "Warn about packages whose version differs from the server version")
+	fs.BoolVar(&opts.namespaceDomain, "check-namespace-domain", false, "Warn if the domain of the name's reverse-DNS namespace does not resolve")
+	fs.BoolVar(&opts.authValidate, "auth-validate", false, "Send the saved registry token with the validate request, for registries that require it")
+	fs.BoolVar(&opts.fixSchema, "fix-schema", false, "Update the $schema field of the file in place to the current schema, then validate")
+	fs.BoolVar(&opts.exitZero, "exit-zero", false, "Exit with status 0 even if validation fails, still reporting every issue")
+	fs.IntVar(&opts.parallelValidate, "parallel-validate", defaultParallelValidate, "Validate this many files of a directory at once when validating via the registry")
+	opts.overrides.register(fs)
+	opts.template.register(fs)
+	opts.archive.register(fs)
+	opts.github.register(fs)
+	opts.input.register(fs)
+	opts.merge.register(fs)
+	fs.BoolVar(&opts.printRequest, "print-request", false, "Print each registry request as an equivalent curl command")
+	fs.StringVar(&opts.extractKey, "extract-key", "", "Validate the object under this top-level key of the file, e.g. mcp in package.json")
+	fs.BoolVar(&opts.embedded, "use-embedded-schema", false, "Validate offline against the schema built into this binary and print its version")
+	fs.BoolVar(&opts.remoteSchema, "remote-schema", false, "Validate locally against the schema served by the registry instead of the built-in one")
+	fs.StringVar(&opts.schemaRefDir, "schema-ref-dir", "", "Resolve external $refs of the schema from files in this directory")
+	only := fs.String("only", "", "Only report issues with these comma-separated references")
+	ignore := fs.String("ignore", "", "Do not report issues with these comma-separated references")
+	schemaVersions := fs.String("schema-versions", "", "Validate locally against each comma-separated schema version")
+
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts.only = splitReferences(*only)
+	opts.ignore = splitReferences(*ignore)
+	if opts.profile, err = validators.LookupProfile(*profile); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.check(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.checkInputFormat(); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.checkMerge(positional); err != nil {
+		return nil, nil, err
+	}
+	if opts.parallelValidate < 1 || opts.parallelValidate > maxParallelValidate {
+		return nil, nil, fmt.Errorf("invalid parallel-validate %d. Must be between 1 and %d", opts.parallelValidate, maxParallelValidate)
+	}
+	if opts.embedded {
+		// The embedded schemas are only used by local validation
+		opts.offline = true
+	}
+	if opts.apiPrefix, err = resolveAPIPrefix(opts.apiPrefix); err != nil {
+		return nil, nil, err
+	}
+	if opts.registry != "" {
+		if opts.registry, err = resolveRegistryURL(opts.registry); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if *schemaVersions != "" {
+		if opts.lockfile != "" || opts.baselinePath != "" || opts.remoteSchema || opts.format == formatGitHub || opts.format == formatSARIF {
+			return nil, nil, fmt.Errorf("--schema-versions cannot be combined with --lockfile, --baseline, --remote-schema or --format github or sarif")
 		}
-		if !strings.HasPrefix(arg, "-") {
-			serverFile = arg
+		if opts.schemaVersions, err = parseSchemaVersions(*schemaVersions); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	// Read server file
-	serverData, err := os.ReadFile(serverFile)
+	return opts, positional, nil
+}
+
+// check reports invalid flag values and combinations
+func (o *validateOptions) check() error {
+	if !slices.Contains([]string{formatText, formatTable, formatJSON, formatGitHub, formatEvents, formatSARIF}, o.format) {
+		return fmt.Errorf("invalid format '%s'. Must be one of: %s, %s, %s, %s, %s, %s", o.format, formatText, formatTable, formatJSON, formatGitHub, formatEvents, formatSARIF)
+	}
+	if o.reportPath != "" && o.format != formatSARIF {
+		return fmt.Errorf("--report requires --format sarif")
+	}
+	if !slices.Contains([]string{severityError, severityWarning, severityAll}, o.severity) {
+		return fmt.Errorf("invalid severity '%s'. Must be one of: %s, %s, %s", o.severity, severityError, severityWarning, severityAll)
+	}
+	if !slices.Contains([]string{failOnError, failOnWarning, failOnNone}, o.failOn) {
+		return fmt.Errorf("invalid fail-on '%s'. Must be one of: %s, %s, %s", o.failOn, failOnError, failOnWarning, failOnNone)
+	}
+	if o.eventsFD < 0 {
+		return fmt.Errorf("invalid events-fd %d. Must be a file descriptor open for writing", o.eventsFD)
+	}
+	if o.eventsFD > 0 && o.format == formatEvents {
+		return fmt.Errorf("--events-fd cannot be combined with --format events")
+	}
+	if o.maxIssues < 0 {
+		return fmt.Errorf("invalid max-issues %d. Must be 0 (unlimited) or greater", o.maxIssues)
+	}
+	if o.updateBaseline && o.baselinePath == "" {
+		return fmt.Errorf("--update-baseline requires --baseline")
+	}
+	if o.lockfile != "" && o.baselinePath != "" {
+		return fmt.Errorf("--baseline cannot be combined with --lockfile")
+	}
+	if o.remoteSchema && (o.offline || o.embedded) {
+		return fmt.Errorf("--remote-schema cannot be combined with --offline or --use-embedded-schema")
+	}
+	if o.schemaRefDir != "" && !o.offline && !o.embedded && !o.remoteSchema {
+		return fmt.Errorf("--schema-ref-dir requires local validation with --offline, --use-embedded-schema or --remote-schema")
+	}
+	if o.fixSchema && (o.lockfile != "" || o.extractKey != "" || o.template.used()) {
+		return fmt.Errorf("--fix-schema cannot be combined with --lockfile, --extract-key, --template, --var or --values")
+	}
+	if o.lockfile != "" && o.extractKey != "" {
+		return fmt.Errorf("--extract-key cannot be combined with --lockfile")
+	}
+	if o.lockfile != "" && (len(o.only) > 0 || len(o.ignore) > 0 || o.template.used() || o.archive.entry != "" || o.github.path != "") {
+		return fmt.Errorf("--only, --ignore, --template, --var, --values, --entry and --path cannot be combined with --lockfile")
+	}
+	return nil
+}
+
+// checkInputFormat checks --input-format, which only applies to a single server.json
+func (o *validateOptions) checkInputFormat() error {
+	if o.input.name != "" && (o.lockfile != "" || o.dump != "") {
+		return fmt.Errorf("--input-format cannot be combined with --lockfile or --dump")
+	}
+	return o.input.init(&o.template)
+}
+
+// checkMerge checks --merge, which combines the positional arguments into one server.json
+func (o *validateOptions) checkMerge(positional []string) error {
+	if o.merge.enabled && (o.lockfile != "" || o.dump != "" || o.fixSchema) {
+		return fmt.Errorf("--merge cannot be combined with --lockfile, --dump or --fix-schema")
+	}
+	return o.merge.init(positional)
+}
+
+// checkDirectory reports flags that directory validation does not support
+func (o *validateOptions) checkDirectory() error {
+	if !slices.Contains([]string{formatText, formatEvents, formatSARIF}, o.format) || o.baselinePath != "" || len(o.schemaVersions) > 0 || o.extractKey != "" || o.fixSchema {
+		return fmt.Errorf("validating a directory does not support --format (other than events and sarif), --baseline, --schema-versions, --extract-key or --fix-schema")
+	}
+	if len(o.only) > 0 || len(o.ignore) > 0 || o.template.used() || o.archive.entry != "" || o.github.path != "" || o.input.name != "" || o.merge.enabled {
+		return fmt.Errorf("validating a directory does not support --only, --ignore, --template, --var, --values, --entry, --path, --input-format or --merge")
+	}
+	return nil
+}
+
+// checkDump reports flags that validating a registry export does not support
+func (o *validateOptions) checkDump() error {
+	if o.format != formatText || o.eventsFD > 0 || o.outputDir != "" || o.summaryOnly || o.lockfile != "" || o.baselinePath != "" || o.extractKey != "" || o.fixSchema || len(o.schemaVersions) > 0 {
+		return fmt.Errorf("--dump cannot be combined with --format, --events-fd, --output-dir, --summary-only, --lockfile, --baseline, --extract-key, --fix-schema or --schema-versions")
+	}
+	if len(o.only) > 0 || len(o.ignore) > 0 || o.template.used() || o.archive.entry != "" || o.github.path != "" {
+		return fmt.Errorf("--dump cannot be combined with --only, --ignore, --template, --var, --values, --entry or --path")
+	}
+	return nil
+}
+
+// checkSingleFile reports flags that validating a single server.json does not support
+func (o *validateOptions) checkSingleFile() error {
+	if o.format == formatEvents || o.eventsFD > 0 {
+		return fmt.Errorf("--format events and --events-fd are only supported when validating a directory or --lockfile")
+	}
+	if o.outputDir != "" {
+		return fmt.Errorf("--output-dir is only supported when validating a directory or --lockfile")
+	}
+	if o.summaryOnly {
+		return fmt.Errorf("--summary-only is only supported when validating a directory or --lockfile")
+	}
+	return nil
+}
+
+// errValidateUnsupported is returned by validateViaAPI for registries that predate the
+// validate endpoint
+var errValidateUnsupported = errors.New("registry does not support validation")
+
+// validateUnsupportedNotice is printed when validation falls back to local validation
+// because of errValidateUnsupported
+const validateUnsupportedNotice = "notice: registry does not support validation; validated locally instead"
+
+// validateFailedNotice is printed when publish explains a rejection by local validation
+// because the validate endpoint kept failing with server errors
+const validateFailedNotice = "notice: registry validation kept failing; validated locally instead"
+
+// validationMode selects where validateServer validates a server.json
+type validationMode struct {
+	// offline validates locally instead of via the registry's validate endpoint
+	offline bool
+	// apiPrefix is the registry's API path prefix, as returned by resolveAPIPrefix
+	apiPrefix string
+	// registryURL is the registry to validate against; empty for the logged-in registry
+	registryURL string
+	// requireLicense reports a missing or unrecognized SPDX license, also enabled by the
+	// requireLicense config setting
+	requireLicense bool
+	// packageVersions warns about packages whose version differs from the server version,
+	// also enabled by the checkPackageVersions config setting
+	packageVersions bool
+	// namespaceDomain warns if the domain of the server name's namespace does not resolve
+	namespaceDomain bool
+	// schema, if set, is validated against locally instead of the embedded schema
+	schema []byte
+	// schemaRefDir is the directory external $refs of the schema are resolved from
+	schemaRefDir string
+	// authValidate sends the saved token to the validate endpoint
+	authValidate bool
+	// parallel is how many files directory validation checks at once via the registry
+	parallel int
+	// profile adjusts which issues are reported and at what severity; nil reports all of
+	// them at their default severity
+	profile *validators.Profile
+}
+
+// validateToken returns the token sent to the validate endpoint of registryURL: the saved
+// token with --auth-validate, and none otherwise. A token saved for a different registry is
+// an error rather than being disclosed to registryURL.
+func (m validationMode) validateToken(registryURL string) (string, error) {
+	if !m.authValidate {
+		return "", nil
+	}
+	token, tokenRegistry, err := loadSavedToken("")
 	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("%s not found, please check the file path", serverFile)
+		return "", err
+	}
+	if strings.TrimRight(tokenRegistry, "/") != strings.TrimRight(registryURL, "/") {
+		return "", fmt.Errorf("--auth-validate: the saved token is for %s, not %s; run 'mcp-publisher login <method> --registry %s' first", tokenRegistry, registryURL, registryURL)
+	}
+	return token, nil
+}
+
+// mode returns where the validate command validates
+func (o *validateOptions) mode() validationMode {
+	return validationMode{offline: o.offline, apiPrefix: o.apiPrefix, registryURL: o.registry, requireLicense: o.requireLicense, packageVersions: o.packageVersions, namespaceDomain: o.namespaceDomain, schema: o.schema, schemaRefDir: o.schemaRefDir, authValidate: o.authValidate, parallel: o.parallelValidate, profile: o.profile}
+}
+
+// issueList returns how text output lists issues
+func (o *validateOptions) issueList() issueListOptions {
+	return issueListOptions{maxIssues: o.maxIssues, showReferences: o.showReferences}
+}
+
+// issuePolicy returns the options that apply to every server.json of a directory or lockfile
+func (o *validateOptions) issuePolicy() issuePolicy {
+	return issuePolicy{strict: o.strict, failOn: o.failOn, severity: o.severity, list: o.issueList()}
+}
+
+// progressWriter returns where progress messages go. They are kept off stdout for
+// machine-readable formats so the output can be piped.
+func (o *validateOptions) progressWriter() io.Writer {
+	if o.format == formatJSON || o.format == formatEvents || o.format == formatSARIF {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+func ValidateCommand(args []string) error {
+	opts, positional, err := parseValidateFlags(args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if opts.explainAll {
+		return printRules()
+	}
+
+	err = runValidate(opts, positional)
+	var failure *validationFailedError
+	if opts.exitZero && errors.As(err, &failure) {
+		_, _ = fmt.Fprintf(os.Stderr, "Ignoring validation failure because of --exit-zero: %v\n", err)
+		return nil
+	}
+	return err
+}
+
+// runValidate validates the server.json, directory, lockfile or registry export selected by opts and
+// positional, printing the report
+func runValidate(opts *validateOptions, positional []string) error {
+	if opts.offline {
+		// Compile the schema while the server.json is being read; errors are reported by validation
+		go func() { _ = validators.WarmUp() }()
+	}
+
+	client, err := newHTTPClient(opts.proxy)
+	if err != nil {
+		return err
+	}
+	if err := opts.loadRemoteSchema(client); err != nil {
+		return err
+	}
+
+	if batch, err := runBatchValidate(client, opts); batch {
+		return err
+	}
+
+	serverFile := "server.json"
+	if len(positional) > 0 {
+		serverFile = positional[0]
+	}
+
+	if opts.printRequest {
+		printRequests(client, opts.progressWriter(), requestBodyFile(serverFile))
+	}
+
+	if info, err := os.Stat(serverFile); err == nil && info.IsDir() {
+		if err := opts.checkDirectory(); err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to read %s: %w", serverFile, err)
+		return opts.withBatchOutput(func(out batchOutput) error {
+			return validateDirectory(client, serverFile, opts.mode(), opts.issuePolicy(), out)
+		})
+	}
+	if err := opts.checkSingleFile(); err != nil {
+		return err
 	}
-	if err := validateJSONUnicode(serverFile, serverData); err != nil {
+
+	serverData, serverJSON, err := readServerFile(client, serverFile, opts)
+	if err != nil {
 		return err
 	}
 
+	if len(opts.schemaVersions) > 0 {
+		return validateSchemaVersions(serverJSON, opts)
+	}
+
+	progress := opts.progressWriter()
+	if opts.embedded {
+		printEmbeddedSchema(progress, serverJSON)
+	}
+	result, err := validateServer(client, serverData, serverJSON, opts.mode(), progress)
+	if err != nil {
+		return operationFailed("validation", err)
+	}
+
+	if opts.updateBaseline {
+		if err := writeBaseline(opts.baselinePath, result); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(progress, "✓ Wrote %d issue(s) to baseline %s\n", len(result.Issues), opts.baselinePath)
+		return nil
+	}
+
+	if opts.baselinePath != "" {
+		if result, err = applyBaseline(result, opts.baselinePath, progress); err != nil {
+			return err
+		}
+	}
+
+	if opts.strict {
+		result = escalateIssues(result, strictReferences)
+	}
+	result = filterIssuesByReference(result, opts.only, opts.ignore)
+	return reportValidation(result, serverJSON, serverFile, opts)
+}
+
+// runBatchValidate validates the lockfile or registry export selected by opts, if any. It
+// reports whether one was selected.
+func runBatchValidate(client *http.Client, opts *validateOptions) (bool, error) {
+	switch {
+	case opts.lockfile != "":
+		return true, opts.withBatchOutput(func(out batchOutput) error {
+			return validateLockfile(client, opts.lockfile, opts.mode(), opts.issuePolicy(), out)
+		})
+	case opts.dump != "":
+		if err := opts.checkDump(); err != nil {
+			return true, err
+		}
+		return true, validateDump(client, opts.dump, opts.mode(), os.Stdout)
+	default:
+		return false, nil
+	}
+}
+
+// readServerFile reads and parses the server.json to validate, applying any overrides. With --merge, it is
+// the fragments given as arguments merged in order, and otherwise serverFile.
+func readServerFile(client *http.Client, serverFile string, opts *validateOptions) ([]byte, *apiv0.ServerJSON, error) {
+	serverData, err := opts.merge.read(serverFile, func(file string) ([]byte, error) {
+		return readServerData(client, file, opts)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Validate JSON
 	var serverJSON apiv0.ServerJSON
 	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
-		return fmt.Errorf("invalid JSON: %w", err)
+		return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	serverData, err = opts.overrides.apply(serverData, &serverJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return serverData, &serverJSON, nil
+}
+
+// readServerData reads the JSON of a server.json either from a local file, stdin, a member of a .tar.gz
+// or .tgz archive, an oci:// artifact reference or a GitHub repository URL. Templates are rendered first and YAML or JSON Lines is
+// converted to JSON. With --extract-key, the server.json is the object under that key.
+func readServerData(client *http.Client, serverFile string, opts *validateOptions) ([]byte, error) {
+	if err := opts.archive.check(serverFile); err != nil {
+		return nil, err
+	}
+	if err := opts.github.check(serverFile); err != nil {
+		return nil, err
 	}
+	if opts.fixSchema && (isOCIReference(serverFile) || isArchive(serverFile) || isGitHubRepoURL(serverFile)) {
+		return nil, fmt.Errorf("--fix-schema requires a local server.json file")
+	}
+
+	var serverData []byte
+	var err error
+	switch {
+	case isOCIReference(serverFile):
+		serverData, err = pullOCIServerJSON(client, serverFile)
+	case isArchive(serverFile):
+		serverData, err = opts.archive.read(serverFile)
+	case isGitHubRepoURL(serverFile):
+		serverData, err = opts.github.read(client, serverFile)
+	default:
+		serverData, err = readLocalServerFile(serverFile, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if serverData, err = opts.template.render(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if serverData, err = opts.input.decode(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if serverData, err = prepareJSONInput(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	if opts.extractKey != "" {
+		return extractServerJSON(serverFile, serverData, opts.extractKey)
+	}
+	if err := checkServerResponse(serverFile, serverData); err != nil {
+		return nil, err
+	}
+	return serverData, nil
+}
 
-	// Get registry URL (same pattern as publish)
+// readLocalServerFile reads the server.json file to validate, or stdin for -, first
+// updating its $schema with --fix-schema
+func readLocalServerFile(serverFile string, opts *validateOptions) ([]byte, error) {
+	if opts.fixSchema && (serverFile == stdinSource || opts.input.detect(serverFile) != inputFormatJSON) {
+		return nil, fmt.Errorf("--fix-schema requires a server.json file in JSON format")
+	}
+	if serverFile == stdinSource {
+		return readStdin()
+	}
+	if opts.fixSchema {
+		if err := fixSchemaField(serverFile, opts.progressWriter()); err != nil {
+			return nil, err
+		}
+	}
+	serverData, err := os.ReadFile(serverFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s not found, please check the file path", serverFile)
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", serverFile, err)
+	}
+	return serverData, nil
+}
+
+// validateServer checks that required fields are present and, if they are, performs
+// full validation either locally (offline) or via the registry's validate endpoint.
+// Progress messages are written to progress.
+func validateServer(client *http.Client, serverData []byte, serverJSON *apiv0.ServerJSON, mode validationMode, progress io.Writer) (*validators.ValidationResult, error) {
+	// Report missing required fields without a round-trip
+	result := checkRequiredFields(serverJSON)
+	if !result.Valid {
+		return result, nil
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	localOpts, err := config.localValidationOptions()
+	if err != nil {
+		return nil, err
+	}
+	localOpts.SchemaRefDir = mode.schemaRefDir
+
+	switch {
+	case mode.schema != nil:
+		_, _ = fmt.Fprintln(progress, "Validating locally against the registry's schema...")
+		localOpts.Schema = mode.schema
+		result = validators.ValidateServerJSON(serverJSON, localOpts)
+	case mode.offline:
+		_, _ = fmt.Fprintln(progress, "Validating locally...")
+		result = validators.ValidateServerJSON(serverJSON, localOpts)
+	default:
+		registryURL := mode.registryURL
+		if registryURL == "" {
+			registryURL = validateRegistryURL()
+		}
+		_, _ = fmt.Fprintf(progress, "Validating against %s...\n", registryURL)
+		token, err := mode.validateToken(registryURL)
+		if err != nil {
+			return nil, err
+		}
+		result, err = validateViaAPI(client, registryURL, mode.apiPrefix, token, mode.profile, serverData)
+		if errors.Is(err, errValidateUnsupported) {
+			result, err = validators.ValidateServerJSON(serverJSON, localOpts), nil
+			_, _ = fmt.Fprintln(progress, validateUnsupportedNotice)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if result.Truncated {
+			_, _ = fmt.Fprintf(progress, "notice: the registry only returned the first %d issues; validate with --offline to list all of them\n", len(result.Issues))
+		}
+		// A $schema that cannot be fetched is only a warning, so the rest is still validated
+		if issue := checkSchemaURL(client, serverJSON.Schema); issue != nil {
+			result.AddIssue(*issue)
+		}
+		// Publish refuses reserved namespaces for production registries, see checkReservedNamespace
+		if config.isProductionRegistry(registryURL) {
+			result.Merge(validators.ValidateReservedNamespace(serverJSON, validators.DefaultReservedNamespaces))
+		}
+	}
+
+	// Recommended fields are a publisher-side listing-quality check, so they are
+	// reported in both modes
+	recommended, err := validators.ValidateRecommendedFields(serverJSON, config.recommendedFields())
+	if err != nil {
+		return nil, fmt.Errorf("invalid recommendedFields in config: %w", err)
+	}
+	result.Merge(recommended)
+	// Duplicate keys are lost when server.json is decoded, so only the raw file shows them
+	result.Merge(validators.ValidateDuplicateKeys(serverData))
+	if mode.requireLicense || config.RequireLicense {
+		result.Merge(validators.ValidateLicense(serverData))
+	}
+	if mode.packageVersions || config.CheckPackageVersions {
+		result.Merge(validators.ValidatePackageVersions(serverJSON))
+	}
+	if mode.namespaceDomain {
+		result.Merge(checkNamespaceDomain(serverJSON))
+	}
+	// The registry applies the profile too, but not to the issues found here or by
+	// registries that predate profiles
+	return mode.profile.Apply(result), nil
+}
+
+// namespaceLookupTimeout bounds the DNS lookups of --check-namespace-domain
+const namespaceLookupTimeout = 10 * time.Second
+
+// checkNamespaceDomain warns if the domain of serverJSON's namespace does not resolve
+func checkNamespaceDomain(serverJSON *apiv0.ServerJSON) *validators.ValidationResult {
+	ctx, cancel := context.WithTimeout(context.Background(), namespaceLookupTimeout)
+	defer cancel()
+	return validators.ValidateNamespaceDomain(ctx, serverJSON, net.DefaultResolver)
+}
+
+// validateLocally validates serverJSON against the embedded schema with the limits of the
+// config file
+func validateLocally(serverJSON *apiv0.ServerJSON) (*validators.ValidationResult, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts, err := config.localValidationOptions()
+	if err != nil {
+		return nil, err
+	}
+	return validators.ValidateServerJSON(serverJSON, opts), nil
+}
+
+// printEmbeddedSchema reports which embedded schema version validates serverJSON. When its
+// $schema selects no embedded version nothing is printed; validation reports why.
+func printEmbeddedSchema(w io.Writer, serverJSON *apiv0.ServerJSON) {
+	version, err := validators.EmbeddedSchemaVersion(serverJSON.Schema)
+	if err != nil {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "Using embedded schema %s (current: %s)\n", version, model.CurrentSchemaVersion)
+}
+
+// validateRegistryURL returns the registry to validate against: the one saved in the
+// token file if present, otherwise the default registry.
+func validateRegistryURL() string {
 	registryURL := DefaultRegistryURL
 	// Try to read registry URL from token file (if it exists)
 	if tokenPath, err := tokenFilePath(); err == nil {
@@ -165,32 +936,22 @@ func ValidateCommand(args []string) error {
 			}
 		}
 	}
+	return registryURL
+}
 
-	// Validate via API
-	_, _ = fmt.Fprintf(os.Stdout, "Validating against %s...\n", registryURL)
-	result, err := validateViaAPI(registryURL, serverData)
+// printRules prints every registered validation rule as a JSON array
+func printRules() error {
+	data, err := json.MarshalIndent(validators.Rules(), "", "  ")
 	if err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-
-	// Print validation results using shared formatting logic
-	formattedErrorMsg := printValidationIssues(result, &serverJSON)
-
-	if result.Valid {
-		_, _ = fmt.Fprintln(os.Stdout, "✅ server.json is valid")
-		return nil
+		return fmt.Errorf("failed to serialize rules: %w", err)
 	}
-
-	// Return error with formatted message if available
-	if formattedErrorMsg != "" {
-		return fmt.Errorf("%s", formattedErrorMsg)
-	}
-
-	return fmt.Errorf("validation failed")
+	_, _ = fmt.Fprintln(os.Stdout, string(data))
+	return nil
 }
 
-// validateViaAPI calls the /validate endpoint on the registry
-func validateViaAPI(registryURL string, serverData []byte) (*validators.ValidationResult, error) {
+// validateViaAPI calls the validate endpoint on the registry, authenticating with token
+// unless it is empty
+func validateViaAPI(client *http.Client, registryURL, apiPrefix, token string, profile *validators.Profile, serverData []byte) (*validators.ValidationResult, error) {
 	if err := validateJSONUnicode("server.json", serverData); err != nil {
 		return nil, err
 	}
@@ -212,7 +973,10 @@ func validateViaAPI(registryURL string, serverData []byte) (*validators.Validati
 	if !strings.HasSuffix(registryURL, "/") {
 		registryURL += "/"
 	}
-	validateURL := registryURL + "v0/validate"
+	validateURL := registryURL + apiPrefix + "validate"
+	if profile != nil && profile.Name != validators.ProfileDefault {
+		validateURL += "?" + url.Values{"profile": {profile.Name}}.Encode()
+	}
 
 	// Create and send request
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, validateURL, bytes.NewBuffer(jsonData))
@@ -220,8 +984,10 @@ func validateViaAPI(registryURL string, serverData []byte) (*validators.Validati
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 
-	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
@@ -234,8 +1000,13 @@ func validateViaAPI(registryURL string, serverData []byte) (*validators.Validati
 		return nil, fmt.Errorf("error reading response: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %w", errValidateUnsupported, newRegistryError("validation", resp.StatusCode, body))
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, body)
+		regErr := newRegistryError("validation", resp.StatusCode, body)
+		regErr.RetryAfter = resp.Header.Get("Retry-After")
+		return nil, regErr
 	}
 
 	// Parse response - Huma returns ValidationResult directly
@@ -243,6 +1014,10 @@ func validateViaAPI(registryURL string, serverData []byte) (*validators.Validati
 	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
+	// Registries predating JSON pointer paths report issues as e.g. packages[0].version
+	for i := range result.Issues {
+		result.Issues[i].Path = validators.ConvertBracketNotationToJSONPointer(result.Issues[i].Path)
+	}
 
 	return &result, nil
 }
@@ -136,6 +136,40 @@ func TestValidateCommand_DeprecatedSchema(t *testing.T) {
 	assert.Contains(t, err.Error(), "Migration checklist:")
 }
 
+func TestValidateCommand_ReservedNamespace(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      commands.PublisherConfig
+		expectError bool
+	}{
+		{"production registry", commands.PublisherConfig{ProductionHosts: []string{"127.0.0.1"}}, true},
+		{"non-production registry", commands.PublisherConfig{ProductionHosts: []string{}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := SetupMockRegistryServer(t, nil, nil)
+			SetupTestToken(t, server.URL, "test-token")
+			SetupTestConfig(t, tt.config)
+			CreateTestServerJSON(t, testServerJSON())
+
+			var err error
+			output := CaptureStdout(t, func() {
+				err = commands.ValidateCommand([]string{})
+			})
+
+			if !tt.expectError {
+				require.NoError(t, err)
+				assert.NotContains(t, output, "namespace-reserved")
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, output, "namespace-reserved")
+			assert.Contains(t, output, "server name is in a reserved namespace: com.example/*")
+		})
+	}
+}
+
 func TestValidateCommand_NoServerFile(t *testing.T) {
 	server := SetupMockRegistryServer(t, nil, nil)
 	SetupTestToken(t, server.URL, "test-token")
@@ -180,3 +214,347 @@ func TestValidateCommand_InvalidJSON(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid JSON")
 }
+
+func TestValidateCommand_ExplainAll(t *testing.T) {
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--explain-all"})
+	})
+	require.NoError(t, err)
+
+	var rules []validators.Rule
+	require.NoError(t, json.Unmarshal([]byte(output), &rules))
+
+	references := map[string]validators.Rule{}
+	for _, rule := range rules {
+		references[rule.Reference] = rule
+	}
+
+	// Built-in rules from the validators package
+	assert.Contains(t, references, "version-looks-like-range")
+	assert.Contains(t, references, "schema-version-deprecated")
+	assert.Equal(t, validators.ValidationIssueSeverityWarning, references["schema-version-deprecated"].Severity)
+
+	// Rules registered by the CLI itself
+	assert.Contains(t, references, "name-casing-conflict")
+}
+
+func TestValidateCommand_MissingRequiredFieldSkipsRegistry(t *testing.T) {
+	validateCallCount := 0
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		validateCallCount++
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+
+	CreateTestServerJSON(t, apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+	})
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{})
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, output, "version-field-required")
+	assert.Equal(t, 0, validateCallCount, "registry should not be called when required fields are missing")
+}
+
+func TestValidateCommand_ValidateEndpointMissing(t *testing.T) {
+	// A registry deployed before the validate endpoint existed
+	validateCallCount := 0
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		validateCallCount++
+		http.NotFound(w, r)
+	})
+	SetupTestToken(t, server.URL, "test-token")
+
+	t.Run("valid", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "registry does not support validation; validated locally instead")
+		assert.Contains(t, output, "server.json is valid")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(withRangeVersion))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{})
+		})
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "404")
+		assert.Contains(t, output, "registry does not support validation; validated locally instead")
+		assert.Contains(t, output, "version-looks-like-range", "the offline checks should report the issue")
+	})
+
+	assert.Equal(t, 2, validateCallCount)
+}
+
+func TestValidateCommand_TruncatedResult(t *testing.T) {
+	// A registry that caps the issues it returns
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{
+			Valid: false,
+			Issues: []validators.ValidationIssue{
+				validators.NewValidationIssue(validators.ValidationIssueTypeSemantic, "/packages/0/transport/url", "url must be empty for stdio transport type", validators.ValidationIssueSeverityError, "stdio-transport-url-not-empty"),
+			},
+			Truncated: true,
+		})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+
+	var err error
+	output := CaptureStdout(t, func() {
+		err = commands.ValidateCommand([]string{"--profile", "lenient"})
+	})
+	require.Error(t, err)
+	assert.Contains(t, output, "notice: the registry only returned the first 1 issues; validate with --offline to list all of them")
+	assert.Contains(t, output, "stdio-transport-url-not-empty")
+}
+
+func TestValidateCommand_ServerResponse(t *testing.T) {
+	SetupTestToken(t, commands.DefaultRegistryURL, "test-token")
+	serverJSON := testServerJSON()
+
+	t.Run("bare server.json", func(t *testing.T) {
+		CreateTestServerJSON(t, serverJSON)
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "server.json is valid")
+	})
+
+	t.Run("registry response", func(t *testing.T) {
+		data, err := json.Marshal(apiv0.ServerResponse{
+			Server: serverJSON,
+			Meta:   apiv0.ResponseMeta{Official: &apiv0.RegistryExtensions{Status: model.StatusActive, IsLatest: true}},
+		})
+		require.NoError(t, err)
+		createRawServerJSON(t, data)
+
+		err = commands.ValidateCommand([]string{"--offline"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `server.json looks like a registry API response rather than a server.json: the server.json is its "server" field. Extract it first, e.g. with: jq .server server.json`)
+
+		var output string
+		output = CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline", "--extract-key", "server"})
+		})
+		require.NoError(t, err, "the wrapped server.json can be validated with --extract-key")
+		assert.Contains(t, output, "server.json is valid")
+	})
+}
+
+func TestValidateCommand_Offline(t *testing.T) {
+	validateCallCount := 0
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		validateCallCount++
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+
+	t.Run("valid document", func(t *testing.T) {
+		CreateTestServerJSON(t, apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+		})
+		require.NoError(t, commands.ValidateCommand([]string{"--offline"}))
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		CreateTestServerJSON(t, apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "^1.0.0",
+		})
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--offline"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, output, "version-looks-like-range")
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		CreateTestServerJSON(t, apiv0.ServerJSON{
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+		})
+
+		err := commands.ValidateCommand([]string{"--offline"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "$schema field is required")
+	})
+
+	assert.Equal(t, 0, validateCallCount, "offline validation should not call the registry")
+}
+
+func TestValidateCommand_ErrorBody(t *testing.T) {
+	t.Run("structured body", func(t *testing.T) {
+		server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"rate limit exceeded","code":"RATE_LIMITED"}`))
+		})
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.ValidateCommand([]string{})
+		require.Error(t, err)
+		assert.Equal(t, "validation failed (code=RATE_LIMITED): rate limit exceeded", err.Error())
+	})
+
+	t.Run("unstructured body", func(t *testing.T) {
+		server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("internal error"))
+		})
+		SetupTestToken(t, server.URL, "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		err := commands.ValidateCommand([]string{})
+		require.Error(t, err)
+		assert.Equal(t, "validation failed: server returned status 500: internal error", err.Error())
+	})
+}
+
+func TestValidateCommand_UseEmbeddedSchema(t *testing.T) {
+	validateCallCount := 0
+	server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		validateCallCount++
+		_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+	})
+	SetupTestToken(t, server.URL, "test-token")
+
+	t.Run("current schema", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--use-embedded-schema"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Using embedded schema "+model.CurrentSchemaVersion)
+		assert.Contains(t, output, "Validating locally...")
+	})
+
+	t.Run("older embedded schema", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+			s.Schema = "https://static.modelcontextprotocol.io/schemas/2025-10-17/server.schema.json"
+		}))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--use-embedded-schema"})
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Using embedded schema 2025-10-17 (current: "+model.CurrentSchemaVersion+")")
+		assert.Contains(t, output, "Deprecated schema detected")
+	})
+
+	t.Run("schema newer than embedded", func(t *testing.T) {
+		CreateTestServerJSON(t, testServerJSON(func(s *apiv0.ServerJSON) {
+			s.Schema = "https://static.modelcontextprotocol.io/schemas/2099-01-01/server.schema.json"
+		}))
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--use-embedded-schema"})
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, output, "Using embedded schema")
+		assert.Contains(t, output, "schema version 2099-01-01 is newer than "+model.CurrentSchemaVersion)
+		assert.Contains(t, output, "Upgrade mcp-publisher")
+	})
+
+	assert.Equal(t, 0, validateCallCount, "--use-embedded-schema should not call the registry")
+}
+
+func TestValidateCommand_AuthValidate(t *testing.T) {
+	// setupAuthRegistry starts a registry whose validate endpoint records the
+	// Authorization header of each request
+	setupAuthRegistry := func(t *testing.T, authorization *[]string) string {
+		t.Helper()
+		server := SetupMockRegistryServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			*authorization = append(*authorization, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(validators.ValidationResult{Valid: true})
+		})
+		return server.URL
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		var authorization []string
+		SetupTestToken(t, setupAuthRegistry(t, &authorization), "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--auth-validate"})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bearer test-token"}, authorization)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		var authorization []string
+		SetupTestToken(t, setupAuthRegistry(t, &authorization), "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{""}, authorization)
+	})
+
+	t.Run("token for another registry", func(t *testing.T) {
+		var authorization []string
+		registryURL := setupAuthRegistry(t, &authorization)
+		SetupTestToken(t, "https://other.example.com", "test-token")
+		CreateTestServerJSON(t, testServerJSON())
+
+		var err error
+		CaptureStdout(t, func() {
+			err = commands.ValidateCommand([]string{"--auth-validate", "--registry", registryURL})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the saved token is for https://other.example.com")
+		assert.Empty(t, authorization, "the token must not be sent to a registry it was not issued for")
+	})
+}
+
+func TestValidateCommand_DuplicateKeys(t *testing.T) {
+	serverJSON, err := json.Marshal(testServerJSON())
+	require.NoError(t, err)
+	// A copy-pasted version that silently overrides the first one
+	duplicated := append(serverJSON[:len(serverJSON)-1], []byte(`,"version":"1.0.1"}`)...)
+	path := writeServerFile(t, "server.json", string(duplicated))
+
+	result, err := runValidateJSON(t, path)
+	require.NoError(t, err, "duplicate keys are warnings")
+	assert.True(t, result.Valid)
+	require.Equal(t, []string{"duplicate-json-key"}, issueReferences(result.Issues))
+	assert.Equal(t, "/version", result.Issues[0].Path)
+	assert.Contains(t, result.Issues[0].Message, `key "version" appears more than once`)
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Defaults for the publish command's --timeout and --registry-timeout flags
+const (
+	defaultRequestTimeout  = 30 * time.Second
+	defaultRegistryTimeout = 2 * time.Minute
+)
+
+// waitPollInterval is how long --wait pauses between checks for the published version
+const waitPollInterval = time.Second
+
+// checkTimeouts rejects negative --timeout and --registry-timeout values
+func checkTimeouts(requestTimeout, registryTimeout time.Duration) error {
+	if requestTimeout < 0 {
+		return fmt.Errorf("invalid --timeout %s: must not be negative", requestTimeout)
+	}
+	if registryTimeout < 0 {
+		return fmt.Errorf("invalid --registry-timeout %s: must not be negative", registryTimeout)
+	}
+	return nil
+}
+
+// waitForIndexing polls the registry until the published version can be read back.
+// Each request is bounded by the client's timeout, while budget bounds the whole wait;
+// 0 waits indefinitely.
+func waitForIndexing(client *http.Client, registryURL, apiPrefix, serverName, version string, budget time.Duration) error {
+	ctx := context.Background()
+	if budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+	}
+
+	if !strings.HasSuffix(registryURL, "/") {
+		registryURL += "/"
+	}
+	versionURL := registryURL + apiPrefix + "servers/" + url.PathEscape(serverName) + "/versions/" + url.PathEscape(version)
+
+	for {
+		indexed, err := isIndexed(ctx, client, versionURL)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out waiting for indexing after %s", budget)
+		}
+		if err != nil {
+			return err
+		}
+		if indexed {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for indexing after %s", budget)
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
+
+// isIndexed reports whether versionURL can be read from the registry yet
+func isIndexed(ctx context.Context, client *http.Client, versionURL string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, versionURL, nil)
+	if err != nil {
+		return false, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("error checking whether the server is indexed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return false, newRegistryError("wait", resp.StatusCode, body)
+	}
+}
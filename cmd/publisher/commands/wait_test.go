@@ -0,0 +1,130 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/cmd/publisher/commands"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupIndexingServer mocks a registry that accepts publishes and answers version lookups
+// with versionHandler
+func setupIndexingServer(t *testing.T, publishHandler, versionHandler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	if publishHandler == nil {
+		publishHandler = func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v0/publish", publishHandler)
+	mux.HandleFunc("GET /v0/servers/{name}/versions/{version}", versionHandler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	SetupTestToken(t, server.URL, "test-token")
+	CreateTestServerJSON(t, testServerJSON())
+	return server
+}
+
+// blockUntilCanceled never answers, so only a timeout ends the request. The body is read
+// first so that the server notices when the client gives up.
+func blockUntilCanceled(_ http.ResponseWriter, r *http.Request) {
+	_, _ = io.Copy(io.Discard, r.Body)
+	<-r.Context().Done()
+}
+
+func TestPublishCommand_Wait(t *testing.T) {
+	t.Run("waits until the version is indexed", func(t *testing.T) {
+		var lookups atomic.Int32
+		setupIndexingServer(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "com.example/test-server", r.PathValue("name"))
+			assert.Equal(t, "1.0.0", r.PathValue("version"))
+			if lookups.Add(1) == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(apiv0.ServerResponse{Server: testServerJSON()})
+		})
+
+		var err error
+		output := CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--wait"})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), lookups.Load())
+		assert.Contains(t, output, "✓ Version is available from the registry")
+	})
+
+	t.Run("registry timeout bounds the whole wait", func(t *testing.T) {
+		setupIndexingServer(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		start := time.Now()
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--wait", "--timeout", "10s", "--registry-timeout", "300ms"})
+		})
+		require.Error(t, err)
+		assert.Equal(t, "timed out waiting for indexing after 300ms", err.Error())
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("registry timeout expires mid-request", func(t *testing.T) {
+		setupIndexingServer(t, nil, blockUntilCanceled)
+
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--wait", "--timeout", "10s", "--registry-timeout", "200ms"})
+		})
+		require.Error(t, err)
+		assert.Equal(t, "timed out waiting for indexing after 200ms", err.Error())
+	})
+
+	t.Run("request timeout bounds each poll", func(t *testing.T) {
+		setupIndexingServer(t, nil, blockUntilCanceled)
+
+		start := time.Now()
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--wait", "--timeout", "200ms", "--registry-timeout", "1m"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error checking whether the server is indexed")
+		assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("request timeout applies to the publish request", func(t *testing.T) {
+		setupIndexingServer(t, blockUntilCanceled, blockUntilCanceled)
+
+		var err error
+		_ = CaptureStdout(t, func() {
+			err = commands.PublishCommand([]string{"--timeout", "200ms"})
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+	})
+
+	t.Run("negative timeouts", func(t *testing.T) {
+		err := commands.PublishCommand([]string{"--timeout", "-1s"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --timeout")
+
+		err = commands.PublishCommand([]string{"--registry-timeout", "-1s"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --registry-timeout")
+	})
+}
@@ -35,12 +35,28 @@ func main() {
 
 	var err error
 	switch os.Args[1] {
+	case "auth":
+		err = commands.AuthCommand(os.Args[2:])
+	case "canonicalize":
+		err = commands.CanonicalizeCommand(os.Args[2:])
+	case "compare-registries":
+		err = commands.CompareRegistriesCommand(os.Args[2:])
+	case "completion":
+		err = commands.CompletionCommand(os.Args[2:])
+	case "config":
+		err = commands.ConfigCommand(os.Args[2:])
+	case "deprecate":
+		err = commands.DeprecateCommand(os.Args[2:])
 	case "init":
 		err = commands.InitCommand()
+	case "lint":
+		err = commands.LintCommand(os.Args[2:])
 	case "login":
 		err = commands.LoginCommand(os.Args[2:])
 	case "logout":
 		err = commands.LogoutCommand()
+	case "migrate":
+		err = commands.MigrateCommand(os.Args[2:])
 	case "publish":
 		err = commands.PublishCommand(os.Args[2:])
 	case "status":
@@ -60,7 +76,7 @@ func main() {
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		os.Exit(commands.ExitCode(err))
 	}
 }
 
@@ -71,9 +87,18 @@ func printUsage() {
 	_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher <command> [arguments]")
 	_, _ = fmt.Fprintln(os.Stdout)
 	_, _ = fmt.Fprintln(os.Stdout, "Commands:")
+	_, _ = fmt.Fprintln(os.Stdout, "  auth test     Check that the saved token is accepted by the registry")
+	_, _ = fmt.Fprintln(os.Stdout, "  canonicalize  Print server.json as RFC 8785 canonical JSON for signing")
+	_, _ = fmt.Fprintln(os.Stdout, "  compare-registries")
+	_, _ = fmt.Fprintln(os.Stdout, "                List server versions published to only one of two registries")
+	_, _ = fmt.Fprintln(os.Stdout, "  completion    Generate a shell completion script")
+	_, _ = fmt.Fprintln(os.Stdout, "  config        Show the effective configuration and where each value comes from")
+	_, _ = fmt.Fprintln(os.Stdout, "  deprecate     Mark a server version deprecated with a notice")
 	_, _ = fmt.Fprintln(os.Stdout, "  init          Create a server.json file template")
+	_, _ = fmt.Fprintln(os.Stdout, "  lint          Score server.json against registry listing best practices")
 	_, _ = fmt.Fprintln(os.Stdout, "  login         Authenticate with the registry")
 	_, _ = fmt.Fprintln(os.Stdout, "  logout        Clear saved authentication")
+	_, _ = fmt.Fprintln(os.Stdout, "  migrate       Migrate server.json to the current schema")
 	_, _ = fmt.Fprintln(os.Stdout, "  publish       Publish server.json to the registry")
 	_, _ = fmt.Fprintln(os.Stdout, "  status        Update the status of a server version")
 	_, _ = fmt.Fprintln(os.Stdout, "  validate      Validate server.json without publishing")
@@ -83,6 +108,85 @@ func printUsage() {
 
 func printCommandHelp(command string) {
 	switch command {
+	case "auth":
+		_, _ = fmt.Fprintln(os.Stdout, "Check the saved authentication token")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher auth test [options]")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --proxy string   Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry-auth-command command")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Read the token from the output of this credential helper, in the token file format")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-file path")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Read the token from this file (default: ~/.config/mcp-publisher/token.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-stdin    Read the token from stdin instead of a file, keeping it in memory only")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "'auth test' sends the saved token to the registry without changing anything and")
+		_, _ = fmt.Fprintln(os.Stdout, "reports whether it is accepted, who it authenticates and which namespaces it")
+		_, _ = fmt.Fprintln(os.Stdout, "may publish to. Use it to catch expired tokens before publishing.")
+
+	case "canonicalize":
+		_, _ = fmt.Fprintln(os.Stdout, "Print server.json as RFC 8785 canonical JSON for signing")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher canonicalize [options] [server.json]")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
+		_, _ = fmt.Fprintln(os.Stdout, "  server.json   Path to the server.json file (default: ./server.json)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --output path    Write the canonical JSON to this file instead of stdout")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "server.json is validated locally first. The output is the JSON Canonicalization")
+		_, _ = fmt.Fprintln(os.Stdout, "Scheme form of the file, without a trailing newline, so that signers and")
+		_, _ = fmt.Fprintln(os.Stdout, "verifiers hash identical bytes.")
+
+	case "compare-registries":
+		_, _ = fmt.Fprintln(os.Stdout, "List server versions published to only one of two registries")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher compare-registries [options] <registry-a> <registry-b>")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
+		_, _ = fmt.Fprintln(os.Stdout, "  registry-a, registry-b   Registry URLs or aliases from the config file")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --api-prefix path")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Path below the registry URLs where their API is mounted (default: v0)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --format string  Output format: text or json (default: text)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --proxy string   Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Every page of both registries' server lists is fetched, and the name and")
+		_, _ = fmt.Fprintln(os.Stdout, "version of each server are compared. Exits with status 1 if they differ.")
+
+	case "completion":
+		_, _ = fmt.Fprintln(os.Stdout, "Generate a shell completion script")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher completion <bash|zsh|fish>")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Examples:")
+		_, _ = fmt.Fprintln(os.Stdout, "  source <(mcp-publisher completion bash)")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher completion zsh > \"${fpath[1]}/_mcp-publisher\"")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher completion fish > ~/.config/fish/completions/mcp-publisher.fish")
+
+	case "config":
+		_, _ = fmt.Fprintln(os.Stdout, "Show the effective configuration and where each value comes from")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher config [options]")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --format string  Output format: text or json (default: text)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "  --api-prefix, --no-prerelease, --proxy, --registry, --registry-timeout,")
+		_, _ = fmt.Fprintln(os.Stdout, "  --timeout and --token-file are accepted as for publish and validate, to")
+		_, _ = fmt.Fprintln(os.Stdout, "  show how they would be resolved.")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Each setting is printed with its source: a flag, an environment variable")
+		_, _ = fmt.Fprintln(os.Stdout, "(e.g. env HTTPS_PROXY), the config file, the token file or the default.")
+
 	case "init":
 		_, _ = fmt.Fprintln(os.Stdout, "Create a server.json file template")
 		_, _ = fmt.Fprintln(os.Stdout)
@@ -95,6 +199,23 @@ func printCommandHelp(command string) {
 		_, _ = fmt.Fprintln(os.Stdout, "After running init, edit the generated server.json to customize your")
 		_, _ = fmt.Fprintln(os.Stdout, "server's metadata before publishing.")
 
+	case "lint":
+		_, _ = fmt.Fprintln(os.Stdout, "Score server.json against registry listing best practices")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher lint [options] [server.json]")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
+		_, _ = fmt.Fprintln(os.Stdout, "  server.json   Path to the server.json file (default: ./server.json)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --format string  Output format: text or json (default: text)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "lint prints a listing quality score out of 100 and a suggestion for each best")
+		_, _ = fmt.Fprintln(os.Stdout, "practice server.json does not follow: a description of at least 20 characters,")
+		_, _ = fmt.Fprintln(os.Stdout, "a repository, a license, a package or remote, and no placeholder text. It does")
+		_, _ = fmt.Fprintln(os.Stdout, "not validate the file; use 'mcp-publisher validate' for that.")
+
 	case "login":
 		_, _ = fmt.Fprintln(os.Stdout, "Authenticate with the registry")
 		_, _ = fmt.Fprintln(os.Stdout)
@@ -107,9 +228,18 @@ func printCommandHelp(command string) {
 		_, _ = fmt.Fprintln(os.Stdout, "  dns           DNS-based authentication (requires --domain)")
 		_, _ = fmt.Fprintln(os.Stdout, "  http          HTTP-based authentication (requires --domain)")
 		_, _ = fmt.Fprintln(os.Stdout, "  none          Anonymous authentication (for testing)")
+		_, _ = fmt.Fprintln(os.Stdout, "  refresh       Renew the saved token with the refresh token saved by 'login github'")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry string  Registry URL or alias from the config file (default: https://registry.modelcontextprotocol.io)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-file path  With refresh: the token file to refresh (default: ~/.config/mcp-publisher/token.json)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "An expired saved token is refreshed automatically by commands that use it.")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "Examples:")
 		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher login github")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher login refresh")
+		_, _ = fmt.Fprintln(os.Stdout, "  echo \"$GITHUB_PAT\" | mcp-publisher login github --token-stdin")
 		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher login dns --domain example.com --private-key <key>")
 
 	case "logout":
@@ -120,17 +250,97 @@ func printCommandHelp(command string) {
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "This command removes the saved authentication token from your system.")
 
+	case "migrate":
+		_, _ = fmt.Fprintln(os.Stdout, "Migrate server.json to the current schema")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher migrate [options] [server.json]")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
+		_, _ = fmt.Fprintln(os.Stdout, "  server.json   Path to the server.json file (default: ./server.json)")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --dry-run     Print a unified diff of the changes without writing the file")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "The migration updates $schema, renames legacy snake_case fields to camelCase")
+		_, _ = fmt.Fprintln(os.Stdout, "and removes registry-managed fields such as status.")
+
 	case "publish":
 		_, _ = fmt.Fprintln(os.Stdout, "Publish server.json to the registry")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
-		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher publish [server.json]")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher publish [options] [server.json...]")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
-		_, _ = fmt.Fprintln(os.Stdout, "  server.json   Path to the server.json file (default: ./server.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  server.json   Path to the server.json file, a .tar.gz or .tgz archive containing it or - for")
+		_, _ = fmt.Fprintln(os.Stdout, "                stdin (default: ./server.json); several files are published in order")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Options:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --api-prefix path")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Path below the registry URL where its API is mounted (default: v0)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --auth-validate  Send the token with the validate request that explains a rejected publish")
+		_, _ = fmt.Fprintln(os.Stdout, "  --check-downgrade")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Refuse to publish a version lower than the latest published version")
+		_, _ = fmt.Fprintln(os.Stdout, "  --continue-on-error")
+		_, _ = fmt.Fprintln(os.Stdout, "                   When publishing several files, keep going after one fails")
+		_, _ = fmt.Fprintln(os.Stdout, "  --dump-body path Write the exact JSON body of the publish request to this file")
+		_, _ = fmt.Fprintln(os.Stdout, "  --dump-body-redacted path")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Write the JSON body of the publish request with secret values redacted")
+		_, _ = fmt.Fprintln(os.Stdout, "  --entry path     Publish this member of a .tar.gz or .tgz archive (default: server.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --input-format string")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Parse server.json as json, yaml, jsonl or template instead of detecting it from the file extension")
+		_, _ = fmt.Fprintln(os.Stdout, "  --merge          Deep-merge the given files into one server.json, later files overriding earlier ones")
+		_, _ = fmt.Fprintln(os.Stdout, "  --merge-arrays string")
+		_, _ = fmt.Fprintln(os.Stdout, "                   How --merge combines arrays: replace (default) or append")
+		_, _ = fmt.Fprintln(os.Stdout, "  --name string    Override the name field of server.json")
+		_, _ = fmt.Fprintln(os.Stdout, "  --no-prerelease  Refuse to publish a pre-release version (e.g. 1.0.0-rc.1) to a production registry")
+		_, _ = fmt.Fprintln(os.Stdout, "  --notes path     Attach the release notes in this file to the published version")
+		_, _ = fmt.Fprintln(os.Stdout, "  --notes-text string")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Attach these release notes to the published version")
+		_, _ = fmt.Fprintln(os.Stdout, "  --notify-url url POST a JSON notification to this URL after each successful publish")
+		_, _ = fmt.Fprintln(os.Stdout, "  --print-request  Print each registry request as an equivalent curl command (token redacted)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --proxy string   Proxy URL for registry requests (default: from HTTP_PROXY/HTTPS_PROXY)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry string")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Publish to this registry URL or alias from the config file (default: logged-in registry)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry-auth-command command")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Read the token from the output of this credential helper, in the token file format")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry-timeout duration")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Overall time allowed for --wait, 0 for none (default: 2m)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --resume path    Record published files in this state file and skip files it already lists")
+		_, _ = fmt.Fprintln(os.Stdout, "  --template       Render server.json as a Go template before parsing (implied by a .tmpl extension)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --timeout duration")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Timeout for each registry request, 0 for none (default: 30s)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-file path")
+		_, _ = fmt.Fprintln(os.Stdout, "                   Read the token from this file (default: ~/.config/mcp-publisher/token.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-stdin    Read the token from stdin instead of a file, keeping it in memory only")
+		_, _ = fmt.Fprintln(os.Stdout, "  --update         Replace an already published version (requires edit permission)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --values path    Read template variables from this JSON object file")
+		_, _ = fmt.Fprintln(os.Stdout, "  --var key=value  Set a template variable (repeatable)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --version string Override the version field of server.json (must be a specific version)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --wait           Wait until the published version can be read back from the registry")
+		_, _ = fmt.Fprintln(os.Stdout, "  --yes, -y        Skip the confirmation prompt for production registries")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Exit codes: 0 when every file was published, 5 when only some were, 1 otherwise.")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "You must be logged in before publishing. Run 'mcp-publisher login' first.")
 
+	case "deprecate":
+		_, _ = fmt.Fprintln(os.Stdout, "Mark a server version deprecated with a notice, keeping it available")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Usage:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher deprecate --reason <reason> [--replacement <name>[@<version>]] [flags] <server-name> <version>")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Flags:")
+		_, _ = fmt.Fprintln(os.Stdout, "  --reason string            Why the version is deprecated (required)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --replacement string       Server to use instead, as <name> or <name>@<version>")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry-auth-command command")
+		_, _ = fmt.Fprintln(os.Stdout, "                             Read the token from the output of this credential helper")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-file path          Read the token from this file (default: ~/.config/mcp-publisher/token.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-stdin              Read the token from stdin instead of a file")
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Example:")
+		_, _ = fmt.Fprintln(os.Stdout, "  mcp-publisher deprecate --reason \"Superseded by the v2 API\" \\")
+		_, _ = fmt.Fprintln(os.Stdout, "    --replacement io.github.user/my-server@2.0.0 io.github.user/my-server 1.0.0")
 	case "status":
 		_, _ = fmt.Fprintln(os.Stdout, "Update the status of a server version")
 		_, _ = fmt.Fprintln(os.Stdout)
@@ -141,6 +351,10 @@ func printCommandHelp(command string) {
 		_, _ = fmt.Fprintln(os.Stdout, "  --status string            New status: active, deprecated, or deleted (required)")
 		_, _ = fmt.Fprintln(os.Stdout, "  --message string           Optional message explaining the status change")
 		_, _ = fmt.Fprintln(os.Stdout, "  --all-versions             Apply status change to all versions of the server")
+		_, _ = fmt.Fprintln(os.Stdout, "  --registry-auth-command command")
+		_, _ = fmt.Fprintln(os.Stdout, "                             Read the token from the output of this credential helper")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-file path          Read the token from this file (default: ~/.config/mcp-publisher/token.json)")
+		_, _ = fmt.Fprintln(os.Stdout, "  --token-stdin              Read the token from stdin instead of a file (combine with --yes)")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "Arguments:")
 		_, _ = fmt.Fprintln(os.Stdout, "  server-name   Full server name (e.g., io.github.user/my-server)")
@@ -164,6 +378,9 @@ func printCommandHelp(command string) {
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "You must be logged in before updating status. Run 'mcp-publisher login' first.")
 
+	case "validate":
+		_ = commands.ValidateCommand([]string{"--help"})
+
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", command)
 		printUsage()
@@ -67,7 +67,7 @@ func TestEditServerEndpoint(t *testing.T) {
 
 	// Create the test servers
 	for _, server := range testServers {
-		_, err := registryService.CreateServer(context.Background(), server)
+		_, err := registryService.CreateServer(context.Background(), server, "")
 		require.NoError(t, err)
 	}
 
@@ -83,7 +83,7 @@ func TestEditServerEndpoint(t *testing.T) {
 			ID:     "testuser/build-metadata-server",
 		},
 	}
-	_, err = registryService.CreateServer(context.Background(), buildMetadataServer)
+	_, err = registryService.CreateServer(context.Background(), buildMetadataServer, "")
 	require.NoError(t, err)
 
 	testCases := []struct {
@@ -383,7 +383,7 @@ func TestEditServerEndpointEdgeCases(t *testing.T) {
 			Name:        server.name,
 			Description: "Test server for editing",
 			Version:     server.version,
-		})
+		}, "")
 		require.NoError(t, err)
 	}
 
@@ -400,7 +400,7 @@ func TestEditServerEndpointEdgeCases(t *testing.T) {
 			Name:        specialServerName,
 			Description: "Server with special characters",
 			Version:     "1.0.0",
-		})
+		}, "")
 		require.NoError(t, err)
 
 		requestBody := apiv0.ServerJSON{
@@ -496,7 +496,7 @@ func TestEditServerEndpointEdgeCases(t *testing.T) {
 			Description: "Server to test edit preserves status",
 			Version:     "1.0.0",
 		}
-		_, err := registryService.CreateServer(context.Background(), deprecatedServer)
+		_, err := registryService.CreateServer(context.Background(), deprecatedServer, "")
 		require.NoError(t, err)
 
 		// Set to deprecated status using UpdateServerStatus
@@ -15,8 +15,8 @@ import (
 
 // PublishServerInput represents the input for publishing a server
 type PublishServerInput struct {
-	Authorization string           `header:"Authorization" doc:"Registry JWT token (obtained from /v0/auth/token/github)" required:"true"`
-	Body          apiv0.ServerJSON `body:""`
+	Authorization string               `header:"Authorization" doc:"Registry JWT token (obtained from /v0/auth/token/github)" required:"true"`
+	Body          apiv0.PublishRequest `body:""`
 }
 
 // RegisterPublishEndpoint registers the publish endpoint with a custom path prefix
@@ -50,18 +50,19 @@ func RegisterPublishEndpoint(api huma.API, pathPrefix string, registry service.R
 		}
 
 		// Verify that the token has permission to publish the server
-		if !jwtManager.HasPermission(input.Body.Name, auth.PermissionActionPublish, claims.Permissions) {
-			return nil, huma.Error403Forbidden(buildPermissionErrorMessage(input.Body.Name, claims.Permissions))
+		serverJSON := &input.Body.ServerJSON
+		if !jwtManager.HasPermission(serverJSON.Name, auth.PermissionActionPublish, claims.Permissions) {
+			return nil, huma.Error403Forbidden(buildPermissionErrorMessage(serverJSON.Name, claims.Permissions))
 		}
 
 		// Validate server JSON structure and schema (returns 422 on validation failure)
-		validationResult := validators.ValidateServerJSON(&input.Body, validators.ValidationSchemaVersionAndSemantic)
+		validationResult := validators.ValidateServerJSON(serverJSON, validators.ValidationSchemaVersionAndSemantic)
 		if !validationResult.Valid {
 			return nil, huma.Error422UnprocessableEntity("Failed to publish server, invalid schema: call /validate for details")
 		}
 
-		// Publish the server with extensions
-		publishedServer, err := registry.CreateServer(ctx, &input.Body)
+		// Publish the server with extensions and the release notes
+		publishedServer, err := registry.CreateServer(ctx, serverJSON, input.Body.ReleaseNotes)
 		if err != nil {
 			return nil, huma.Error400BadRequest("Failed to publish server", err)
 		}
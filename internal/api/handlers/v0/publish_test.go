@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/danielgtaylor/huma/v2"
@@ -199,7 +200,7 @@ func TestPublishEndpoint(t *testing.T) {
 						ID:     "example/test-server-existing",
 					},
 				}
-				_, _ = registry.CreateServer(context.Background(), &existingServer)
+				_, _ = registry.CreateServer(context.Background(), &existingServer, "")
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "invalid version: cannot publish duplicate version",
@@ -542,3 +543,91 @@ func TestPublishEndpoint_MultipleSlashesEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishEndpoint_ReleaseNotesTooLong(t *testing.T) {
+	testSeed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(testSeed)
+	require.NoError(t, err)
+	testConfig := &config.Config{JWTPrivateKey: hex.EncodeToString(testSeed)}
+
+	// The request is rejected by the request schema before the registry is used
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterPublishEndpoint(api, "/v0", nil, testConfig)
+
+	bodyBytes, err := json.Marshal(apiv0.PublishRequest{
+		ServerJSON: apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "Test server",
+			Version:     "1.0.0",
+		},
+		ReleaseNotes: strings.Repeat("a", apiv0.MaxReleaseNotesLength+1),
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/v0/publish", bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer token")
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	assert.Contains(t, rr.Body.String(), "body.releaseNotes")
+}
+
+// createOnlyRegistry records the server and release notes passed to CreateServer; other
+// methods are not used
+type createOnlyRegistry struct {
+	service.RegistryService
+	created      *apiv0.ServerJSON
+	releaseNotes string
+}
+
+func (r *createOnlyRegistry) CreateServer(_ context.Context, req *apiv0.ServerJSON, releaseNotes string) (*apiv0.ServerResponse, error) {
+	r.created, r.releaseNotes = req, releaseNotes
+	return &apiv0.ServerResponse{Server: *req}, nil
+}
+
+func TestPublishEndpoint_ReleaseNotes(t *testing.T) {
+	testSeed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(testSeed)
+	require.NoError(t, err)
+	testConfig := &config.Config{JWTPrivateKey: hex.EncodeToString(testSeed)}
+
+	registry := &createOnlyRegistry{}
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterPublishEndpoint(api, "/v0", registry, testConfig)
+
+	bodyBytes, err := json.Marshal(apiv0.PublishRequest{
+		ServerJSON: apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "Test server",
+			Version:     "1.0.0",
+		},
+		ReleaseNotes: "Adds the forecast tool",
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/v0/publish", bytes.NewBuffer(bodyBytes))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	token, err := generateTestJWTToken(testConfig, auth.JWTClaims{
+		AuthMethod:  auth.MethodNone,
+		Permissions: []auth.Permission{{Action: auth.PermissionActionPublish, ResourcePattern: "*"}},
+	})
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	require.NotNil(t, registry.created)
+	assert.Equal(t, "com.example/test-server", registry.created.Name)
+	assert.Equal(t, "Adds the forecast tool", registry.releaseNotes)
+}
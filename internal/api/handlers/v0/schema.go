@@ -0,0 +1,35 @@
+package v0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// RegisterSchemaEndpoint registers the endpoint serving the server.json schema that the
+// registry validates against, so that clients can validate offline with the same schema
+func RegisterSchemaEndpoint(api huma.API, pathPrefix string) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-schema" + strings.ReplaceAll(pathPrefix, "/", "-"),
+		Method:      http.MethodGet,
+		Path:        pathPrefix + "/schema",
+		Summary:     "Get the server.json schema",
+		Description: "Returns the JSON Schema of the current server.json version, which publish and validate enforce",
+		Tags:        []string{"validate"},
+	}, func(_ context.Context, _ *struct{}) (*Response[map[string]any], error) {
+		data, err := validators.EmbeddedSchema(model.CurrentSchemaVersion)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("Failed to load schema")
+		}
+		var schema map[string]any
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return nil, huma.Error500InternalServerError("Failed to parse schema")
+		}
+		return &Response[map[string]any]{Body: schema}, nil
+	})
+}
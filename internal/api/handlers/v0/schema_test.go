@@ -0,0 +1,31 @@
+package v0_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaEndpoint(t *testing.T) {
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterSchemaEndpoint(api, "/v0")
+
+	req := httptest.NewRequest(http.MethodGet, "/v0/schema", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &schema))
+	assert.Equal(t, model.CurrentSchemaURL, schema["$id"], "the current server.json schema should be served")
+	assert.Contains(t, schema, "definitions")
+}
@@ -30,7 +30,7 @@ func TestListServersEndpoint(t *testing.T) {
 		Name:        "com.example/server-alpha",
 		Description: "Alpha test server",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = registryService.CreateServer(ctx, &apiv0.ServerJSON{
@@ -38,7 +38,7 @@ func TestListServersEndpoint(t *testing.T) {
 		Name:        "com.example/server-beta",
 		Description: "Beta test server",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Create API
@@ -124,7 +124,7 @@ func TestGetLatestServerVersionEndpoint(t *testing.T) {
 		Name:        "com.example/detail-server",
 		Description: "Server for detail testing",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Create API
@@ -187,7 +187,7 @@ func TestGetServerVersionEndpoint(t *testing.T) {
 		Name:        serverName,
 		Description: "Version test server v1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = registryService.CreateServer(ctx, &apiv0.ServerJSON{
@@ -195,7 +195,7 @@ func TestGetServerVersionEndpoint(t *testing.T) {
 		Name:        serverName,
 		Description: "Version test server v2",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Add version with build metadata for URL encoding test
@@ -204,7 +204,7 @@ func TestGetServerVersionEndpoint(t *testing.T) {
 		Name:        serverName,
 		Description: "Version test server with build metadata",
 		Version:     "1.0.0+20130313144700",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Create API
@@ -314,7 +314,7 @@ func TestGetAllVersionsEndpoint(t *testing.T) {
 			Name:        serverName,
 			Description: "Multi-version test server " + version,
 			Version:     version,
-		})
+		}, "")
 		require.NoError(t, err)
 	}
 
@@ -402,7 +402,7 @@ func TestListServersDeletedFiltering(t *testing.T) {
 		Name:        "com.example/active-server-1",
 		Description: "Active server 1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = registryService.CreateServer(ctx, &apiv0.ServerJSON{
@@ -410,7 +410,7 @@ func TestListServersDeletedFiltering(t *testing.T) {
 		Name:        "com.example/active-server-2",
 		Description: "Active server 2",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = registryService.CreateServer(ctx, &apiv0.ServerJSON{
@@ -418,7 +418,7 @@ func TestListServersDeletedFiltering(t *testing.T) {
 		Name:        "com.example/deleted-server",
 		Description: "Deleted server",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Delete the third server
@@ -529,7 +529,7 @@ func TestServersEndpointEdgeCases(t *testing.T) {
 			Name:        server.name,
 			Description: server.description,
 			Version:     server.version,
-		})
+		}, "")
 		require.NoError(t, err)
 	}
 
@@ -647,7 +647,7 @@ func TestIncludeDeletedOnDetailEndpoints(t *testing.T) {
 		Name:        serverName,
 		Description: "Test server v1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = registryService.CreateServer(ctx, &apiv0.ServerJSON{
@@ -655,7 +655,7 @@ func TestIncludeDeletedOnDetailEndpoints(t *testing.T) {
 		Name:        serverName,
 		Description: "Test server v2",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Delete version 1.0.0
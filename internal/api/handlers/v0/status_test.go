@@ -100,7 +100,7 @@ func TestUpdateServerStatusEndpoint(t *testing.T) {
 
 	// Create the test servers
 	for _, server := range testServers {
-		_, err := registryService.CreateServer(context.Background(), server)
+		_, err := registryService.CreateServer(context.Background(), server, "")
 		require.NoError(t, err)
 	}
 
@@ -128,7 +128,7 @@ func TestUpdateServerStatusEndpoint(t *testing.T) {
 			ID:     "testuser/multi-version-server",
 		},
 	}
-	_, err = registryService.CreateServer(context.Background(), multiVersionV2)
+	_, err = registryService.CreateServer(context.Background(), multiVersionV2, "")
 	require.NoError(t, err)
 
 	testCases := []struct {
@@ -469,7 +469,7 @@ func TestUpdateServerStatusEndpointSameStatusTransition(t *testing.T) {
 		Description: "Server for same status transition test",
 		Version:     "1.0.0",
 	}
-	_, err = registryService.CreateServer(context.Background(), activeServer)
+	_, err = registryService.CreateServer(context.Background(), activeServer, "")
 	require.NoError(t, err)
 
 	// Create Huma API
@@ -528,7 +528,7 @@ func TestUpdateServerStatusEndpointURLEncoding(t *testing.T) {
 		Description: "Server with build metadata version",
 		Version:     "1.0.0+20130313144700",
 	}
-	_, err = registryService.CreateServer(context.Background(), buildMetadataServer)
+	_, err = registryService.CreateServer(context.Background(), buildMetadataServer, "")
 	require.NoError(t, err)
 
 	// Create Huma API
@@ -599,16 +599,16 @@ func TestUpdateAllVersionsStatusEndpoint(t *testing.T) {
 			ID:     "testuser/multi-version-server",
 		},
 	}
-	_, err = registryService.CreateServer(context.Background(), multiVersionServer)
+	_, err = registryService.CreateServer(context.Background(), multiVersionServer, "")
 	require.NoError(t, err)
 
 	// Add more versions
 	multiVersionServer.Version = "1.1.0"
-	_, err = registryService.CreateServer(context.Background(), multiVersionServer)
+	_, err = registryService.CreateServer(context.Background(), multiVersionServer, "")
 	require.NoError(t, err)
 
 	multiVersionServer.Version = "2.0.0"
-	_, err = registryService.CreateServer(context.Background(), multiVersionServer)
+	_, err = registryService.CreateServer(context.Background(), multiVersionServer, "")
 	require.NoError(t, err)
 
 	// Create other user's server
@@ -623,7 +623,7 @@ func TestUpdateAllVersionsStatusEndpoint(t *testing.T) {
 			ID:     "otheruser/other-server",
 		},
 	}
-	_, err = registryService.CreateServer(context.Background(), otherServer)
+	_, err = registryService.CreateServer(context.Background(), otherServer, "")
 	require.NoError(t, err)
 
 	testCases := []struct {
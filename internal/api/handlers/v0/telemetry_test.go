@@ -33,7 +33,7 @@ func TestPrometheusHandler(t *testing.T) {
 			ID:     "example/test-server",
 		},
 		Version: "2.0.0",
-	})
+	}, "")
 	assert.NoError(t, err)
 
 	cfg := config.NewConfig()
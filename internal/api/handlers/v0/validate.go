@@ -3,34 +3,77 @@ package v0
 import (
 	"context"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/validators"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 )
 
 // ValidateServerInput represents the input for validating a server JSON
 type ValidateServerInput struct {
-	Body apiv0.ServerJSON `body:""`
+	Strict  bool             `query:"strict" doc:"Also report the server.json as invalid if any issue is a warning after the profile is applied. Unlike profile=strict, which turns selected warnings into errors, severities are unchanged (default: false)" required:"false" default:"false"`
+	Profile string           `query:"profile" doc:"Validation profile selecting which rules are reported and at what severity (default: default)" required:"false" default:"default" enum:"default,strict,lenient"`
+	Body    apiv0.ServerJSON `body:""`
 }
 
-// RegisterValidateEndpoint registers the validate endpoint with a custom path prefix
-func RegisterValidateEndpoint(api huma.API, pathPrefix string) {
+// RegisterValidateEndpoint registers the validate endpoint, and the endpoint listing the rules
+// it reports, with a custom path prefix
+func RegisterValidateEndpoint(api huma.API, pathPrefix string, cfg *config.Config) {
 	huma.Register(api, huma.Operation{
 		OperationID: "validate-server" + strings.ReplaceAll(pathPrefix, "/", "-"),
 		Method:      http.MethodPost,
 		Path:        pathPrefix + "/validate",
 		Summary:     "Validate MCP server JSON",
-		Description: "Validate a server.json file without publishing it to the registry",
+		Description: "Validate a server.json file without publishing it to the registry. The profile selects which rules are reported and at what severity. With strict=true, any warning left by the profile also makes the result invalid, whichever profile is selected; unlike profile=strict it does not change severities. At most the registry's configured maximum of issues is returned, with truncated set if there were more.",
 		Tags:        []string{"validate"},
 	}, func(_ context.Context, input *ValidateServerInput) (*Response[validators.ValidationResult], error) {
+		profile, err := validators.LookupProfile(input.Profile)
+		if err != nil {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+
 		// Perform comprehensive validation (schema version, full schema validation, and semantic)
-		result := validators.ValidateServerJSON(&input.Body, validators.ValidationAll)
+		result := profile.Apply(validators.ValidateServerJSON(&input.Body, validators.ValidationAll))
+		// strict=true is checked after the profile, so it also counts the warnings of
+		// profile=strict and cannot resurrect the rules a profile disables
+		if input.Strict && hasWarnings(result) {
+			result.Valid = false
+		}
+		limitIssues(result, cfg.ValidateMaxIssues)
 
 		// Return validation result (always 200 OK, validity indicated in result.Valid)
 		return &Response[validators.ValidationResult]{
 			Body: *result,
 		}, nil
 	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-validation-rules" + strings.ReplaceAll(pathPrefix, "/", "-"),
+		Method:      http.MethodGet,
+		Path:        pathPrefix + "/validate/rules",
+		Summary:     "List validation rules",
+		Description: "Returns the reference, type, default severity and description of every named rule that validation can report, sorted by reference. Issues found by JSON Schema validation carry the schema rule path as their reference and are not listed.",
+		Tags:        []string{"validate"},
+	}, func(_ context.Context, _ *struct{}) (*Response[[]validators.Rule], error) {
+		return &Response[[]validators.Rule]{Body: validators.Rules()}, nil
+	})
+}
+
+// limitIssues cuts the issues of result short at maxIssues, if positive, to bound the size of
+// the response, and marks it truncated if any were dropped
+func limitIssues(result *validators.ValidationResult, maxIssues int) {
+	if maxIssues > 0 && len(result.Issues) > maxIssues {
+		result.Issues = result.Issues[:maxIssues]
+		result.Truncated = true
+	}
+}
+
+// hasWarnings reports whether result has an issue of warning severity
+func hasWarnings(result *validators.ValidationResult) bool {
+	return slices.ContainsFunc(result.Issues, func(issue validators.ValidationIssue) bool {
+		return issue.Severity == validators.ValidationIssueSeverityWarning
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humago"
 	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
+	"github.com/modelcontextprotocol/registry/internal/config"
+	"github.com/modelcontextprotocol/registry/internal/validators"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/modelcontextprotocol/registry/pkg/model"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +35,7 @@ func TestValidateEndpoint(t *testing.T) {
 	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
 
 	// Register the endpoint
-	v0.RegisterValidateEndpoint(api, "/v0")
+	v0.RegisterValidateEndpoint(api, "/v0", config.NewConfig())
 
 	testCases := []struct {
 		name           string
@@ -49,6 +52,7 @@ func TestValidateEndpoint(t *testing.T) {
 				Name:        "com.example/test-server",
 				Description: "A test server",
 				Version:     "1.0.0",
+				Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
 			},
 			expectedValid:  true,
 			expectedStatus: http.StatusOK,
@@ -74,7 +78,7 @@ func TestValidateEndpoint(t *testing.T) {
 				require.Greater(t, len(issues), 0, "Should have at least one issue")
 				issue := issues[0]
 				assert.Equal(t, "semantic", issue.Type, "Issue type should be semantic")
-				assert.Equal(t, "version", issue.Path, "Issue path should be 'version'")
+				assert.Equal(t, "/version", issue.Path, "Issue path should be '/version'")
 				assert.Equal(t, "error", issue.Severity, "Issue severity should be error")
 				assert.NotEmpty(t, issue.Message, "Issue message should not be empty")
 				assert.Contains(t, issue.Message, "^1.0.0", "Issue message should contain the version range")
@@ -97,7 +101,7 @@ func TestValidateEndpoint(t *testing.T) {
 				require.Greater(t, len(issues), 0, "Should have at least one issue")
 				issue := issues[0]
 				assert.Equal(t, "semantic", issue.Type, "Issue type should be semantic")
-				assert.Equal(t, "version", issue.Path, "Issue path should be 'version'")
+				assert.Equal(t, "/version", issue.Path, "Issue path should be '/version'")
 				assert.Equal(t, "error", issue.Severity, "Issue severity should be error")
 				assert.NotEmpty(t, issue.Message, "Issue message should not be empty")
 				assert.Contains(t, strings.ToLower(issue.Message), "latest", "Issue message should mention 'latest'")
@@ -171,3 +175,267 @@ func TestValidateEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEndpoint_Strict(t *testing.T) {
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterValidateEndpoint(api, "/v0", config.NewConfig())
+
+	// An http:// repository URL is only a warning
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Repository:  &model.Repository{URL: "http://github.com/example/test-server", Source: "github"},
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	bodyBytes, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+
+	validate := func(target string) (bool, []issueStruct) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, target, bytes.NewReader(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var result struct {
+			Valid  bool          `json:"valid"`
+			Issues []issueStruct `json:"issues"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		return result.Valid, result.Issues
+	}
+
+	valid, issues := validate("/v0/validate")
+	require.NotEmpty(t, issues)
+	for _, issue := range issues {
+		require.Equal(t, "warning", issue.Severity, "the document should only have warnings: %+v", issue)
+	}
+	assert.True(t, valid, "warnings should not affect validity by default")
+
+	valid, _ = validate("/v0/validate?strict=false")
+	assert.True(t, valid)
+
+	valid, strictIssues := validate("/v0/validate?strict=true")
+	assert.False(t, valid, "strict=true should make warnings invalidate the result")
+	assert.Equal(t, issues, strictIssues, "strict=true should not change the issues")
+}
+
+func TestValidateEndpoint_Profile(t *testing.T) {
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterValidateEndpoint(api, "/v0", config.NewConfig())
+
+	// An http:// repository URL is a warning by default
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Repository:  &model.Repository{URL: "http://github.com/example/test-server", Source: "github"},
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	bodyBytes, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+
+	validate := func(target string) (int, bool, map[string]string) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, target, bytes.NewReader(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+
+		var result struct {
+			Valid  bool          `json:"valid"`
+			Issues []issueStruct `json:"issues"`
+		}
+		_ = json.Unmarshal(rr.Body.Bytes(), &result)
+		severities := map[string]string{}
+		for _, issue := range result.Issues {
+			severities[issue.Reference] = issue.Severity
+		}
+		return rr.Code, result.Valid, severities
+	}
+
+	status, valid, severities := validate("/v0/validate?profile=strict")
+	require.Equal(t, http.StatusOK, status)
+	assert.False(t, valid)
+	assert.Equal(t, "error", severities["url-insecure-scheme"])
+
+	status, valid, severities = validate("/v0/validate?profile=lenient")
+	require.Equal(t, http.StatusOK, status)
+	assert.True(t, valid)
+	assert.Equal(t, "warning", severities["url-insecure-scheme"])
+
+	status, _, defaultSeverities := validate("/v0/validate")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, severities, defaultSeverities, "the lenient profile does not change this rule")
+
+	status, _, _ = validate("/v0/validate?profile=paranoid")
+	assert.Equal(t, http.StatusUnprocessableEntity, status, "unknown profiles should be rejected")
+}
+
+func TestValidateEndpoint_StrictWithProfile(t *testing.T) {
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterValidateEndpoint(api, "/v0", config.NewConfig())
+
+	// A name close to the length limit is a warning that the strict profile keeps, and an
+	// http:// repository URL one that it turns into an error
+	nearLimitName := "com.example/" + strings.Repeat("a", 180)
+	insecureRepository := "http://github.com/example/test-server"
+
+	validate := func(target, name, repositoryURL string) (bool, map[string]string) {
+		bodyBytes, err := json.Marshal(apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        name,
+			Description: "A test server",
+			Version:     "1.0.0",
+			Repository:  &model.Repository{URL: repositoryURL, Source: "github"},
+			Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+		})
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, target, bytes.NewReader(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var result struct {
+			Valid  bool          `json:"valid"`
+			Issues []issueStruct `json:"issues"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+		severities := map[string]string{}
+		for _, issue := range result.Issues {
+			severities[issue.Reference] = issue.Severity
+		}
+		return result.Valid, severities
+	}
+
+	t.Run("warnings the strict profile keeps", func(t *testing.T) {
+		valid, severities := validate("/v0/validate?profile=strict", nearLimitName, "https://github.com/example/test-server")
+		assert.Equal(t, map[string]string{"name-length-near-limit": "warning"}, severities)
+		assert.True(t, valid, "profile=strict only fails the warnings it turns into errors")
+
+		valid, strictSeverities := validate("/v0/validate?strict=true", nearLimitName, "https://github.com/example/test-server")
+		assert.False(t, valid, "strict=true fails on any warning")
+		assert.Equal(t, severities, strictSeverities, "strict=true does not change severities")
+
+		valid, _ = validate("/v0/validate?profile=strict&strict=true", nearLimitName, "https://github.com/example/test-server")
+		assert.False(t, valid, "strict=true also counts the warnings left by profile=strict")
+	})
+
+	t.Run("warnings of the lenient profile", func(t *testing.T) {
+		valid, severities := validate("/v0/validate?profile=lenient", "com.example/test-server", insecureRepository)
+		assert.True(t, valid)
+		assert.Equal(t, "warning", severities["url-insecure-scheme"])
+
+		valid, severities = validate("/v0/validate?profile=lenient&strict=true", "com.example/test-server", insecureRepository)
+		assert.False(t, valid, "strict=true applies to the result of any profile")
+		assert.Equal(t, "warning", severities["url-insecure-scheme"])
+	})
+
+	t.Run("errors of the strict profile", func(t *testing.T) {
+		for _, target := range []string{"/v0/validate?profile=strict", "/v0/validate?profile=strict&strict=true"} {
+			valid, severities := validate(target, "com.example/test-server", insecureRepository)
+			assert.False(t, valid, target)
+			assert.Equal(t, "error", severities["url-insecure-scheme"], target)
+		}
+	})
+}
+
+func TestValidateEndpoint_MaxIssues(t *testing.T) {
+	// Every package declares a url for its stdio transport, an error each
+	packages := make([]model.Package, 25)
+	for i := range packages {
+		packages[i] = model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   fmt.Sprintf("@example/server-%d", i),
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio, URL: "https://example.com/mcp"},
+		}
+	}
+	bodyBytes, err := json.Marshal(apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Packages:    packages,
+	})
+	require.NoError(t, err)
+
+	validate := func(maxIssues int) (map[string]json.RawMessage, []issueStruct) {
+		mux := http.NewServeMux()
+		api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+		v0.RegisterValidateEndpoint(api, "/v0", &config.Config{ValidateMaxIssues: maxIssues})
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/v0/validate", bytes.NewReader(bodyBytes))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &fields))
+		var issues []issueStruct
+		require.NoError(t, json.Unmarshal(fields["issues"], &issues))
+		return fields, issues
+	}
+
+	fields, issues := validate(0)
+	require.Greater(t, len(issues), 10)
+	assert.NotContains(t, fields, "truncated", "an unlimited result should not be marked truncated")
+	all := issues
+
+	fields, issues = validate(10)
+	assert.Equal(t, all[:10], issues, "the first issues should be kept in order")
+	assert.JSONEq(t, "true", string(fields["truncated"]))
+	assert.JSONEq(t, "false", string(fields["valid"]), "validity should reflect every issue")
+
+	fields, issues = validate(len(all))
+	assert.Equal(t, all, issues)
+	assert.NotContains(t, fields, "truncated", "a result at the maximum should not be marked truncated")
+
+	assert.Equal(t, 200, config.NewConfig().ValidateMaxIssues)
+}
+
+func TestValidateRulesEndpoint(t *testing.T) {
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterValidateEndpoint(api, "/v0", config.NewConfig())
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/v0/validate/rules", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var rules []struct {
+		Reference   string `json:"reference"`
+		Type        string `json:"type"`
+		Severity    string `json:"severity"`
+		Description string `json:"description"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rules))
+	require.Len(t, rules, len(validators.Rules()), "every registered rule should be listed")
+
+	byReference := make(map[string]issueStruct, len(rules))
+	for _, rule := range rules {
+		assert.NotEmpty(t, rule.Description, "rule %s should have a description", rule.Reference)
+		byReference[rule.Reference] = issueStruct{Type: rule.Type, Severity: rule.Severity, Reference: rule.Reference}
+	}
+	for _, want := range []issueStruct{
+		{Reference: "schema-field-required", Type: "semantic", Severity: "error"},
+		{Reference: "schema-version-deprecated", Type: "semantic", Severity: "warning"},
+		{Reference: "schema-not-a-url", Type: "schema", Severity: "error"},
+		{Reference: "transport-url-scheme-mismatch", Type: "semantic", Severity: "error"},
+	} {
+		assert.Equal(t, want, byReference[want.Reference])
+	}
+}
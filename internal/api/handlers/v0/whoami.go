@@ -0,0 +1,69 @@
+package v0
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
+)
+
+// WhoAmIInput represents the input for inspecting a Registry JWT token
+type WhoAmIInput struct {
+	Authorization string `header:"Authorization" doc:"Registry JWT token" required:"true"`
+}
+
+// WhoAmIBody describes the identity and permissions of a Registry JWT token
+type WhoAmIBody struct {
+	AuthMethod        auth.Method       `json:"authMethod" doc:"Authentication method used to obtain the token" example:"github-at"`
+	AuthMethodSubject string            `json:"authMethodSubject" doc:"Subject authenticated by that method, e.g. a GitHub username or domain" example:"octocat"`
+	Permissions       []auth.Permission `json:"permissions" doc:"Actions the token grants and the server name patterns they apply to"`
+	ExpiresAt         *time.Time        `json:"expiresAt,omitempty" doc:"When the token expires"`
+}
+
+// RegisterWhoAmIEndpoint registers the token inspection endpoint with a custom path prefix
+func RegisterWhoAmIEndpoint(api huma.API, pathPrefix string, cfg *config.Config) {
+	jwtManager := auth.NewJWTManager(cfg)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-token-identity" + strings.ReplaceAll(pathPrefix, "/", "-"),
+		Method:      http.MethodGet,
+		Path:        pathPrefix + "/auth/whoami",
+		Summary:     "Inspect Registry JWT",
+		Description: "Verify a Registry JWT token and return the identity and permissions it grants. Makes no changes, so it can be used to check a token before publishing.",
+		Tags:        []string{"auth"},
+		Security: []map[string][]string{
+			{"bearer": {}},
+		},
+	}, func(ctx context.Context, input *WhoAmIInput) (*Response[WhoAmIBody], error) {
+		// Extract bearer token
+		const bearerPrefix = "Bearer "
+		authHeader := input.Authorization
+		if len(authHeader) < len(bearerPrefix) || !strings.EqualFold(authHeader[:len(bearerPrefix)], bearerPrefix) {
+			return nil, huma.Error401Unauthorized("Invalid Authorization header format. Expected 'Bearer <token>'")
+		}
+		token := authHeader[len(bearerPrefix):]
+
+		// Validate Registry JWT token
+		claims, err := jwtManager.ValidateToken(ctx, token)
+		if err != nil {
+			return nil, huma.Error401Unauthorized("Invalid or expired Registry JWT token", err)
+		}
+
+		body := WhoAmIBody{
+			AuthMethod:        claims.AuthMethod,
+			AuthMethodSubject: claims.AuthMethodSubject,
+			Permissions:       claims.Permissions,
+		}
+		if claims.ExpiresAt != nil {
+			expiresAt := claims.ExpiresAt.Time
+			body.ExpiresAt = &expiresAt
+		}
+
+		return &Response[WhoAmIBody]{Body: body}, nil
+	})
+}
@@ -0,0 +1,79 @@
+package v0_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
+	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
+)
+
+func TestWhoAmIEndpoint(t *testing.T) {
+	testSeed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(testSeed)
+	require.NoError(t, err)
+	cfg := &config.Config{JWTPrivateKey: hex.EncodeToString(testSeed)}
+
+	mux := http.NewServeMux()
+	api := humago.New(mux, huma.DefaultConfig("Test API", "1.0.0"))
+	v0.RegisterWhoAmIEndpoint(api, "/v0", cfg)
+
+	jwtManager := auth.NewJWTManager(cfg)
+	tokenResponse, err := jwtManager.GenerateTokenResponse(context.Background(), auth.JWTClaims{
+		AuthMethod:        auth.MethodGitHubAT,
+		AuthMethodSubject: "testuser",
+		Permissions: []auth.Permission{
+			{Action: auth.PermissionActionPublish, ResourcePattern: "io.github.testuser/*"},
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		authHeader     string
+		expectedStatus int
+	}{
+		{"valid token", "Bearer " + tokenResponse.RegistryToken, http.StatusOK},
+		{"invalid token", "Bearer invalid-token", http.StatusUnauthorized},
+		{"missing bearer prefix", tokenResponse.RegistryToken, http.StatusUnauthorized},
+		{"missing header", "", http.StatusUnprocessableEntity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v0/auth/whoami", nil)
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code, w.Body.String())
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var body v0.WhoAmIBody
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+			assert.Equal(t, auth.MethodGitHubAT, body.AuthMethod)
+			assert.Equal(t, "testuser", body.AuthMethodSubject)
+			require.Len(t, body.Permissions, 1)
+			assert.Equal(t, "io.github.testuser/*", body.Permissions[0].ResourcePattern)
+			require.NotNil(t, body.ExpiresAt)
+			assert.WithinDuration(t, time.Unix(int64(tokenResponse.ExpiresAt), 0), *body.ExpiresAt, time.Second)
+		})
+	}
+}
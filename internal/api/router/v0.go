@@ -22,8 +22,10 @@ func RegisterV0Routes(
 	v0.RegisterStatusEndpoints(api, "/v0", registry, cfg)
 	v0.RegisterAllVersionsStatusEndpoints(api, "/v0", registry, cfg)
 	v0auth.RegisterAuthEndpoints(api, "/v0", cfg)
+	v0.RegisterWhoAmIEndpoint(api, "/v0", cfg)
 	v0.RegisterPublishEndpoint(api, "/v0", registry, cfg)
-	v0.RegisterValidateEndpoint(api, "/v0")
+	v0.RegisterValidateEndpoint(api, "/v0", cfg)
+	v0.RegisterSchemaEndpoint(api, "/v0")
 }
 
 func RegisterV0_1Routes(
@@ -37,6 +39,8 @@ func RegisterV0_1Routes(
 	v0.RegisterStatusEndpoints(api, "/v0.1", registry, cfg)
 	v0.RegisterAllVersionsStatusEndpoints(api, "/v0.1", registry, cfg)
 	v0auth.RegisterAuthEndpoints(api, "/v0.1", cfg)
+	v0.RegisterWhoAmIEndpoint(api, "/v0.1", cfg)
 	v0.RegisterPublishEndpoint(api, "/v0.1", registry, cfg)
-	v0.RegisterValidateEndpoint(api, "/v0.1")
+	v0.RegisterValidateEndpoint(api, "/v0.1", cfg)
+	v0.RegisterSchemaEndpoint(api, "/v0.1")
 }
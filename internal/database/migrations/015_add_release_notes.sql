@@ -0,0 +1,10 @@
+-- Add the release notes a publisher can attach to a version when publishing it.
+--
+-- The migration framework wraps each migration in its own transaction, so no explicit
+-- BEGIN/COMMIT here.
+
+ALTER TABLE servers ADD COLUMN release_notes TEXT;
+
+-- Constraint: release_notes must not exceed 5000 characters (apiv0.MaxReleaseNotesLength)
+ALTER TABLE servers ADD CONSTRAINT check_release_notes_length
+    CHECK (length(release_notes) <= 5000);
@@ -207,7 +207,7 @@ func (db *PostgreSQL) ListServers(
 
 	// Query servers table with hybrid column/JSON data
 	query := fmt.Sprintf(`
-        SELECT server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value
+        SELECT server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value
         FROM servers
         %s
         ORDER BY server_name, version
@@ -226,10 +226,11 @@ func (db *PostgreSQL) ListServers(
 		var serverName, version, status string
 		var statusChangedAt, publishedAt, updatedAt time.Time
 		var statusMessage *string
+		var releaseNotes *string
 		var isLatest bool
 		var valueJSON []byte
 
-		err := rows.Scan(&serverName, &version, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest, &valueJSON)
+		err := rows.Scan(&serverName, &version, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest, &valueJSON)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to scan server row: %w", err)
 		}
@@ -248,6 +249,7 @@ func (db *PostgreSQL) ListServers(
 					Status:          model.Status(status),
 					StatusChangedAt: statusChangedAt,
 					StatusMessage:   statusMessage,
+					ReleaseNotes:    releaseNotes,
 					PublishedAt:     publishedAt,
 					UpdatedAt:       updatedAt,
 					IsLatest:        isLatest,
@@ -295,7 +297,7 @@ func (db *PostgreSQL) GetServerByName(ctx context.Context, tx pgx.Tx, serverName
 	}
 
 	query := fmt.Sprintf(`
-		SELECT server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value
+		SELECT server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value
 		FROM servers
 		%s
 		ORDER BY published_at DESC
@@ -305,9 +307,10 @@ func (db *PostgreSQL) GetServerByName(ctx context.Context, tx pgx.Tx, serverName
 	var name, version, status string
 	var statusChangedAt, publishedAt, updatedAt time.Time
 	var statusMessage *string
+	var releaseNotes *string
 	var valueJSON []byte
 
-	err := db.getExecutor(tx).QueryRow(ctx, query, args...).Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest, &valueJSON)
+	err := db.getExecutor(tx).QueryRow(ctx, query, args...).Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest, &valueJSON)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -329,6 +332,7 @@ func (db *PostgreSQL) GetServerByName(ctx context.Context, tx pgx.Tx, serverName
 				Status:          model.Status(status),
 				StatusChangedAt: statusChangedAt,
 				StatusMessage:   statusMessage,
+				ReleaseNotes:    releaseNotes,
 				PublishedAt:     publishedAt,
 				UpdatedAt:       updatedAt,
 				IsLatest:        isLatest,
@@ -361,7 +365,7 @@ func (db *PostgreSQL) GetServerByNameAndVersion(ctx context.Context, tx pgx.Tx,
 	}
 
 	query := fmt.Sprintf(`
-		SELECT server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value
+		SELECT server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value
 		FROM servers
 		%s
 		LIMIT 1
@@ -370,10 +374,11 @@ func (db *PostgreSQL) GetServerByNameAndVersion(ctx context.Context, tx pgx.Tx,
 	var name, vers, status string
 	var statusChangedAt, publishedAt, updatedAt time.Time
 	var statusMessage *string
+	var releaseNotes *string
 	var isLatest bool
 	var valueJSON []byte
 
-	err := db.getExecutor(tx).QueryRow(ctx, query, args...).Scan(&name, &vers, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest, &valueJSON)
+	err := db.getExecutor(tx).QueryRow(ctx, query, args...).Scan(&name, &vers, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest, &valueJSON)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -395,6 +400,7 @@ func (db *PostgreSQL) GetServerByNameAndVersion(ctx context.Context, tx pgx.Tx,
 				Status:          model.Status(status),
 				StatusChangedAt: statusChangedAt,
 				StatusMessage:   statusMessage,
+				ReleaseNotes:    releaseNotes,
 				PublishedAt:     publishedAt,
 				UpdatedAt:       updatedAt,
 				IsLatest:        isLatest,
@@ -426,7 +432,7 @@ func (db *PostgreSQL) GetAllVersionsByServerName(ctx context.Context, tx pgx.Tx,
 	}
 
 	query := fmt.Sprintf(`
-		SELECT server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value
+		SELECT server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value
 		FROM servers
 		%s
 		ORDER BY published_at DESC
@@ -443,10 +449,11 @@ func (db *PostgreSQL) GetAllVersionsByServerName(ctx context.Context, tx pgx.Tx,
 		var name, version, status string
 		var statusChangedAt, publishedAt, updatedAt time.Time
 		var statusMessage *string
+		var releaseNotes *string
 		var isLatest bool
 		var valueJSON []byte
 
-		err := rows.Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest, &valueJSON)
+		err := rows.Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest, &valueJSON)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan server row: %w", err)
 		}
@@ -465,6 +472,7 @@ func (db *PostgreSQL) GetAllVersionsByServerName(ctx context.Context, tx pgx.Tx,
 					Status:          model.Status(status),
 					StatusChangedAt: statusChangedAt,
 					StatusMessage:   statusMessage,
+					ReleaseNotes:    releaseNotes,
 					PublishedAt:     publishedAt,
 					UpdatedAt:       updatedAt,
 					IsLatest:        isLatest,
@@ -509,8 +517,8 @@ func (db *PostgreSQL) CreateServer(ctx context.Context, tx pgx.Tx, serverJSON *a
 
 	// Insert the new server version using composite primary key
 	insertQuery := `
-		INSERT INTO servers (server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO servers (server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err = db.getExecutor(tx).Exec(ctx, insertQuery,
@@ -519,6 +527,7 @@ func (db *PostgreSQL) CreateServer(ctx context.Context, tx pgx.Tx, serverJSON *a
 		string(officialMeta.Status),
 		officialMeta.StatusChangedAt,
 		officialMeta.StatusMessage,
+		officialMeta.ReleaseNotes,
 		officialMeta.PublishedAt,
 		officialMeta.UpdatedAt,
 		officialMeta.IsLatest,
@@ -566,15 +575,16 @@ func (db *PostgreSQL) UpdateServer(ctx context.Context, tx pgx.Tx, serverName, v
 		UPDATE servers
 		SET value = $1, updated_at = NOW()
 		WHERE server_name = $2 AND version = $3
-		RETURNING server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest
+		RETURNING server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest
 	`
 
 	var name, vers, status string
 	var statusChangedAt, publishedAt, updatedAt time.Time
 	var statusMessage *string
+	var releaseNotes *string
 	var isLatest bool
 
-	err = db.getExecutor(tx).QueryRow(ctx, query, valueJSON, serverName, version).Scan(&name, &vers, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest)
+	err = db.getExecutor(tx).QueryRow(ctx, query, valueJSON, serverName, version).Scan(&name, &vers, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -590,6 +600,7 @@ func (db *PostgreSQL) UpdateServer(ctx context.Context, tx pgx.Tx, serverName, v
 				Status:          model.Status(status),
 				StatusChangedAt: statusChangedAt,
 				StatusMessage:   statusMessage,
+				ReleaseNotes:    releaseNotes,
 				PublishedAt:     publishedAt,
 				UpdatedAt:       updatedAt,
 				IsLatest:        isLatest,
@@ -616,7 +627,7 @@ func (db *PostgreSQL) SetServerStatus(ctx context.Context, tx pgx.Tx, serverName
 			updated_at = NOW(),
 			status_message = $4
 		WHERE server_name = $2 AND version = $3
-		RETURNING server_name, version, status, value, published_at, updated_at, is_latest, status_changed_at, status_message
+		RETURNING server_name, version, status, value, published_at, updated_at, is_latest, status_changed_at, status_message, release_notes
 	`
 
 	var name, vers, currentStatus string
@@ -624,8 +635,9 @@ func (db *PostgreSQL) SetServerStatus(ctx context.Context, tx pgx.Tx, serverName
 	var isLatest bool
 	var valueJSON []byte
 	var resultStatusMessage *string
+	var releaseNotes *string
 
-	err := db.getExecutor(tx).QueryRow(ctx, query, string(status), serverName, version, statusMessage).Scan(&name, &vers, &currentStatus, &valueJSON, &publishedAt, &updatedAt, &isLatest, &statusChangedAt, &resultStatusMessage)
+	err := db.getExecutor(tx).QueryRow(ctx, query, string(status), serverName, version, statusMessage).Scan(&name, &vers, &currentStatus, &valueJSON, &publishedAt, &updatedAt, &isLatest, &statusChangedAt, &resultStatusMessage, &releaseNotes)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -647,6 +659,7 @@ func (db *PostgreSQL) SetServerStatus(ctx context.Context, tx pgx.Tx, serverName
 				Status:          model.Status(currentStatus),
 				StatusChangedAt: statusChangedAt,
 				StatusMessage:   resultStatusMessage,
+				ReleaseNotes:    releaseNotes,
 				PublishedAt:     publishedAt,
 				UpdatedAt:       updatedAt,
 				IsLatest:        isLatest,
@@ -675,7 +688,7 @@ func (db *PostgreSQL) SetAllVersionsStatus(ctx context.Context, tx pgx.Tx, serve
 			status_message = $2
 		WHERE server_name = $3
 			AND (status != $1::varchar OR status_message IS DISTINCT FROM $2)
-		RETURNING server_name, version, status, value, published_at, updated_at, is_latest, status_changed_at, status_message
+		RETURNING server_name, version, status, value, published_at, updated_at, is_latest, status_changed_at, status_message, release_notes
 	`
 
 	rows, err := db.getExecutor(tx).Query(ctx, query, string(status), statusMessage, serverName)
@@ -691,8 +704,9 @@ func (db *PostgreSQL) SetAllVersionsStatus(ctx context.Context, tx pgx.Tx, serve
 		var isLatest bool
 		var valueJSON []byte
 		var resultStatusMessage *string
+		var releaseNotes *string
 
-		if err := rows.Scan(&name, &vers, &currentStatus, &valueJSON, &publishedAt, &updatedAt, &isLatest, &statusChangedAt, &resultStatusMessage); err != nil {
+		if err := rows.Scan(&name, &vers, &currentStatus, &valueJSON, &publishedAt, &updatedAt, &isLatest, &statusChangedAt, &resultStatusMessage, &releaseNotes); err != nil {
 			return nil, fmt.Errorf("failed to scan server row: %w", err)
 		}
 
@@ -709,6 +723,7 @@ func (db *PostgreSQL) SetAllVersionsStatus(ctx context.Context, tx pgx.Tx, serve
 					Status:          model.Status(currentStatus),
 					StatusChangedAt: statusChangedAt,
 					StatusMessage:   resultStatusMessage,
+					ReleaseNotes:    releaseNotes,
 					PublishedAt:     publishedAt,
 					UpdatedAt:       updatedAt,
 					IsLatest:        isLatest,
@@ -800,7 +815,7 @@ func (db *PostgreSQL) GetCurrentLatestVersion(ctx context.Context, tx pgx.Tx, se
 	executor := db.getExecutor(tx)
 
 	query := `
-		SELECT server_name, version, status, status_changed_at, status_message, published_at, updated_at, is_latest, value
+		SELECT server_name, version, status, status_changed_at, status_message, release_notes, published_at, updated_at, is_latest, value
 		FROM servers
 		WHERE server_name = $1 AND is_latest = true
 	`
@@ -810,10 +825,11 @@ func (db *PostgreSQL) GetCurrentLatestVersion(ctx context.Context, tx pgx.Tx, se
 	var name, version, status string
 	var statusChangedAt, publishedAt, updatedAt time.Time
 	var statusMessage *string
+	var releaseNotes *string
 	var isLatest bool
 	var jsonValue []byte
 
-	err := row.Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &publishedAt, &updatedAt, &isLatest, &jsonValue)
+	err := row.Scan(&name, &version, &status, &statusChangedAt, &statusMessage, &releaseNotes, &publishedAt, &updatedAt, &isLatest, &jsonValue)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
@@ -835,6 +851,7 @@ func (db *PostgreSQL) GetCurrentLatestVersion(ctx context.Context, tx pgx.Tx, se
 				Status:          model.Status(status),
 				StatusChangedAt: statusChangedAt,
 				StatusMessage:   statusMessage,
+				ReleaseNotes:    releaseNotes,
 				PublishedAt:     publishedAt,
 				UpdatedAt:       updatedAt,
 				IsLatest:        isLatest,
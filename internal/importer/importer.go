@@ -40,7 +40,7 @@ func (s *Service) ImportFromPath(ctx context.Context, path string) error {
 	var failedCreations []string
 
 	for _, server := range servers {
-		_, err := s.registry.CreateServer(ctx, server)
+		_, err := s.registry.CreateServer(ctx, server, "")
 		if err != nil {
 			failedCreations = append(failedCreations, fmt.Sprintf("%s: %v", server.Name, err))
 			log.Printf("Failed to create server %s: %v", server.Name, err)
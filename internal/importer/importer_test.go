@@ -132,7 +132,7 @@ func TestImportService_RegistryPagination(t *testing.T) {
 	}
 
 	for _, server := range sourceServers {
-		_, err := registryService.CreateServer(ctx, server)
+		_, err := registryService.CreateServer(ctx, server, "")
 		require.NoError(t, err)
 	}
 
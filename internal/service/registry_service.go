@@ -90,11 +90,11 @@ func (s *registryServiceImpl) GetAllVersionsByServerName(ctx context.Context, se
 	return serverRecords, nil
 }
 
-// CreateServer creates a new server version
-func (s *registryServiceImpl) CreateServer(ctx context.Context, req *apiv0.ServerJSON) (*apiv0.ServerResponse, error) {
+// CreateServer creates a new server version with optional release notes
+func (s *registryServiceImpl) CreateServer(ctx context.Context, req *apiv0.ServerJSON, releaseNotes string) (*apiv0.ServerResponse, error) {
 	// Wrap the entire operation in a transaction
 	return database.InTransactionT(ctx, s.db, func(ctx context.Context, tx pgx.Tx) (*apiv0.ServerResponse, error) {
-		return s.createServerInTransaction(ctx, tx, req)
+		return s.createServerInTransaction(ctx, tx, req, releaseNotes)
 	})
 }
 
@@ -105,7 +105,7 @@ func (s *registryServiceImpl) CreateServer(ctx context.Context, req *apiv0.Serve
 // pool-exhaustion stalls in acquire_lock / version_checks / db_create — we saw 50s+
 // total publish times even though validate_ms was a few hundred ms. With every phase
 // reported the next slow publish tells us which step to blame.
-func (s *registryServiceImpl) createServerInTransaction(ctx context.Context, tx pgx.Tx, req *apiv0.ServerJSON) (resp *apiv0.ServerResponse, err error) {
+func (s *registryServiceImpl) createServerInTransaction(ctx context.Context, tx pgx.Tx, req *apiv0.ServerJSON, releaseNotes string) (resp *apiv0.ServerResponse, err error) {
 	start := time.Now()
 	serverJSON := *req
 	var (
@@ -233,6 +233,9 @@ func (s *registryServiceImpl) createServerInTransaction(ctx context.Context, tx
 		UpdatedAt:       publishTime,
 		IsLatest:        isNewLatest,
 	}
+	if releaseNotes != "" {
+		officialMeta.ReleaseNotes = &releaseNotes
+	}
 
 	// Insert new server version
 	if !runPhase(phaseDBCreate, &createMs, func() error {
@@ -48,7 +48,7 @@ func TestValidateNoDuplicateRemoteURLs(t *testing.T) {
 
 	// Create existing servers using the new CreateServer method
 	for _, server := range existingServers {
-		_, err := service.CreateServer(ctx, server)
+		_, err := service.CreateServer(ctx, server, "")
 		require.NoError(t, err, "failed to create server: %v", err)
 	}
 
@@ -139,7 +139,7 @@ func TestGetServerByName(t *testing.T) {
 		Name:        "com.example/test-server",
 		Description: "Test server v1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
@@ -147,7 +147,7 @@ func TestGetServerByName(t *testing.T) {
 		Name:        "com.example/test-server",
 		Description: "Test server v2",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -210,7 +210,7 @@ func TestGetServerByNameAndVersion(t *testing.T) {
 		Name:        serverName,
 		Description: "Versioned server v1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
@@ -218,7 +218,7 @@ func TestGetServerByNameAndVersion(t *testing.T) {
 		Name:        serverName,
 		Description: "Versioned server v2",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -290,6 +290,45 @@ func TestGetServerByNameAndVersion(t *testing.T) {
 	}
 }
 
+func TestCreateServer_ReleaseNotes(t *testing.T) {
+	ctx := context.Background()
+	testDB := database.NewTestDB(t)
+	service := NewRegistryService(testDB, &config.Config{EnableRegistryValidation: false})
+
+	serverName := "com.example/notes-server"
+	newServer := func(version string) *apiv0.ServerJSON {
+		return &apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        serverName,
+			Description: "Server with release notes",
+			Version:     version,
+		}
+	}
+
+	published, err := service.CreateServer(ctx, newServer("1.0.0"), "Adds the forecast tool")
+	require.NoError(t, err)
+	require.NotNil(t, published.Meta.Official.ReleaseNotes)
+	assert.Equal(t, "Adds the forecast tool", *published.Meta.Official.ReleaseNotes)
+
+	_, err = service.CreateServer(ctx, newServer("2.0.0"), "")
+	require.NoError(t, err)
+
+	// The notes are stored with their version and survive later status changes
+	result, err := service.GetServerByNameAndVersion(ctx, serverName, "1.0.0", false)
+	require.NoError(t, err)
+	require.NotNil(t, result.Meta.Official.ReleaseNotes)
+	assert.Equal(t, "Adds the forecast tool", *result.Meta.Official.ReleaseNotes)
+
+	result, err = service.UpdateServerStatus(ctx, serverName, "1.0.0", &StatusChangeRequest{NewStatus: model.StatusDeprecated})
+	require.NoError(t, err)
+	require.NotNil(t, result.Meta.Official.ReleaseNotes)
+	assert.Equal(t, "Adds the forecast tool", *result.Meta.Official.ReleaseNotes)
+
+	result, err = service.GetServerByNameAndVersion(ctx, serverName, "2.0.0", false)
+	require.NoError(t, err)
+	assert.Nil(t, result.Meta.Official.ReleaseNotes)
+}
+
 func TestGetAllVersionsByServerName(t *testing.T) {
 	ctx := context.Background()
 	testDB := database.NewTestDB(t)
@@ -303,7 +342,7 @@ func TestGetAllVersionsByServerName(t *testing.T) {
 		Name:        serverName,
 		Description: "Multi-version server v1",
 		Version:     "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
@@ -311,7 +350,7 @@ func TestGetAllVersionsByServerName(t *testing.T) {
 		Name:        serverName,
 		Description: "Multi-version server v2",
 		Version:     "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
@@ -319,7 +358,7 @@ func TestGetAllVersionsByServerName(t *testing.T) {
 		Name:        serverName,
 		Description: "Multi-version server v2.1",
 		Version:     "2.1.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -406,7 +445,7 @@ func TestCreateServerConcurrentVersionsNoRace(t *testing.T) {
 				Name:        serverName,
 				Description: fmt.Sprintf("Version %d", idx),
 				Version:     fmt.Sprintf("1.0.%d", idx),
-			})
+			}, "")
 			results[idx] = result
 			errors[idx] = err
 		}(i)
@@ -459,7 +498,7 @@ func TestUpdateServer(t *testing.T) {
 		Remotes: []model.Transport{
 			{Type: "streamable-http", URL: "https://original.example.com/mcp"},
 		},
-	})
+	}, "")
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -578,7 +617,7 @@ func TestUpdateServer_SkipValidationForDeletedServers(t *testing.T) {
 	// Create initial server (validation disabled for creation in this test)
 	originalConfig := service.(*registryServiceImpl).cfg.EnableRegistryValidation
 	service.(*registryServiceImpl).cfg.EnableRegistryValidation = false
-	_, err := service.CreateServer(ctx, invalidServer)
+	_, err := service.CreateServer(ctx, invalidServer, "")
 	require.NoError(t, err, "failed to create server with validation disabled")
 	service.(*registryServiceImpl).cfg.EnableRegistryValidation = originalConfig
 
@@ -635,7 +674,7 @@ func TestUpdateServer_SkipValidationForDeletedServers(t *testing.T) {
 
 	// Create active server (with validation disabled)
 	service.(*registryServiceImpl).cfg.EnableRegistryValidation = false
-	_, err = service.CreateServer(ctx, activeServer)
+	_, err = service.CreateServer(ctx, activeServer, "")
 	require.NoError(t, err)
 	service.(*registryServiceImpl).cfg.EnableRegistryValidation = originalConfig
 
@@ -671,7 +710,7 @@ func TestListServers(t *testing.T) {
 			Name:        server.name,
 			Description: server.description,
 			Version:     server.version,
-		})
+		}, "")
 		require.NoError(t, err)
 	}
 
@@ -769,7 +808,7 @@ func TestVersionComparison(t *testing.T) {
 			Name:        serverName,
 			Description: v.description,
 			Version:     v.version,
-		})
+		}, "")
 		require.NoError(t, err, "Failed to create version %s", v.version)
 	}
 
@@ -810,7 +849,7 @@ func TestUpdateServerStatus_ValidateRemoteURLsOnRestoreToActive(t *testing.T) {
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err := service.CreateServer(ctx, serverA)
+	_, err := service.CreateServer(ctx, serverA, "")
 	require.NoError(t, err)
 
 	// Delete Server A
@@ -829,7 +868,7 @@ func TestUpdateServerStatus_ValidateRemoteURLsOnRestoreToActive(t *testing.T) {
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err = service.CreateServer(ctx, serverB)
+	_, err = service.CreateServer(ctx, serverB, "")
 	require.NoError(t, err)
 
 	// Try to restore Server A to active - should fail due to URL conflict
@@ -858,7 +897,7 @@ func TestUpdateServerStatus_ValidateRemoteURLsOnRestoreFromDeleted(t *testing.T)
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err := service.CreateServer(ctx, serverA)
+	_, err := service.CreateServer(ctx, serverA, "")
 	require.NoError(t, err)
 
 	// Delete Server A
@@ -877,7 +916,7 @@ func TestUpdateServerStatus_ValidateRemoteURLsOnRestoreFromDeleted(t *testing.T)
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err = service.CreateServer(ctx, serverB)
+	_, err = service.CreateServer(ctx, serverB, "")
 	require.NoError(t, err)
 
 	// Try to restore deleted server to active - should fail due to URL conflict
@@ -906,7 +945,7 @@ func TestUpdateAllVersionsStatus_ValidateRemoteURLsOnRestoreToActive(t *testing.
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err := service.CreateServer(ctx, serverAv1)
+	_, err := service.CreateServer(ctx, serverAv1, "")
 	require.NoError(t, err)
 
 	serverAv2 := &apiv0.ServerJSON{
@@ -918,7 +957,7 @@ func TestUpdateAllVersionsStatus_ValidateRemoteURLsOnRestoreToActive(t *testing.
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err = service.CreateServer(ctx, serverAv2)
+	_, err = service.CreateServer(ctx, serverAv2, "")
 	require.NoError(t, err)
 
 	// Delete all versions of Server A
@@ -937,7 +976,7 @@ func TestUpdateAllVersionsStatus_ValidateRemoteURLsOnRestoreToActive(t *testing.
 			{Type: "streamable-http", URL: remoteURL},
 		},
 	}
-	_, err = service.CreateServer(ctx, serverB)
+	_, err = service.CreateServer(ctx, serverB, "")
 	require.NoError(t, err)
 
 	// Try to restore all versions of Server A to active - should fail
@@ -964,7 +1003,7 @@ func TestUpdateServerStatus_NoConflictWhenRestoringWithUniqueURLs(t *testing.T)
 			{Type: "streamable-http", URL: "https://unique.example.com/mcp"},
 		},
 	}
-	_, err := service.CreateServer(ctx, server)
+	_, err := service.CreateServer(ctx, server, "")
 	require.NoError(t, err)
 
 	// Delete the server
@@ -992,11 +1031,11 @@ func TestRecalculateLatest_PromotesNextHighestWhenLatestSoftDeleted(t *testing.T
 	// Publish 1.0.0, then 0.0.59. 1.0.0 is latest.
 	_, err := service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v1", Version: "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v0.0.59", Version: "0.0.59",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Soft-delete 1.0.0.
@@ -1022,13 +1061,13 @@ func TestRecalculateLatest_ReproFromIssue1081(t *testing.T) {
 	// 1. Publish 1.0.0.
 	_, err := service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v1", Version: "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// 2. Publish 0.0.59 — expected not latest at this point.
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v0.0.59", Version: "0.0.59",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// 3. Delete 1.0.0.
@@ -1045,7 +1084,7 @@ func TestRecalculateLatest_ReproFromIssue1081(t *testing.T) {
 	// 5. Publish 0.0.60 — should become latest because compare now excludes deleted 1.0.0.
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v0.0.60", Version: "0.0.60",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	latest, err = service.GetServerByName(ctx, serverName, false)
@@ -1074,11 +1113,11 @@ func TestRecalculateLatest_AllDeletedKeepsHighestAsLatest(t *testing.T) {
 
 	_, err := service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v1", Version: "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v2", Version: "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Delete all versions in one call.
@@ -1109,11 +1148,11 @@ func TestRecalculateLatest_RestoringHigherVersionPromotesIt(t *testing.T) {
 	// Publish 2.0.0 and 1.0.0 — 2.0.0 is latest.
 	_, err := service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v2", Version: "2.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 	_, err = service.CreateServer(ctx, &apiv0.ServerJSON{
 		Schema: model.CurrentSchemaURL, Name: serverName, Description: "v1", Version: "1.0.0",
-	})
+	}, "")
 	require.NoError(t, err)
 
 	// Delete 2.0.0 — 1.0.0 gets promoted.
@@ -24,8 +24,8 @@ type RegistryService interface {
 	GetServerByNameAndVersion(ctx context.Context, serverName string, version string, includeDeleted bool) (*apiv0.ServerResponse, error)
 	// GetAllVersionsByServerName retrieve all versions of a server by server name
 	GetAllVersionsByServerName(ctx context.Context, serverName string, includeDeleted bool) ([]*apiv0.ServerResponse, error)
-	// CreateServer creates a new server version
-	CreateServer(ctx context.Context, req *apiv0.ServerJSON) (*apiv0.ServerResponse, error)
+	// CreateServer creates a new server version with optional release notes
+	CreateServer(ctx context.Context, req *apiv0.ServerJSON, releaseNotes string) (*apiv0.ServerResponse, error)
 	// UpdateServer updates an existing server and optionally its status
 	UpdateServer(ctx context.Context, serverName, version string, req *apiv0.ServerJSON, statusChange *StatusChangeRequest) (*apiv0.ServerResponse, error)
 	// UpdateServerStatus updates only the status metadata of a server version
@@ -12,10 +12,13 @@ var (
 	ErrPackageNameHasSpaces  = errors.New("package name cannot contain spaces")
 	ErrReservedVersionString = errors.New("version string 'latest' is reserved and cannot be used")
 	ErrVersionLooksLikeRange = errors.New("version must be a specific version, not a range")
+	ErrPrereleaseVersion     = errors.New("version must not be a pre-release")
+	ErrVersionDowngrade      = errors.New("version is lower than the latest published version")
 
 	// Transport validation errors
 	ErrInvalidPackageTransportURL = errors.New("invalid package transport URL")
 	ErrInvalidRemoteURL           = errors.New("invalid remote URL")
+	ErrPrivateRemoteURL           = errors.New("remote URL points at a non-public address")
 
 	// Registry validation errors
 	ErrUnsupportedRegistryBaseURL   = errors.New("unsupported registry base URL")
@@ -30,6 +33,10 @@ var (
 	// Server name validation errors
 	ErrMultipleSlashesInServerName = errors.New("server name cannot contain multiple slashes")
 	ErrInvalidServerNameFormat     = errors.New("server name format is invalid")
+	ErrReservedNamespace           = errors.New("server name is in a reserved namespace")
+
+	// Recommended field configuration errors
+	ErrUnknownRecommendedField = errors.New("unknown recommended field")
 )
 
 // RepositorySource represents valid repository sources
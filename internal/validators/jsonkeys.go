@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ValidateDuplicateKeys warns about every key that appears more than once in the same
+// object of serverData, a server.json document. encoding/json silently keeps the last
+// value of a duplicated key, so such keys are usually copy-paste mistakes that the other
+// checks cannot see. Offsets are byte offsets in serverData. A document that is not valid
+// JSON is checked up to the syntax error, which is left to the other checks to report.
+func ValidateDuplicateKeys(serverData []byte) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	dec := json.NewDecoder(bytes.NewReader(serverData))
+	_ = scanDuplicateKeys(dec, serverData, &ValidationContext{}, result)
+	return result
+}
+
+// scanDuplicateKeys reads the next JSON value from dec, the decoder of data, and adds an
+// issue to result for every duplicated key in the objects it contains
+func scanDuplicateKeys(dec *json.Decoder, data []byte, ctx *ValidationContext, result *ValidationResult) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if err := scanDuplicateKeys(dec, data, ctx.Index(i), result); err != nil {
+				return err
+			}
+		}
+	case json.Delim('{'):
+		if err := scanObjectKeys(dec, data, ctx, result); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	// The closing bracket or brace
+	_, err = dec.Token()
+	return err
+}
+
+// scanObjectKeys reads the members of the object whose opening brace was just read from
+// dec, reporting keys already seen in the object and scanning the member values
+func scanObjectKeys(dec *json.Decoder, data []byte, ctx *ValidationContext, result *ValidationResult) error {
+	offsets := map[string]int64{}
+	for dec.More() {
+		offset := keyOffset(data, dec.InputOffset())
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+
+		if first, ok := offsets[key]; ok {
+			result.AddIssue(NewValidationIssue(
+				ValidationIssueTypeJSON,
+				ctx.Field(key).String(),
+				fmt.Sprintf("key %q appears more than once in the same object, at offsets %d and %d; only the last value is used", key, first, offset),
+				ValidationIssueSeverityWarning,
+				"duplicate-json-key",
+			))
+		} else {
+			offsets[key] = offset
+		}
+
+		if err := scanDuplicateKeys(dec, data, ctx.Field(key), result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyOffset returns the offset of the opening quote of the key that follows offset, the
+// decoder position after the previous token, by skipping the whitespace and comma between
+func keyOffset(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) && bytes.IndexByte([]byte(" \t\r\n,"), data[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
@@ -0,0 +1,65 @@
+package validators
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//go:embed spdx/licenses.txt
+var spdxLicenseList string
+
+// spdxLicenses maps the lowercased SPDX license identifiers to their canonical spelling.
+// It is built on first use.
+var spdxLicenses = sync.OnceValue(func() map[string]string {
+	licenses := map[string]string{}
+	for _, id := range strings.Fields(spdxLicenseList) {
+		licenses[strings.ToLower(id)] = id
+	}
+	return licenses
+})
+
+// ValidateLicense reports an error unless serverData, a server.json document, has a
+// top-level license field set to a recognized SPDX license identifier (e.g. MIT or
+// Apache-2.0). The field is not modelled by apiv0.ServerJSON, so it is read from the raw
+// document. It is not part of ValidateServerJSON, so callers opt in where a license is
+// required.
+func ValidateLicense(serverData []byte) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+
+	var document struct {
+		License any `json:"license"`
+	}
+	// Unparseable documents are reported by the other checks; here they have no license
+	_ = json.Unmarshal(serverData, &document)
+
+	var message string
+	switch license := document.License.(type) {
+	case nil:
+		message = "license is required; set it to an SPDX license identifier such as MIT or Apache-2.0"
+	case string:
+		canonical, ok := spdxLicenses()[strings.ToLower(strings.TrimSpace(license))]
+		switch {
+		case !ok:
+			message = fmt.Sprintf("license %q is not a recognized SPDX license identifier (see https://spdx.org/licenses/)", license)
+		case canonical != license:
+			message = fmt.Sprintf("license %q must be spelled as the SPDX license identifier %q", license, canonical)
+		}
+	default:
+		message = "license must be a string holding an SPDX license identifier"
+	}
+
+	if message != "" {
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("license").String(),
+			message,
+			ValidationIssueSeverityError,
+			"license-invalid",
+		))
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// MinLintDescriptionLength is the shortest description LintServerJSON accepts as saying
+// what a server does
+const MinLintDescriptionLength = 20
+
+// lintCheckCount is the number of best practices LintServerJSON checks, each worth an
+// equal share of the score
+const lintCheckCount = 5
+
+// placeholderPattern matches text left over from 'mcp-publisher init' or other templates
+var placeholderPattern = regexp.MustCompile(`(?i)<your-[^>]*>|\[describe[^\]]*\]|\byour-(org|package|image|username)\b|\b(TODO|TBD|FIXME|CHANGEME)\b|lorem ipsum`)
+
+// LintResult is the listing quality of a server.json: a score from 0 to 100 and a warning
+// for each best practice it does not follow
+type LintResult struct {
+	Score  int               `json:"score"`
+	Issues []ValidationIssue `json:"issues"`
+}
+
+// LintServerJSON scores serverData, a server.json document, against registry listing best
+// practices: a description of reasonable length, a repository, a license, at least one
+// package or remote to install it from, and no placeholder text. Unlike
+// ValidateServerJSON, it does not check that the document is valid, and its issues are
+// all warnings.
+func LintServerJSON(serverData []byte) (*LintResult, error) {
+	var serverJSON apiv0.ServerJSON
+	if err := json.Unmarshal(serverData, &serverJSON); err != nil {
+		return nil, fmt.Errorf("invalid server.json: %w", err)
+	}
+	var document any
+	if err := json.Unmarshal(serverData, &document); err != nil {
+		return nil, fmt.Errorf("invalid server.json: %w", err)
+	}
+
+	result := &LintResult{Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+	failed := 0
+
+	if length := len([]rune(strings.TrimSpace(serverJSON.Description))); length < MinLintDescriptionLength {
+		failed++
+		result.Issues = append(result.Issues, NewValidationIssue(
+			ValidationIssueTypeLinter,
+			ctx.Field("description").String(),
+			fmt.Sprintf("description is %d characters long; say what the server does in at least %d characters", length, MinLintDescriptionLength),
+			ValidationIssueSeverityWarning,
+			"listing-description-short",
+		))
+	}
+	if !recommendedFieldPresent["repository"](&serverJSON) {
+		failed++
+		result.Issues = append(result.Issues, NewValidationIssue(
+			ValidationIssueTypeLinter,
+			ctx.Field("repository").String(),
+			"repository is missing; link the source code so users can review the server",
+			ValidationIssueSeverityWarning,
+			"listing-repository-missing",
+		))
+	}
+	members, _ := document.(map[string]any)
+	if license, _ := members["license"].(string); strings.TrimSpace(license) == "" {
+		failed++
+		result.Issues = append(result.Issues, NewValidationIssue(
+			ValidationIssueTypeLinter,
+			ctx.Field("license").String(),
+			"license is missing; set it to an SPDX license identifier such as MIT or Apache-2.0",
+			ValidationIssueSeverityWarning,
+			"listing-license-missing",
+		))
+	}
+	if len(serverJSON.Packages) == 0 && len(serverJSON.Remotes) == 0 {
+		failed++
+		result.Issues = append(result.Issues, NewValidationIssue(
+			ValidationIssueTypeLinter,
+			ctx.Field("packages").String(),
+			"no packages or remotes; declare at least one way to install or connect to the server",
+			ValidationIssueSeverityWarning,
+			"listing-install-method-missing",
+		))
+	}
+
+	// Placeholders fail a single check however many there are
+	issuesBefore := len(result.Issues)
+	findPlaceholders(document, ctx, func(path, text string) {
+		result.Issues = append(result.Issues, NewValidationIssue(
+			ValidationIssueTypeLinter,
+			path,
+			fmt.Sprintf("%q looks like placeholder text; replace it with the real value", text),
+			ValidationIssueSeverityWarning,
+			"listing-placeholder",
+		))
+	})
+	if len(result.Issues) > issuesBefore {
+		failed++
+	}
+
+	result.Score = (lintCheckCount - failed) * 100 / lintCheckCount
+	return result, nil
+}
+
+// findPlaceholders calls found with the JSON pointer and text of every placeholder in the
+// string values of value, visiting object members in order of their names
+func findPlaceholders(value any, ctx *ValidationContext, found func(path, text string)) {
+	switch v := value.(type) {
+	case string:
+		if text := placeholderPattern.FindString(v); text != "" {
+			found(ctx.String(), text)
+		}
+	case []any:
+		for i, element := range v {
+			findPlaceholders(element, ctx.Index(i), found)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			findPlaceholders(v[key], ctx.Field(key), found)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// githubNamespacePrefix starts the namespaces the registry authenticates with GitHub
+// rather than DNS, such as io.github.username
+const githubNamespacePrefix = "io.github."
+
+// NamespaceDomain returns the domain named by the reverse-DNS namespace of serverName, e.g.
+// api.example.com for com.example.api/server. It returns false if serverName has no
+// namespace or its namespace is authenticated with GitHub instead of DNS.
+func NamespaceDomain(serverName string) (string, bool) {
+	namespace, _, found := strings.Cut(serverName, "/")
+	if !found || namespace == "" || strings.HasPrefix(namespace, githubNamespacePrefix) {
+		return "", false
+	}
+	labels := strings.Split(namespace, ".")
+	slices.Reverse(labels)
+	return strings.ToLower(strings.Join(labels, ".")), true
+}
+
+// ValidateNamespaceDomain warns with namespace-domain-unresolvable if the domain of the
+// namespace of serverJSON's name does not resolve with resolver, which often means a typo
+// such as com.exmaple. The namespace resolves if its domain or one of the parent domains
+// with at least two labels does, so com.example.api only needs example.com. Lookups that
+// fail for other reasons than the domain not existing, e.g. without network access, are not
+// reported. It is not part of ValidateServerJSON because it needs the network, so callers
+// opt in.
+func ValidateNamespaceDomain(ctx context.Context, serverJSON *apiv0.ServerJSON, resolver HostResolver) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	domain, ok := NamespaceDomain(serverJSON.Name)
+	if !ok {
+		return result
+	}
+
+	for candidate := domain; strings.Contains(candidate, "."); {
+		_, err := resolver.LookupIPAddr(ctx, candidate)
+		var dnsErr *net.DNSError
+		if err == nil || !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return result
+		}
+		_, candidate, _ = strings.Cut(candidate, ".")
+	}
+
+	vctx := &ValidationContext{}
+	result.AddIssue(NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		vctx.Field("name").String(),
+		fmt.Sprintf("the namespace of %s names the domain %s, which does not resolve; check it for typos", serverJSON.Name, domain),
+		ValidationIssueSeverityWarning,
+		"namespace-domain-unresolvable",
+	))
+	return result
+}
@@ -0,0 +1,95 @@
+package validators_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingResolver resolves like stubResolver, or fails every lookup with err if it is
+// set, and records the hosts looked up
+type recordingResolver struct {
+	stubResolver
+	err     error
+	lookups []string
+}
+
+func (r *recordingResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.lookups = append(r.lookups, host)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.stubResolver.LookupIPAddr(ctx, host)
+}
+
+func TestNamespaceDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		ok       bool
+	}{
+		{name: "com.acme/server", expected: "acme.com", ok: true},
+		{name: "com.Acme.API/server", expected: "api.acme.com", ok: true},
+		{name: "io.github.user/server"},
+		{name: "no-slash"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain, ok := validators.NamespaceDomain(tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, domain)
+		})
+	}
+}
+
+func TestValidateNamespaceDomain(t *testing.T) {
+	validate := func(name string, resolver *recordingResolver) *validators.ValidationResult {
+		return validators.ValidateNamespaceDomain(context.Background(), &apiv0.ServerJSON{Name: name}, resolver)
+	}
+
+	t.Run("resolvable", func(t *testing.T) {
+		resolver := &recordingResolver{stubResolver: stubResolver{"acme.com": {"192.0.2.1"}}}
+		result := validate("com.acme/server", resolver)
+		assert.Empty(t, result.Issues)
+		assert.Equal(t, []string{"acme.com"}, resolver.lookups)
+	})
+
+	t.Run("resolvable parent domain", func(t *testing.T) {
+		resolver := &recordingResolver{stubResolver: stubResolver{"acme.com": {"192.0.2.1"}}}
+		result := validate("com.acme.tools/server", resolver)
+		assert.Empty(t, result.Issues)
+		assert.Equal(t, []string{"tools.acme.com", "acme.com"}, resolver.lookups)
+	})
+
+	t.Run("unresolvable", func(t *testing.T) {
+		resolver := &recordingResolver{stubResolver: stubResolver{"acme.com": {"192.0.2.1"}}}
+		result := validate("com.acmme.tools/server", resolver)
+		assert.True(t, result.Valid, "an unresolvable namespace is only a warning")
+		require.Len(t, result.Issues, 1)
+		issue := result.Issues[0]
+		assert.Equal(t, "namespace-domain-unresolvable", issue.Reference)
+		assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+		assert.Equal(t, "/name", issue.Path)
+		assert.Contains(t, issue.Message, "tools.acmme.com, which does not resolve")
+		assert.Equal(t, []string{"tools.acmme.com", "acmme.com"}, resolver.lookups, "the top-level domain alone is not looked up")
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		resolver := &recordingResolver{err: errors.New("network is unreachable")}
+		result := validate("com.acme/server", resolver)
+		assert.Empty(t, result.Issues, "only domains that do not exist are reported")
+	})
+
+	t.Run("github namespace", func(t *testing.T) {
+		resolver := &recordingResolver{}
+		result := validate("io.github.user/server", resolver)
+		assert.Empty(t, result.Issues)
+		assert.Empty(t, resolver.lookups)
+	})
+}
@@ -0,0 +1,106 @@
+package validators
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Names of the validation profiles
+const (
+	ProfileDefault = "default"
+	ProfileStrict  = "strict"
+	ProfileLenient = "lenient"
+)
+
+// Profile is a named ruleset that adjusts the issues of a validation, for registries that
+// enforce more or less than the defaults. Issues of rules it does not mention keep their
+// default severity.
+type Profile struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Severities overrides the severity of the issues with these references
+	Severities map[string]ValidationIssueSeverity `json:"severities,omitempty"`
+	// Disabled lists the references whose issues are not reported
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// profiles lists the validation profiles, the default first
+var profiles = []Profile{
+	{
+		Name:        ProfileDefault,
+		Description: "Every rule at its default severity",
+	},
+	{
+		Name:        ProfileStrict,
+		Description: "Security, listing-quality and consistency warnings are errors",
+		Severities: map[string]ValidationIssueSeverity{
+			"description-equals-name":     ValidationIssueSeverityError,
+			"duplicate-json-key":          ValidationIssueSeverityError,
+			"env-name-nonstandard":        ValidationIssueSeverityError,
+			"name-package-inconsistent":   ValidationIssueSeverityError,
+			"no-installation-method":      ValidationIssueSeverityError,
+			"repository-package-mismatch": ValidationIssueSeverityError,
+			"schema-host-untrusted":       ValidationIssueSeverityError,
+			"schema-version-deprecated":   ValidationIssueSeverityError,
+			"transport-duplicate":         ValidationIssueSeverityError,
+			"url-insecure-scheme":         ValidationIssueSeverityError,
+		},
+	},
+	{
+		Name:        ProfileLenient,
+		Description: "Size limits and argument style are warnings, and naming conventions are not checked",
+		Severities: map[string]ValidationIssueSeverity{
+			"argument-default-starts-with-name": ValidationIssueSeverityWarning,
+			"argument-value-starts-with-name":   ValidationIssueSeverityWarning,
+			"arguments-too-many":                ValidationIssueSeverityWarning,
+			"name-too-long":                     ValidationIssueSeverityWarning,
+			"server-json-too-large":             ValidationIssueSeverityWarning,
+		},
+		Disabled: []string{"description-equals-name", "env-name-nonstandard", "name-package-inconsistent", "too-many-packages"},
+	},
+}
+
+// Profiles returns the validation profiles, the default first
+func Profiles() []Profile {
+	return slices.Clone(profiles)
+}
+
+// LookupProfile returns the validation profile called name, or the default profile if name
+// is empty
+func LookupProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = ProfileDefault
+	}
+	names := make([]string, 0, len(profiles))
+	for i := range profiles {
+		if profiles[i].Name == name {
+			return &profiles[i], nil
+		}
+		names = append(names, profiles[i].Name)
+	}
+	return nil, fmt.Errorf("unknown validation profile '%s'. Must be one of: %s", name, strings.Join(names, ", "))
+}
+
+// Apply returns result with the issues of disabled rules removed and the severities of the
+// others overridden by p. Valid is recomputed from the remaining errors, except that a
+// truncated result does not become valid. A nil profile returns result unchanged.
+func (p *Profile) Apply(result *ValidationResult) *ValidationResult {
+	if p == nil || (len(p.Severities) == 0 && len(p.Disabled) == 0) {
+		return result
+	}
+
+	applied := &ValidationResult{Valid: true, Issues: []ValidationIssue{}, Truncated: result.Truncated}
+	for _, issue := range result.Issues {
+		if slices.Contains(p.Disabled, issue.Reference) {
+			continue
+		}
+		if severity, ok := p.Severities[issue.Reference]; ok {
+			issue.Severity = severity
+		}
+		applied.AddIssue(issue)
+	}
+	// The issues cut from a truncated result are unknown, so it stays as valid as it was
+	applied.Valid = applied.Valid && (result.Valid || !result.Truncated)
+	return applied
+}
@@ -0,0 +1,111 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileSeverities validates serverJSON under the named profile and returns the result
+// and the severity of each reference it reports
+func profileSeverities(t *testing.T, serverJSON *apiv0.ServerJSON, opts validators.ValidationOptions, name string) (*validators.ValidationResult, map[string]validators.ValidationIssueSeverity) {
+	t.Helper()
+	profile, err := validators.LookupProfile(name)
+	require.NoError(t, err)
+	result := profile.Apply(validators.ValidateServerJSON(serverJSON, opts))
+	severities := map[string]validators.ValidationIssueSeverity{}
+	for _, issue := range result.Issues {
+		severities[issue.Reference] = issue.Severity
+	}
+	return result, severities
+}
+
+func TestProfiles_ReferenceKnownRules(t *testing.T) {
+	byRef := rulesByReference()
+	profiles := validators.Profiles()
+	require.NotEmpty(t, profiles)
+	assert.Equal(t, validators.ProfileDefault, profiles[0].Name)
+
+	for _, profile := range profiles {
+		assert.NotEmpty(t, profile.Description, profile.Name)
+		for reference := range profile.Severities {
+			assert.Contains(t, byRef, reference, "profile %s", profile.Name)
+		}
+		for _, reference := range profile.Disabled {
+			assert.Contains(t, byRef, reference, "profile %s", profile.Name)
+		}
+	}
+}
+
+func TestLookupProfile(t *testing.T) {
+	profile, err := validators.LookupProfile("")
+	require.NoError(t, err)
+	assert.Equal(t, validators.ProfileDefault, profile.Name)
+
+	_, err = validators.LookupProfile("paranoid")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown validation profile 'paranoid'. Must be one of: default, strict, lenient")
+}
+
+func TestProfile_Apply(t *testing.T) {
+	t.Run("strict error is lenient warning", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Repository:  &model.Repository{URL: "http://github.com/example/test-server", Source: "github"},
+			Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+		}
+
+		result, severities := profileSeverities(t, &serverJSON, validators.ValidationAll, validators.ProfileStrict)
+		assert.Equal(t, validators.ValidationIssueSeverityError, severities["url-insecure-scheme"])
+		assert.False(t, result.Valid)
+
+		result, severities = profileSeverities(t, &serverJSON, validators.ValidationAll, validators.ProfileLenient)
+		assert.Equal(t, validators.ValidationIssueSeverityWarning, severities["url-insecure-scheme"])
+		assert.True(t, result.Valid, "%v", result.Issues)
+	})
+
+	t.Run("lenient downgrades and disables rules", func(t *testing.T) {
+		args := make([]model.Argument, validators.DefaultMaxArguments+1)
+		for i := range args {
+			args[i] = model.Argument{Type: model.ArgumentTypePositional, ValueHint: "arg"}
+			args[i].Value = "value"
+		}
+		serverJSON := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "io.github.owner/server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Packages: []model.Package{{
+				RegistryType:         model.RegistryTypeNPM,
+				Identifier:           "@owner/server",
+				Version:              "1.0.0",
+				Transport:            model.Transport{Type: model.TransportTypeStdio},
+				PackageArguments:     args,
+				EnvironmentVariables: []model.KeyValueInput{{Name: "apiKey"}},
+			}},
+		}
+
+		result, severities := profileSeverities(t, &serverJSON, validators.ValidationSemanticOnly, validators.ProfileDefault)
+		assert.Equal(t, validators.ValidationIssueSeverityError, severities["arguments-too-many"])
+		assert.Contains(t, severities, "env-name-nonstandard")
+		assert.False(t, result.Valid)
+
+		result, severities = profileSeverities(t, &serverJSON, validators.ValidationSemanticOnly, validators.ProfileLenient)
+		assert.Equal(t, validators.ValidationIssueSeverityWarning, severities["arguments-too-many"])
+		assert.NotContains(t, severities, "env-name-nonstandard")
+		assert.True(t, result.Valid, "%v", result.Issues)
+	})
+
+	t.Run("nil profile", func(t *testing.T) {
+		result := &validators.ValidationResult{Valid: true}
+		var profile *validators.Profile
+		assert.Same(t, result, profile.Apply(result))
+	})
+}
@@ -0,0 +1,149 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Rule describes a named validation rule and the issue it produces.
+// Schema issues reported by full JSON Schema validation carry the schema rule path as
+// their reference instead and are not listed individually.
+type Rule struct {
+	Reference   string                  `json:"reference"`
+	Type        ValidationIssueType     `json:"type"`
+	Severity    ValidationIssueSeverity `json:"severity"` // Default severity (some rules vary by policy)
+	Description string                  `json:"description"`
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]Rule{}
+)
+
+// builtinRules lists every named rule emitted by this package
+var builtinRules = []Rule{
+	// Schema version and JSON Schema validation
+	{"schema-field-required", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "$schema field must be present"},
+	{"schema-url-whitespace", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "$schema should not have leading or trailing whitespace or control characters"},
+	{"schema-not-a-url", ValidationIssueTypeSchema, ValidationIssueSeverityError, "$schema must be an http(s) URL, not a bare version or relative path"},
+	{"schema-version-extraction-error", ValidationIssueTypeSchema, ValidationIssueSeverityError, "$schema must be a URL containing /schemas/{version}/server.schema.json"},
+	{"schema-version-deprecated", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "$schema should reference the current schema version (error when publishing)"},
+	{"schema-version-ahead", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "$schema references a schema version newer than the validator supports, so it is not validated against its schema (error when publishing)"},
+	{"schema-host-untrusted", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "$schema should be on the host of the official schemas, static.modelcontextprotocol.io (error when publishing)"},
+	{"schema-version-unknown", ValidationIssueTypeSchema, ValidationIssueSeverityError, "$schema must reference a known schema version"},
+	{"schema-parse-error", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Embedded schema file could not be parsed"},
+	{"schema-missing-id", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Embedded schema file must declare $id"},
+	{"schema-dialect-unsupported", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Embedded schema must declare a supported JSON Schema dialect in $schema"},
+	{"schema-resource-error", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Embedded schema could not be loaded by the schema compiler"},
+	{"schema-compile-error", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Embedded schema could not be compiled"},
+	{"schema-external-ref-unresolved", ValidationIssueTypeSchema, ValidationIssueSeverityError, "Schema references an external schema that could not be resolved from the schema reference directory"},
+	{"schema-validation-error", ValidationIssueTypeSchema, ValidationIssueSeverityError, "server.json must conform to the JSON Schema for its version"},
+	{"duplicate-json-key", ValidationIssueTypeJSON, ValidationIssueSeverityWarning, "An object should not contain the same key twice, as only the last value is used (checked by validate)"},
+	{"json-marshal-error", ValidationIssueTypeJSON, ValidationIssueSeverityError, "server.json could not be serialized for schema validation"},
+	{"json-unmarshal-error", ValidationIssueTypeJSON, ValidationIssueSeverityError, "server.json could not be deserialized for schema validation"},
+
+	// Server metadata
+	{"invalid-server-name", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "name must be in 'dns-namespace/name' format"},
+	{"namespace-reserved", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "name must not be in a reserved namespace such as io.modelcontextprotocol/* or com.example/*"},
+	{"namespace-domain-unresolvable", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "name's reverse-DNS namespace should name a domain that resolves (checked by validate --check-namespace-domain)"},
+	{"reserved-version-string", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "version must not be the reserved string 'latest'"},
+	{"version-looks-like-range", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "version must be a specific version, not a range"},
+	{"version-prerelease-forbidden", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "version must not be a semver pre-release such as 1.0.0-rc.1 where pre-releases are forbidden (publish --no-prerelease to a production registry)"},
+	{"version-downgrade", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "version must not be lower than the latest published version of the server (publish --check-downgrade)"},
+	{"invalid-repository-url", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "repository.url must be a valid URL for the repository source"},
+	{"url-insecure-scheme", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "repository.url and packages[].registryBaseUrl should use https rather than http, except on localhost (an error with validate --strict)"},
+	{"invalid-subfolder-path", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "repository.subfolder must be a clean relative path"},
+	{"name-package-inconsistent", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "At least one package should share a recognizable word with the server name (e.g. @acme/mcp-weather for com.acme/weather)"},
+	{"repository-package-mismatch", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "Packages should plausibly belong to the repository owner (npm scope, ghcr.io namespace)"},
+	{"recommended-field-missing:icons", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "icons is recommended for a good registry listing"},
+	{"recommended-field-missing:repository", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "repository is recommended for a good registry listing"},
+	{"recommended-field-missing:title", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "title is recommended for a good registry listing"},
+	{"recommended-field-missing:websiteUrl", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "websiteUrl is recommended for a good registry listing"},
+	{"listing-description-short", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "description should be long enough to say what the server does (checked by lint)"},
+	{"listing-install-method-missing", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "At least one package or remote is recommended for a good registry listing (checked by lint)"},
+	{"listing-license-missing", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "license is recommended for a good registry listing (checked by lint)"},
+	{"listing-placeholder", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "Values should not contain template placeholder text (checked by lint)"},
+	{"listing-repository-missing", ValidationIssueTypeLinter, ValidationIssueSeverityWarning, "repository is recommended for a good registry listing (checked by lint)"},
+	{"license-invalid", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "license must be a recognized SPDX license identifier (checked by validate --require-license)"},
+	{"invalid-website-url", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "websiteUrl must be a parseable URL"},
+	{"website-url-must-be-absolute", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "websiteUrl must be an absolute URL"},
+	{"website-url-invalid-scheme", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "websiteUrl must use https"},
+	{"website-url-invalid-characters", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "websiteUrl must not contain quotes, angle brackets or whitespace"},
+	{"description-equals-name", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "description should say what the server does rather than repeat its name"},
+	{"title-whitespace-only", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "title must not be only whitespace"},
+	{"icon-src-invalid-url", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "icons[].src must be a parseable URL"},
+	{"icon-src-not-absolute", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "icons[].src must be an absolute URL"},
+	{"icon-src-invalid-scheme", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "icons[].src must use https"},
+
+	// Packages and arguments
+	{"package-version-mismatch", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "packages[].version should match the server version (checked by validate --check-package-versions)"},
+	{"package-name-has-spaces", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "packages[].identifier must not contain spaces"},
+	{"named-argument-name-required", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Named arguments must have a name"},
+	{"invalid-named-argument-name", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Named argument names must not embed values or descriptions"},
+	{"argument-value-starts-with-name", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Named argument value must not repeat the argument name"},
+	{"argument-default-starts-with-name", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Named argument default must not repeat the argument name"},
+	{"argument-template-invalid", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "{identifier} placeholders in argument values and defaults should be closed and declared in variables"},
+
+	// Transports
+	{"stdio-transport-url-not-empty", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "stdio transports must not declare a url"},
+	{"streamable-transport-url-required", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "streamable-http and sse package transports must declare a url"},
+	{"invalid-templated-url", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Transport URL template variables must reference declared inputs"},
+	{"unsupported-transport-type", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Package transport type must be stdio, streamable-http or sse"},
+	{"remote-transport-url-required", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Remotes must declare a url"},
+	{"invalid-remote-url", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Remote URLs must be public https URLs"},
+	{"remote-url-private", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Remote URLs must not resolve to loopback, private or link-local addresses when publishing to a production registry (checked by publish)"},
+	{"unsupported-remote-transport-type", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Remote transport type must be streamable-http or sse"},
+	{"no-installation-method", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "A server should declare at least one package or remote, so that it can be installed or connected to"},
+	{"too-many-packages", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "A server should not declare more packages than the configured maximum (default 50), which usually indicates duplicated or generated entries"},
+	{"env-name-nonstandard", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "Package environment variable names should match the configured pattern (default UPPER_SNAKE_CASE)"},
+	{"arguments-too-many", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "A package must not declare more runtime and package arguments than the configured maximum (default 100)"},
+	{"name-too-long", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "Server name must not be longer than the configured maximum (default 200 characters)"},
+	{"name-length-near-limit", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "Server name should not be within 10% of the maximum length"},
+	{"server-json-too-large", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "server.json must not serialize to more than the configured maximum size (default 256 KiB)"},
+	{"transport-duplicate", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "A transport should not be declared twice (same remote type and url, or same package and transport type)"},
+	{"transport-options-conflict", ValidationIssueTypeSemantic, ValidationIssueSeverityWarning, "stdio transports should not declare headers or variables, which only apply to streamable-http and sse"},
+	{"transport-url-scheme-mismatch", ValidationIssueTypeSemantic, ValidationIssueSeverityError, "streamable-http and sse transport urls must use http or https, not e.g. ws"},
+}
+
+func init() {
+	for _, rule := range builtinRules {
+		if err := RegisterRule(rule); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterRule adds a rule to the registry so it is included in Rules().
+// Callers outside this package (e.g. client-side checks) use this to describe the
+// references they emit. Registering the same reference twice is an error.
+func RegisterRule(rule Rule) error {
+	if rule.Reference == "" {
+		return errors.New("rule reference is required")
+	}
+
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	if _, exists := rules[rule.Reference]; exists {
+		return fmt.Errorf("rule %s is already registered", rule.Reference)
+	}
+	rules[rule.Reference] = rule
+	return nil
+}
+
+// Rules returns all registered rules sorted by reference
+func Rules() []Rule {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	result := make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, rule)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Reference < result[j].Reference
+	})
+	return result
+}
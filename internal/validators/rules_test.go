@@ -0,0 +1,101 @@
+package validators_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rulesByReference() map[string]validators.Rule {
+	byRef := map[string]validators.Rule{}
+	for _, rule := range validators.Rules() {
+		byRef[rule.Reference] = rule
+	}
+	return byRef
+}
+
+func TestRules_IncludesKnownReferences(t *testing.T) {
+	byRef := rulesByReference()
+
+	rule, ok := byRef["version-looks-like-range"]
+	require.True(t, ok, "version range rule should be registered")
+	assert.Equal(t, validators.ValidationIssueTypeSemantic, rule.Type)
+	assert.Equal(t, validators.ValidationIssueSeverityError, rule.Severity)
+	assert.NotEmpty(t, rule.Description)
+
+	rule, ok = byRef["schema-version-deprecated"]
+	require.True(t, ok, "deprecated schema rule should be registered")
+	assert.Equal(t, validators.ValidationIssueSeverityWarning, rule.Severity)
+}
+
+func TestRules_SortedByReference(t *testing.T) {
+	rules := validators.Rules()
+	for i := 1; i < len(rules); i++ {
+		assert.Less(t, rules[i-1].Reference, rules[i].Reference)
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	err := validators.RegisterRule(validators.Rule{
+		Reference:   "test-plugin-rule",
+		Type:        validators.ValidationIssueTypeLinter,
+		Severity:    validators.ValidationIssueSeverityInfo,
+		Description: "Registered by a test",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, rulesByReference(), "test-plugin-rule")
+
+	err = validators.RegisterRule(validators.Rule{Reference: "test-plugin-rule"})
+	require.Error(t, err, "duplicate references should be rejected")
+
+	err = validators.RegisterRule(validators.Rule{})
+	require.Error(t, err, "empty reference should be rejected")
+}
+
+// TestRules_CoverEmittedReferences keeps the rule registry in sync with the code by
+// checking that every literal reference passed to NewValidationIssue or
+// NewValidationIssueFromError in this package is registered.
+func TestRules_CoverEmittedReferences(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	byRef := rulesByReference()
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		parsed, err := parser.ParseFile(fset, file, src, 0)
+		require.NoError(t, err)
+
+		ast.Inspect(parsed, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn, ok := call.Fun.(*ast.Ident)
+			if !ok || (fn.Name != "NewValidationIssue" && fn.Name != "NewValidationIssueFromError") {
+				return true
+			}
+			lit, ok := call.Args[len(call.Args)-1].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			reference, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+			assert.Contains(t, byRef, reference, "%s: reference %q is not registered in rules.go", fset.Position(lit.Pos()), reference)
+			return true
+		})
+	}
+}
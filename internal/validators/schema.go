@@ -2,13 +2,23 @@ package validators
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/modelcontextprotocol/registry/pkg/model"
@@ -43,6 +53,262 @@ func loadSchemaByVersion(version string) ([]byte, error) {
 	return data, nil
 }
 
+// EmbeddedSchema returns the schema document of the given embedded schema version, e.g. to
+// serve it to clients that validate against the registry's schema
+func EmbeddedSchema(version string) ([]byte, error) {
+	return loadSchemaByVersion(version)
+}
+
+// SchemaVersions returns the schema versions embedded in the validator, oldest first
+func SchemaVersions() []string {
+	entries, err := schemaFS.ReadDir("schemas")
+	if err != nil {
+		return nil
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if version, ok := strings.CutSuffix(entry.Name(), ".json"); ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// EmbeddedSchemaVersion returns the version of the embedded schema that a $schema URL
+// selects, or an error if the URL names no version or that version is not embedded
+func EmbeddedSchemaVersion(schemaURL string) (string, error) {
+	version, err := extractVersionFromSchemaURL(schemaURL)
+	if err != nil {
+		return "", err
+	}
+	if _, err := loadSchemaByVersion(version); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// schemaURLForVersion returns the $schema URL of the given schema version
+func schemaURLForVersion(version string) string {
+	return strings.Replace(model.CurrentSchemaURL, model.CurrentSchemaVersion, version, 1)
+}
+
+// ValidateServerJSONForSchemaVersion validates serverJSON as if its $schema referenced the
+// given schema version, regardless of the version it declares. Since the version is chosen
+// explicitly, non-current versions are not reported.
+func ValidateServerJSONForSchemaVersion(serverJSON *apiv0.ServerJSON, version string, opts ValidationOptions) *ValidationResult {
+	pinned := *serverJSON
+	pinned.Schema = schemaURLForVersion(version)
+	opts.NonCurrentSchemaPolicy = SchemaVersionPolicyAllow
+	return ValidateServerJSON(&pinned, opts)
+}
+
+// compiledSchema is an embedded schema version compiled for validation. Compiling is the
+// slowest part of schema validation, so each version is compiled at most once per process.
+type compiledSchema struct {
+	once     sync.Once
+	schema   map[string]any // parsed schema document, used to resolve $refs in issue references
+	instance *jsonschema.Schema
+	issue    *ValidationIssue // set if the schema could not be compiled
+}
+
+var (
+	compiledSchemasMu sync.Mutex
+	compiledSchemas   = map[string]*compiledSchema{}
+)
+
+// compiledSchemaForVersion returns the compiled schema of the given version, compiling
+// schemaData on first use
+func compiledSchemaForVersion(version string, schemaData []byte, refDir string) *compiledSchema {
+	return compiledSchemaFor(version, "schema file for version "+version, schemaData, refDir)
+}
+
+// compiledSchemaForDocument returns a schema supplied by the caller compiled, caching it
+// by content so that validating many documents against it compiles it once
+func compiledSchemaForDocument(schemaData []byte, refDir string) *compiledSchema {
+	sum := sha256.Sum256(schemaData)
+	return compiledSchemaFor("sha256:"+hex.EncodeToString(sum[:]), "supplied schema", schemaData, refDir)
+}
+
+// compiledSchemaFor returns the compiled schema cached under key, compiling schemaData on
+// first use. name describes the schema in issues. External $refs are resolved from refDir,
+// which is part of the cache key since it can change how the schema compiles.
+func compiledSchemaFor(key, name string, schemaData []byte, refDir string) *compiledSchema {
+	key += "\x00" + refDir
+	compiledSchemasMu.Lock()
+	compiled, ok := compiledSchemas[key]
+	if !ok {
+		compiled = &compiledSchema{}
+		compiledSchemas[key] = compiled
+	}
+	compiledSchemasMu.Unlock()
+
+	compiled.once.Do(func() {
+		compiled.compile(name, schemaData, refDir)
+	})
+	return compiled
+}
+
+// compile parses and compiles schemaData, recording an issue if that fails
+func (c *compiledSchema) compile(name string, schemaData []byte, refDir string) {
+	ctx := &ValidationContext{}
+	fail := func(path, message, reference string) {
+		issue := NewValidationIssue(ValidationIssueTypeSchema, path, message, ValidationIssueSeverityError, reference)
+		c.issue = &issue
+	}
+
+	// Parse the schema
+	if err := json.Unmarshal(schemaData, &c.schema); err != nil {
+		fail(ctx.Field("$schema").String(), fmt.Sprintf("failed to parse schema file: %v", err), "schema-parse-error")
+		return
+	}
+
+	// Get the schema $id for proper reference resolution
+	// Schema files must have $id (required by JSON Schema spec and verified by sync process)
+	// However, we check here in case a schema file exists but is malformed or missing $id
+	schemaID, ok := c.schema["$id"].(string)
+	if !ok {
+		fail(ctx.Field("$schema").String(), fmt.Sprintf("%s exists but is missing or has invalid $id field (required by JSON Schema spec)", name), "schema-missing-id")
+		return
+	}
+
+	draft, err := schemaDialect(c.schema)
+	if err != nil {
+		fail(ctx.Field("$schema").String(), err.Error(), "schema-dialect-unsupported")
+		return
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = draft
+	compiler.LoadURL = schemaRefLoader(refDir)
+	if err := compiler.AddResource(schemaID, bytes.NewReader(schemaData)); err != nil {
+		fail(ctx.Field("$schema").String(), fmt.Sprintf("failed to add schema resource: %v", err), "schema-resource-error")
+		return
+	}
+
+	instance, err := compiler.Compile(schemaID)
+	var unresolved *unresolvedRefError
+	if errors.As(err, &unresolved) {
+		fail("", unresolved.Error(), "schema-external-ref-unresolved")
+		return
+	}
+	if err != nil {
+		fail("", fmt.Sprintf("failed to compile schema: %v", err), "schema-compile-error")
+		return
+	}
+	c.instance = instance
+}
+
+// unresolvedRefError reports an external $ref of a schema that was not found in the
+// schema reference directory
+type unresolvedRefError struct {
+	ref string
+	// candidates are the files that were looked for; none without a reference directory
+	candidates []string
+}
+
+func (e *unresolvedRefError) Error() string {
+	if len(e.candidates) == 0 {
+		return fmt.Sprintf("schema references the external schema %s, which cannot be resolved offline; provide a directory containing it (validate --schema-ref-dir)", e.ref)
+	}
+	return fmt.Sprintf("schema references the external schema %s, which was not found as %s", e.ref, strings.Join(e.candidates, " or "))
+}
+
+// schemaRefLoader returns the compiler's loader for external $refs. References are only
+// resolved from files in refDir, never fetched, so that validation works offline and a
+// schema cannot make the validator read other local files. The reference
+// https://example.com/schemas/npm.json is looked for as example.com/schemas/npm.json and
+// then as npm.json in refDir.
+func schemaRefLoader(refDir string) func(string) (io.ReadCloser, error) {
+	return func(ref string) (io.ReadCloser, error) {
+		candidates := schemaRefCandidates(refDir, ref)
+		for _, candidate := range candidates {
+			if f, err := os.Open(candidate); err == nil {
+				return f, nil
+			}
+		}
+		return nil, &unresolvedRefError{ref: ref, candidates: candidates}
+	}
+}
+
+// schemaRefCandidates returns the files in refDir that may hold the schema at ref, in
+// the order they are tried. Paths that would leave refDir are skipped.
+func schemaRefCandidates(refDir, ref string) []string {
+	if refDir == "" {
+		return nil
+	}
+	parsed, err := url.Parse(ref)
+	if err != nil || parsed.Path == "" {
+		return nil
+	}
+
+	var candidates []string
+	for _, name := range []string{
+		filepath.Join(parsed.Host, filepath.FromSlash(parsed.Path)),
+		path.Base(parsed.Path),
+	} {
+		if filepath.IsLocal(name) && !slices.Contains(candidates, filepath.Join(refDir, name)) {
+			candidates = append(candidates, filepath.Join(refDir, name))
+		}
+	}
+	return candidates
+}
+
+// schemaDialects are the JSON Schema dialects the validator supports, keyed by their
+// $schema URI without scheme or trailing "#"
+var schemaDialects = map[string]*jsonschema.Draft{
+	"json-schema.org/draft-04/schema":      jsonschema.Draft4,
+	"json-schema.org/draft-06/schema":      jsonschema.Draft6,
+	"json-schema.org/draft-07/schema":      jsonschema.Draft7,
+	"json-schema.org/draft/2019-09/schema": jsonschema.Draft2019,
+	"json-schema.org/draft/2020-12/schema": jsonschema.Draft2020,
+}
+
+// schemaDialect returns the JSON Schema dialect a schema declares in $schema, so that the
+// compiler does not fall back to its own default draft. Schemas without $schema are
+// treated as draft-07, the dialect the registry's schemas are generated in.
+func schemaDialect(schema map[string]any) (*jsonschema.Draft, error) {
+	declared, ok := schema["$schema"]
+	if !ok {
+		return jsonschema.Draft7, nil
+	}
+	uri, ok := declared.(string)
+	if !ok {
+		return nil, fmt.Errorf("schema $schema must be a string, got %T", declared)
+	}
+
+	key := strings.TrimSuffix(uri, "#")
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	draft, ok := schemaDialects[key]
+	if !ok {
+		return nil, fmt.Errorf("unsupported JSON Schema dialect %q: the validator supports draft-04, draft-06, draft-07, 2019-09 and 2020-12", uri)
+	}
+	return draft, nil
+}
+
+// schemaDocumentID returns the $id of a schema document, or "" if it has none
+func schemaDocumentID(schemaData []byte) string {
+	var document struct {
+		ID string `json:"$id"`
+	}
+	if len(schemaData) == 0 || json.Unmarshal(schemaData, &document) != nil {
+		return ""
+	}
+	return document.ID
+}
+
+// WarmUp compiles the current schema version ahead of time so that the first validation
+// doesn't pay for it. It is safe to call repeatedly and from multiple goroutines.
+func WarmUp() error {
+	schemaData, err := loadSchemaByVersion(model.CurrentSchemaVersion)
+	if err != nil {
+		return err
+	}
+	if compiled := compiledSchemaForVersion(model.CurrentSchemaVersion, schemaData, ""); compiled.issue != nil {
+		return errors.New(compiled.issue.Message)
+	}
+	return nil
+}
+
 // GetCurrentSchemaVersion returns the current schema URL from constants
 func GetCurrentSchemaVersion() (string, error) {
 	return model.CurrentSchemaURL, nil
@@ -53,99 +319,80 @@ func GetCurrentSchemaVersion() (string, error) {
 // If performValidation is true, performs full JSON Schema validation.
 // If performValidation is false, only checks for empty schema (always an error) and handles non-current schemas per policy.
 // nonCurrentPolicy determines how non-current (but valid) schema versions are handled when performValidation is true.
-func validateServerJSONSchema(serverJSON *apiv0.ServerJSON, performValidation bool, nonCurrentPolicy SchemaVersionPolicy) *ValidationResult {
+// If schemaOverride is set, it is validated against instead of the embedded schema, and its $id is the current version.
+func validateServerJSONSchema(serverJSON *apiv0.ServerJSON, performValidation bool, nonCurrentPolicy SchemaVersionPolicy, schemaOverride []byte, schemaRefDir string) *ValidationResult {
 	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
 	ctx := &ValidationContext{}
 
-	// Empty/missing schema is always an error
-	if serverJSON.Schema == "" {
-		issue := NewValidationIssue(
-			ValidationIssueTypeSemantic,
-			ctx.Field("schema").String(),
-			"$schema field is required",
-			ValidationIssueSeverityError,
-			"schema-field-required",
-		)
-		result.AddIssue(issue)
-		return result
+	// Stray whitespace, such as the \r of a Windows line ending, is not part of the URL
+	serverJSON, trimmed := trimSchemaURL(ctx, serverJSON)
+	if trimmed != nil {
+		result.AddIssue(*trimmed)
 	}
 
-	// Extract version from the schema URL
-	version, err := extractVersionFromSchemaURL(serverJSON.Schema)
-	if err != nil {
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			ctx.Field("schema").String(),
-			fmt.Sprintf("failed to extract schema version from URL: %v", err),
-			ValidationIssueSeverityError,
-			"schema-version-extraction-error",
-		)
-		result.AddIssue(issue)
+	version, issue := schemaURLVersion(ctx, serverJSON.Schema)
+	if issue != nil {
+		result.AddIssue(*issue)
 		return result
 	}
 
+	// A $schema on a foreign host names a version but need not be that version's schema
+	untrusted := schemaHostUntrustedIssue(ctx, serverJSON.Schema, schemaDocumentID(schemaOverride), nonCurrentPolicy)
+	if untrusted != nil {
+		result.AddIssue(*untrusted)
+	}
+
+	// A version released after this validator was built has no embedded schema, and its
+	// rules are unknown, so say so instead of reporting it as outdated or unknown
+	if schemaOverride == nil {
+		if issue := schemaVersionAheadIssue(ctx, version, nonCurrentPolicy); issue != nil {
+			result.AddIssue(*issue)
+			return result
+		}
+	}
+
 	// Check if the schema version is the current one and handle based on policy
 	currentSchemaURL, err := GetCurrentSchemaVersion()
-	if err == nil && serverJSON.Schema != currentSchemaURL {
-		// Extract current version for the message
-		currentVersion, _ := extractVersionFromSchemaURL(currentSchemaURL)
+	if id := schemaDocumentID(schemaOverride); id != "" {
+		currentSchemaURL = id
+	}
+	if err == nil && serverJSON.Schema != currentSchemaURL && untrusted == nil {
+		if issue := schemaVersionDeprecatedIssue(ctx, version, currentSchemaURL, nonCurrentPolicy); issue != nil {
+			result.AddIssue(*issue)
+		}
+	}
 
-		switch nonCurrentPolicy {
-		case SchemaVersionPolicyError:
+	// Load the appropriate schema file to verify it exists (required for schema version validation)
+	// This ensures that the specified schema version is available, even when not performing full validation.
+	// A supplied schema replaces the embedded one, so the declared version need not be embedded.
+	schemaData := schemaOverride
+	if schemaData == nil {
+		if schemaData, err = loadSchemaByVersion(version); err != nil {
 			issue := NewValidationIssue(
-				ValidationIssueTypeSemantic,
-				ctx.Field("schema").String(),
-				fmt.Sprintf("schema version %s is not the current version (%s). Use the current schema version", version, currentVersion),
+				ValidationIssueTypeSchema,
+				ctx.Field("$schema").String(),
+				fmt.Sprintf("schema version %s is unknown: %v. Check the version in $schema against the published schema versions", version, err),
 				ValidationIssueSeverityError,
-				"schema-version-deprecated",
-			)
-			result.AddIssue(issue)
-		case SchemaVersionPolicyWarn:
-			issue := NewValidationIssue(
-				ValidationIssueTypeSemantic,
-				ctx.Field("schema").String(),
-				fmt.Sprintf("schema version %s is not the current version (%s). Consider updating to the latest schema version", version, currentVersion),
-				ValidationIssueSeverityWarning,
-				"schema-version-deprecated",
+				"schema-version-unknown",
 			)
 			result.AddIssue(issue)
-		case SchemaVersionPolicyAllow:
-			// No issue added - allow non-current schemas silently
+			return result
 		}
 	}
 
-	// Load the appropriate schema file to verify it exists (required for schema version validation)
-	// This ensures that the specified schema version is available, even when not performing full validation
-	schemaData, err := loadSchemaByVersion(version)
-	if err != nil {
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			ctx.Field("schema").String(),
-			fmt.Sprintf("schema version %s not available: %v", version, err),
-			ValidationIssueSeverityError,
-			"schema-version-not-available",
-		)
-		result.AddIssue(issue)
-		return result
-	}
-
 	// If not performing validation, return after performing schema version checks (done above)
 	if !performValidation {
 		return result
 	}
 
-	// Parse the schema
-	var schema map[string]any
-	if err := json.Unmarshal(schemaData, &schema); err != nil {
-		// If we can't parse the schema, return an error
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			ctx.Field("schema").String(),
-			fmt.Sprintf("failed to parse schema file: %v", err),
-			ValidationIssueSeverityError,
-			"schema-parse-error",
-		)
-		result.AddIssue(issue)
+	var compiled *compiledSchema
+	if schemaOverride != nil {
+		compiled = compiledSchemaForDocument(schemaOverride, schemaRefDir)
+	} else {
+		compiled = compiledSchemaForVersion(version, schemaData, schemaRefDir)
+	}
+	if compiled.issue != nil {
+		result.AddIssue(*compiled.issue)
 		return result
 	}
 
@@ -176,58 +423,13 @@ func validateServerJSONSchema(serverJSON *apiv0.ServerJSON, performValidation bo
 		return result
 	}
 
-	// Get the schema $id for proper reference resolution
-	// Schema files must have $id (required by JSON Schema spec and verified by sync process)
-	// However, we check here in case a schema file exists but is malformed or missing $id
-	schemaID, ok := schema["$id"].(string)
-	if !ok {
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			ctx.Field("schema").String(),
-			fmt.Sprintf("schema file for version %s exists but is missing or has invalid $id field (required by JSON Schema spec)", version),
-			ValidationIssueSeverityError,
-			"schema-missing-id",
-		)
-		result.AddIssue(issue)
-		return result
-	}
-
-	// Validate against schema using jsonschema library
-	compiler := jsonschema.NewCompiler()
-	if err := compiler.AddResource(schemaID, bytes.NewReader(schemaData)); err != nil {
-		// If we can't add the schema resource, return an error
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			ctx.Field("schema").String(),
-			fmt.Sprintf("failed to add schema resource: %v", err),
-			ValidationIssueSeverityError,
-			"schema-resource-error",
-		)
-		result.AddIssue(issue)
-		return result
-	}
-
-	schemaInstance, err := compiler.Compile(schemaID)
-	if err != nil {
-		// If we can't compile the schema, return an error
-		issue := NewValidationIssue(
-			ValidationIssueTypeSchema,
-			"",
-			fmt.Sprintf("failed to compile schema: %v", err),
-			ValidationIssueSeverityError,
-			"schema-compile-error",
-		)
-		result.AddIssue(issue)
-		return result
-	}
-
 	// Perform validation
-	if err := schemaInstance.Validate(serverMap); err != nil {
+	if err := compiled.instance.Validate(serverMap); err != nil {
 		// Convert validation error to our issue format
 		var validationErr *jsonschema.ValidationError
 		if errors.As(err, &validationErr) {
 			// Process the validation error and its causes
-			addValidationError(result, validationErr, schema)
+			addValidationError(result, validationErr, compiled.schema)
 		} else {
 			// Fallback for other error types
 			issue := NewValidationIssue(
@@ -244,6 +446,177 @@ func validateServerJSONSchema(serverJSON *apiv0.ServerJSON, performValidation bo
 	return result
 }
 
+// schemaVersionDeprecatedIssue returns a schema-version-deprecated issue for version, which
+// is not that of currentSchemaURL, per nonCurrentPolicy, or nil if the policy allows it
+func schemaVersionDeprecatedIssue(ctx *ValidationContext, version, currentSchemaURL string, nonCurrentPolicy SchemaVersionPolicy) *ValidationIssue {
+	// Extract current version for the message
+	currentVersion, _ := extractVersionFromSchemaURL(currentSchemaURL)
+
+	switch nonCurrentPolicy {
+	case SchemaVersionPolicyError:
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("$schema").String(),
+			fmt.Sprintf("schema version %s is not the current version (%s). Use the current schema version", version, currentVersion),
+			ValidationIssueSeverityError,
+			"schema-version-deprecated",
+		)
+		return &issue
+	case SchemaVersionPolicyWarn:
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("$schema").String(),
+			fmt.Sprintf("schema version %s is not the current version (%s). Consider updating to the latest schema version", version, currentVersion),
+			ValidationIssueSeverityWarning,
+			"schema-version-deprecated",
+		)
+		return &issue
+	case SchemaVersionPolicyAllow:
+		// No issue - allow non-current schemas silently
+	}
+	return nil
+}
+
+// trimSchemaURL returns serverJSON with whitespace and control characters trimmed from
+// $schema, and a schema-url-whitespace issue if there were any. serverJSON itself is not
+// modified.
+func trimSchemaURL(ctx *ValidationContext, serverJSON *apiv0.ServerJSON) (*apiv0.ServerJSON, *ValidationIssue) {
+	schemaURL := strings.TrimFunc(serverJSON.Schema, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	})
+	if schemaURL == serverJSON.Schema {
+		return serverJSON, nil
+	}
+
+	trimmed := *serverJSON
+	trimmed.Schema = schemaURL
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.Field("$schema").String(),
+		fmt.Sprintf("$schema %q has leading or trailing whitespace or control characters, e.g. from Windows line endings. Use %q", serverJSON.Schema, schemaURL),
+		ValidationIssueSeverityWarning,
+		"schema-url-whitespace",
+	)
+	return &trimmed, &issue
+}
+
+// schemaURLVersion returns the version of the schema at schemaURL, or the issue that
+// prevents reading it: an empty $schema, one that is not a URL, or a URL without a version
+func schemaURLVersion(ctx *ValidationContext, schemaURL string) (string, *ValidationIssue) {
+	// Empty/missing schema is always an error
+	if schemaURL == "" {
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("$schema").String(),
+			"$schema field is required",
+			ValidationIssueSeverityError,
+			"schema-field-required",
+		)
+		return "", &issue
+	}
+
+	// A bare version or a relative path is not a URL to extract a version from
+	if issue := schemaNotURLIssue(ctx, schemaURL); issue != nil {
+		return "", issue
+	}
+
+	// Extract version from the schema URL
+	version, err := extractVersionFromSchemaURL(schemaURL)
+	if err != nil {
+		issue := NewValidationIssue(
+			ValidationIssueTypeSchema,
+			ctx.Field("$schema").String(),
+			fmt.Sprintf("failed to extract schema version from URL: %v", err),
+			ValidationIssueSeverityError,
+			"schema-version-extraction-error",
+		)
+		return "", &issue
+	}
+	return version, nil
+}
+
+// schemaNotURLIssue returns a schema-not-a-url issue if schemaURL is not an absolute http(s)
+// URL, such as a bare version like 2025-12-11 or a relative path to a schema file, and nil
+// otherwise
+func schemaNotURLIssue(ctx *ValidationContext, schemaURL string) *ValidationIssue {
+	u, err := url.Parse(schemaURL)
+	if err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "" {
+		return nil
+	}
+	issue := NewValidationIssue(
+		ValidationIssueTypeSchema,
+		ctx.Field("$schema").String(),
+		fmt.Sprintf("$schema must be the URL of a schema, not %q. Use %s", schemaURL, model.CurrentSchemaURL),
+		ValidationIssueSeverityError,
+		"schema-not-a-url",
+	)
+	return &issue
+}
+
+// schemaHostUntrustedIssue returns a schema-host-untrusted issue if schemaURL is not on the
+// host of the official schemas, or of trustedID, the $id of a supplied schema, and nil
+// otherwise. It is an error where non-current versions are, as when publishing, a warning
+// where they are warned about, and not reported where they are allowed.
+func schemaHostUntrustedIssue(ctx *ValidationContext, schemaURL, trustedID string, nonCurrentPolicy SchemaVersionPolicy) *ValidationIssue {
+	host := schemaURLHost(schemaURL)
+	if host != "" && (host == schemaURLHost(model.CurrentSchemaURL) || host == schemaURLHost(trustedID)) {
+		return nil
+	}
+
+	if nonCurrentPolicy == SchemaVersionPolicyAllow {
+		return nil
+	}
+	severity := ValidationIssueSeverityWarning
+	if nonCurrentPolicy == SchemaVersionPolicyError {
+		severity = ValidationIssueSeverityError
+	}
+	shown := host
+	if shown == "" {
+		shown = "no host"
+	}
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.Field("$schema").String(),
+		fmt.Sprintf("$schema is on %s, not %s, so it may not be the schema it names. Use %s", shown, schemaURLHost(model.CurrentSchemaURL), model.CurrentSchemaURL),
+		severity,
+		"schema-host-untrusted",
+	)
+	return &issue
+}
+
+// schemaURLHost returns the lowercased host name of schemaURL, or "" if it has none
+func schemaURLHost(schemaURL string) string {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// schemaDatePattern matches dated schema versions, which sort chronologically as strings
+var schemaDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// schemaVersionAheadIssue returns a schema-version-ahead issue if version is a dated schema
+// version newer than model.CurrentSchemaVersion, and nil otherwise. It is an error where
+// non-current versions are, as when publishing, and a warning otherwise.
+func schemaVersionAheadIssue(ctx *ValidationContext, version string, nonCurrentPolicy SchemaVersionPolicy) *ValidationIssue {
+	if !schemaDatePattern.MatchString(version) || version <= model.CurrentSchemaVersion {
+		return nil
+	}
+	severity := ValidationIssueSeverityWarning
+	if nonCurrentPolicy == SchemaVersionPolicyError {
+		severity = ValidationIssueSeverityError
+	}
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.Field("$schema").String(),
+		fmt.Sprintf("schema version %s is newer than %s, the newest version this validator supports, so server.json was not validated against its schema. Upgrade mcp-publisher to validate it", version, model.CurrentSchemaVersion),
+		severity,
+		"schema-version-ahead",
+	)
+	return &issue
+}
+
 // addValidationError processes validation errors and extracts useful information
 func addValidationError(result *ValidationResult, validationErr *jsonschema.ValidationError, schema map[string]any) {
 	// Use DetailedOutput to get the nested error details
@@ -312,12 +685,40 @@ func ConvertJSONPointerToBracketNotation(jsonPointer string) string {
 	return result.String()
 }
 
+// ConvertBracketNotationToJSONPointer converts a path in the bracket notation of earlier
+// releases, e.g. "packages[0].transport.url", to a JSON pointer (RFC 6901) such as
+// "/packages/0/transport/url". Paths that already are JSON pointers are returned as they are,
+// so issues from older registries and saved results can be compared with current ones.
+func ConvertBracketNotationToJSONPointer(path string) string {
+	if path == "" || strings.HasPrefix(path, "/") {
+		return path
+	}
+
+	var pointer, token strings.Builder
+	flush := func() {
+		if token.Len() > 0 {
+			pointer.WriteString(JSONPointerChild("", token.String()))
+			token.Reset()
+		}
+	}
+	for _, r := range path {
+		switch r {
+		case '.', '[', ']':
+			flush()
+		default:
+			token.WriteRune(r)
+		}
+	}
+	flush()
+	return pointer.String()
+}
+
 // addDetailedErrors recursively processes detailed validation errors
 func addDetailedErrors(result *ValidationResult, detailed jsonschema.Detailed, schema map[string]any) {
 	// Only process errors that have specific field paths and meaningful messages
 	if detailed.InstanceLocation != "" && detailed.Error != "" {
-		// Convert JSON Pointer format to bracket notation to match semantic validation format
-		path := ConvertJSONPointerToBracketNotation(detailed.InstanceLocation)
+		// InstanceLocation is a JSON pointer, the format of semantic validation paths
+		path := detailed.InstanceLocation
 
 		// Clean up the error message
 		message := detailed.Error
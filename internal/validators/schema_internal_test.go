@@ -0,0 +1,134 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// resetCompiledSchemas forgets every compiled schema, as in a fresh process
+func resetCompiledSchemas() {
+	compiledSchemasMu.Lock()
+	defer compiledSchemasMu.Unlock()
+	compiledSchemas = map[string]*compiledSchema{}
+}
+
+var benchmarkServerJSON = apiv0.ServerJSON{
+	Schema:      model.CurrentSchemaURL,
+	Name:        "com.example/test-server",
+	Description: "A test server",
+	Version:     "1.0.0",
+	Packages: []model.Package{
+		{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@example/test-server",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: model.TransportTypeStdio},
+		},
+	},
+}
+
+func TestWarmUpCompilesOnce(t *testing.T) {
+	resetCompiledSchemas()
+	t.Cleanup(resetCompiledSchemas)
+
+	if err := WarmUp(); err != nil {
+		t.Fatal(err)
+	}
+	first := compiledSchemas[model.CurrentSchemaVersion]
+	if err := WarmUp(); err != nil {
+		t.Fatal(err)
+	}
+	ValidateServerJSON(&benchmarkServerJSON, ValidationAll)
+
+	if compiledSchemas[model.CurrentSchemaVersion] != first {
+		t.Fatal("the current schema should only be compiled once")
+	}
+}
+
+// BenchmarkValidateServerJSON_Cold measures validation in a fresh process, including
+// compiling the schema
+func BenchmarkValidateServerJSON_Cold(b *testing.B) {
+	b.Cleanup(resetCompiledSchemas)
+	for b.Loop() {
+		resetCompiledSchemas()
+		ValidateServerJSON(&benchmarkServerJSON, ValidationAll)
+	}
+}
+
+// BenchmarkValidateServerJSON_Warm measures validation after WarmUp
+func BenchmarkValidateServerJSON_Warm(b *testing.B) {
+	if err := WarmUp(); err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		ValidateServerJSON(&benchmarkServerJSON, ValidationAll)
+	}
+}
+
+// dialectTestSchema declares a string with a maxLength next to a $ref. Draft-07 ignores
+// keywords beside $ref, while 2019-09 and later apply them.
+func dialectTestSchema(dialect string) []byte {
+	schemaLine := ""
+	if dialect != "" {
+		schemaLine = `"$schema": "` + dialect + `",`
+	}
+	return []byte(`{
+		` + schemaLine + `
+		"$id": "https://example.com/dialect-test.schema.json",
+		"definitions": {"name": {"type": "string"}},
+		"$ref": "#/definitions/name",
+		"maxLength": 3
+	}`)
+}
+
+func TestCompiledSchemaDialect(t *testing.T) {
+	tests := []struct {
+		name          string
+		dialect       string
+		longNameValid bool
+	}{
+		{"draft-07", "http://json-schema.org/draft-07/schema#", true},
+		{"draft-07 over https", "https://json-schema.org/draft-07/schema", true},
+		{"undeclared defaults to draft-07", "", true},
+		{"2020-12", "https://json-schema.org/draft/2020-12/schema", false},
+		{"2019-09", "https://json-schema.org/draft/2019-09/schema", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var compiled compiledSchema
+			compiled.compile("test", dialectTestSchema(tt.dialect), "")
+			if compiled.issue != nil {
+				t.Fatalf("unexpected issue: %s", compiled.issue.Message)
+			}
+
+			if err := compiled.instance.Validate("abc"); err != nil {
+				t.Errorf("short name should be valid: %v", err)
+			}
+			err := compiled.instance.Validate("abcdef")
+			if tt.longNameValid && err != nil {
+				t.Errorf("maxLength beside $ref should be ignored by %s: %v", tt.name, err)
+			}
+			if !tt.longNameValid && err == nil {
+				t.Errorf("maxLength beside $ref should apply under %s", tt.name)
+			}
+		})
+	}
+
+	t.Run("unsupported dialect", func(t *testing.T) {
+		var compiled compiledSchema
+		compiled.compile("test", dialectTestSchema("http://json-schema.org/draft-03/schema#"), "")
+		if compiled.issue == nil {
+			t.Fatal("expected an issue for an unsupported dialect")
+		}
+		if compiled.issue.Reference != "schema-dialect-unsupported" {
+			t.Errorf("reference = %q, want schema-dialect-unsupported", compiled.issue.Reference)
+		}
+		if !strings.Contains(compiled.issue.Message, `unsupported JSON Schema dialect "http://json-schema.org/draft-03/schema#"`) {
+			t.Errorf("unexpected message: %s", compiled.issue.Message)
+		}
+	})
+}
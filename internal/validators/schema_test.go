@@ -1,10 +1,17 @@
 package validators_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConvertJSONPointerToBracketNotation(t *testing.T) {
@@ -119,3 +126,343 @@ func TestConvertJSONPointerToBracketNotation(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaVersions(t *testing.T) {
+	versions := validators.SchemaVersions()
+	assert.Contains(t, versions, model.CurrentSchemaVersion)
+	assert.Contains(t, versions, "2025-07-09")
+	assert.IsIncreasing(t, versions)
+}
+
+func TestValidateServerJSONForSchemaVersion(t *testing.T) {
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Packages: []model.Package{
+			{
+				RegistryType: model.RegistryTypeNPM,
+				Identifier:   "@example/test-server",
+				Version:      "1.0.0",
+				Transport:    model.Transport{Type: model.TransportTypeStdio},
+			},
+		},
+	}
+
+	current := validators.ValidateServerJSONForSchemaVersion(&serverJSON, model.CurrentSchemaVersion, validators.ValidationAll)
+	assert.True(t, current.Valid, "document should be valid under the current schema: %v", current.Issues)
+
+	// The 2025-07-09 schema used snake_case field names
+	legacy := validators.ValidateServerJSONForSchemaVersion(&serverJSON, "2025-07-09", validators.ValidationAll)
+	assert.False(t, legacy.Valid)
+	for _, issue := range legacy.Issues {
+		assert.NotEqual(t, "schema-version-deprecated", issue.Reference)
+	}
+
+	unknown := validators.ValidateServerJSONForSchemaVersion(&serverJSON, "1999-01-01", validators.ValidationAll)
+	assert.False(t, unknown.Valid)
+	assert.Equal(t, "schema-version-unknown", unknown.Issues[0].Reference)
+}
+
+func TestValidateServerJSON_SchemaVersionAhead(t *testing.T) {
+	serverJSON := apiv0.ServerJSON{
+		Schema:      "https://static.modelcontextprotocol.io/schemas/2099-01-01/server.schema.json",
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+
+	result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+	assert.True(t, result.Valid, "a newer schema version is a warning: %v", result.Issues)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "schema-version-ahead", result.Issues[0].Reference)
+	assert.Equal(t, validators.ValidationIssueSeverityWarning, result.Issues[0].Severity)
+	assert.Equal(t, "/$schema", result.Issues[0].Path)
+	assert.Contains(t, result.Issues[0].Message, "Upgrade mcp-publisher")
+
+	// Publishing requires the current schema version
+	result = validators.ValidateServerJSON(&serverJSON, validators.ValidationSchemaVersionOnly)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "schema-version-ahead", result.Issues[0].Reference)
+	assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+
+	// Older versions that are not embedded are unknown
+	serverJSON.Schema = "https://static.modelcontextprotocol.io/schemas/1999-01-01/server.schema.json"
+	result = validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+	assert.False(t, result.Valid)
+	references := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		references = append(references, issue.Reference)
+	}
+	assert.Contains(t, references, "schema-version-unknown")
+	assert.NotContains(t, references, "schema-version-ahead")
+}
+
+func TestValidateServerJSON_SchemaHostAndVersion(t *testing.T) {
+	serverJSON := apiv0.ServerJSON{
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	references := func(result *validators.ValidationResult) []string {
+		refs := make([]string, 0, len(result.Issues))
+		for _, issue := range result.Issues {
+			refs = append(refs, issue.Reference)
+		}
+		return refs
+	}
+	foreignURL := strings.Replace(model.CurrentSchemaURL, "static.modelcontextprotocol.io", "schemas.example.com", 1)
+
+	t.Run("untrusted host with known version", func(t *testing.T) {
+		serverJSON := serverJSON
+		serverJSON.Schema = foreignURL
+		result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+		assert.True(t, result.Valid, "an untrusted host is a warning: %v", result.Issues)
+		assert.Equal(t, []string{"schema-host-untrusted"}, references(result))
+		assert.Equal(t, "/$schema", result.Issues[0].Path)
+		assert.Contains(t, result.Issues[0].Message, "schemas.example.com")
+
+		// Publishing requires the official host
+		result = validators.ValidateServerJSON(&serverJSON, validators.ValidationSchemaVersionOnly)
+		assert.False(t, result.Valid)
+		assert.Equal(t, []string{"schema-host-untrusted"}, references(result))
+		assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+	})
+
+	t.Run("trusted host with unknown version", func(t *testing.T) {
+		serverJSON := serverJSON
+		serverJSON.Schema = "https://static.modelcontextprotocol.io/schemas/1999-01-01/server.schema.json"
+		result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+		assert.False(t, result.Valid)
+		assert.Contains(t, references(result), "schema-version-unknown")
+		assert.NotContains(t, references(result), "schema-host-untrusted")
+	})
+
+	t.Run("untrusted host with unknown version", func(t *testing.T) {
+		serverJSON := serverJSON
+		serverJSON.Schema = "https://schemas.example.com/schemas/1999-01-01/server.schema.json"
+		result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+		assert.Equal(t, []string{"schema-host-untrusted", "schema-version-unknown"}, references(result))
+	})
+
+	t.Run("host of the supplied schema is trusted", func(t *testing.T) {
+		serverJSON := serverJSON
+		serverJSON.Schema = foreignURL
+		opts := validators.ValidationAll
+		opts.Schema = []byte(`{"$id": "` + foreignURL + `", "$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`)
+		result := validators.ValidateServerJSON(&serverJSON, opts)
+		assert.Empty(t, result.Issues)
+	})
+}
+
+func TestValidateServerJSON_SchemaNotAURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"bare version", "2025-12-11"},
+		{"bare numeric version", "1.0"},
+		{"relative path", "./schemas/2025-12-11/server.schema.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      tt.schema,
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+			}
+			result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "schema-not-a-url", result.Issues[0].Reference)
+			assert.Equal(t, "/$schema", result.Issues[0].Path)
+			assert.Contains(t, result.Issues[0].Message, model.CurrentSchemaURL)
+		})
+	}
+
+	t.Run("full URL", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+		}
+		result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+		assert.True(t, result.Valid, "unexpected issues: %v", result.Issues)
+		assert.Empty(t, result.Issues)
+	})
+}
+
+func TestValidateServerJSON_SchemaURLWhitespace(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"trailing carriage return", model.CurrentSchemaURL + "\r"},
+		{"surrounding spaces", "  " + model.CurrentSchemaURL + " "},
+		{"spaces and carriage return", model.CurrentSchemaURL + " \r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      tt.schema,
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+			}
+			result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+			assert.True(t, result.Valid, "whitespace in $schema is a warning: %v", result.Issues)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "schema-url-whitespace", result.Issues[0].Reference)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, result.Issues[0].Severity)
+			assert.Equal(t, "/$schema", result.Issues[0].Path)
+			assert.Equal(t, tt.schema, serverJSON.Schema, "the server.json should not be modified")
+		})
+	}
+
+	t.Run("no whitespace", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+		}
+		result := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+		assert.Empty(t, result.Issues)
+	})
+}
+
+func TestValidateServerJSON_SuppliedSchema(t *testing.T) {
+	// A schema version newer than any embedded one, as served by an updated registry
+	const newerSchemaURL = "https://static.modelcontextprotocol.io/schemas/2099-01-01/server.schema.json"
+	schema := `{
+		"$id": "` + newerSchemaURL + `",
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "object",
+		"properties": {"version": {"type": "string", "maxLength": 3}}
+	}`
+	opts := validators.ValidationAll
+	opts.Schema = []byte(schema)
+
+	serverJSON := apiv0.ServerJSON{
+		Schema:      newerSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	result := validators.ValidateServerJSON(&serverJSON, opts)
+	assert.True(t, result.Valid, "the supplied schema's version need not be embedded: %v", result.Issues)
+	assert.Empty(t, result.Issues, "the supplied schema's $id is the current version")
+
+	serverJSON.Version = "1.0.0"
+	result = validators.ValidateServerJSON(&serverJSON, opts)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "/version", result.Issues[0].Path)
+
+	// The embedded schemas are unaffected
+	serverJSON.Schema = model.CurrentSchemaURL
+	result = validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+	assert.True(t, result.Valid, "%v", result.Issues)
+}
+
+func TestValidateServerJSON_SchemaExternalRefs(t *testing.T) {
+	const schemaURL = "https://static.modelcontextprotocol.io/schemas/2099-01-01/server.schema.json"
+	const versionRef = "https://schemas.example.com/common/version.json"
+	schema := `{
+		"$id": "` + schemaURL + `",
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "object",
+		"properties": {"version": {"$ref": "` + versionRef + `"}}
+	}`
+	versionSchema := `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "string", "maxLength": 3}`
+
+	serverJSON := apiv0.ServerJSON{
+		Schema:      schemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	validate := func(refDir string) *validators.ValidationResult {
+		opts := validators.ValidationAll
+		opts.Schema = []byte(schema)
+		opts.SchemaRefDir = refDir
+		return validators.ValidateServerJSON(&serverJSON, opts)
+	}
+
+	t.Run("no ref dir", func(t *testing.T) {
+		result := validate("")
+		assert.False(t, result.Valid)
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "schema-external-ref-unresolved", result.Issues[0].Reference)
+		assert.Contains(t, result.Issues[0].Message, versionRef)
+		assert.Contains(t, result.Issues[0].Message, "--schema-ref-dir")
+	})
+
+	t.Run("unresolvable", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "other.json"), []byte(versionSchema), 0600))
+		result := validate(dir)
+		assert.False(t, result.Valid)
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "schema-external-ref-unresolved", result.Issues[0].Reference)
+		assert.Contains(t, result.Issues[0].Message, versionRef)
+		assert.Contains(t, result.Issues[0].Message, filepath.Join(dir, "schemas.example.com", "common", "version.json"))
+		assert.Contains(t, result.Issues[0].Message, filepath.Join(dir, "version.json"))
+	})
+
+	t.Run("resolved by host and path", func(t *testing.T) {
+		dir := t.TempDir()
+		refDir := filepath.Join(dir, "schemas.example.com", "common")
+		require.NoError(t, os.MkdirAll(refDir, 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(refDir, "version.json"), []byte(versionSchema), 0600))
+		result := validate(dir)
+		assert.False(t, result.Valid, "the referenced schema should apply")
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "/version", result.Issues[0].Path)
+	})
+
+	t.Run("resolved by file name", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "version.json"), []byte(versionSchema), 0600))
+		serverJSON.Version = "1.0"
+		defer func() { serverJSON.Version = "1.0.0" }()
+		result := validate(dir)
+		assert.True(t, result.Valid, "%v", result.Issues)
+	})
+}
+
+func TestWarmUp(t *testing.T) {
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "^1.0.0",
+	}
+	before := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			assert.NoError(t, validators.WarmUp())
+		})
+	}
+	wg.Wait()
+	require.NoError(t, validators.WarmUp(), "calling WarmUp again should be harmless")
+
+	after := validators.ValidateServerJSON(&serverJSON, validators.ValidationAll)
+	assert.Equal(t, before, after, "warming up must not change validation results")
+}
@@ -1,9 +1,13 @@
 package validators
 
 import (
+	"fmt"
 	"net/url"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
 )
 
 var (
@@ -25,6 +29,117 @@ func IsValidRepositoryURL(source RepositorySource, url string) bool {
 	return false
 }
 
+// repositoryOwner returns the owner (user, organization or top-level group) of a
+// repository URL, e.g. "https://github.com/owner/repo" returns "owner"
+func repositoryOwner(repoURL string) string {
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return ""
+	}
+	owner, _, _ := strings.Cut(strings.TrimPrefix(parsed.Path, "/"), "/")
+	return owner
+}
+
+// packageOwner returns the owner implied by a package identifier, or "" if the
+// identifier does not carry one. Supported: npm scopes ("@owner/name") and
+// GitHub Container Registry images ("ghcr.io/owner/image").
+func packageOwner(pkg *model.Package) string {
+	switch pkg.RegistryType {
+	case model.RegistryTypeNPM:
+		if scope, _, found := strings.Cut(pkg.Identifier, "/"); found && strings.HasPrefix(scope, "@") {
+			return strings.TrimPrefix(scope, "@")
+		}
+	case model.RegistryTypeOCI:
+		if rest, found := strings.CutPrefix(pkg.Identifier, "ghcr.io/"); found {
+			owner, _, _ := strings.Cut(rest, "/")
+			return owner
+		}
+	}
+	return ""
+}
+
+// ownersPlausiblyMatch compares two owner names loosely: case, dashes, underscores and
+// dots are ignored, and one name containing the other (e.g. "acme" and "acme-labs") counts as a match.
+func ownersPlausiblyMatch(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.NewReplacer("-", "", "_", "", ".", "").Replace(strings.ToLower(s))
+	}
+	a, b = normalize(a), normalize(b)
+	if a == "" || b == "" {
+		return true
+	}
+	return strings.Contains(a, b) || strings.Contains(b, a)
+}
+
+// genericNameTokens are words too common in server names and package identifiers to
+// show that they belong together, including placeholders such as "example" and "test"
+var genericNameTokens = map[string]bool{
+	"mcp": true, "server": true, "servers": true, "com": true, "org": true, "net": true,
+	"dev": true, "app": true, "www": true, "github": true, "gitlab": true, "ghcr": true,
+	"docker": true, "npm": true, "pypi": true, "nuget": true,
+	"example": true, "test": true, "demo": true,
+}
+
+// nameTokens splits a server name or package identifier into lowercase words, dropping
+// words shorter than three characters and generic ones such as "mcp" or "server",
+// e.g. "@acme/mcp-weather-server" returns ["acme", "weather"]
+func nameTokens(s string) []string {
+	var tokens []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(word) >= 3 && !genericNameTokens[word] {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// upperSnakeCase converts an environment variable name such as api-key, apiKey or
+// api.key to API_KEY
+func upperSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == '.' || r == ' ':
+			b.WriteRune('_')
+		case unicode.IsUpper(r) && i > 0 && unicode.IsLower(runes[i-1]):
+			b.WriteRune('_')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// normalizeWords lowercases s and joins its words with single spaces, dropping
+// punctuation, e.g. "Acme_Weather-Server." returns "acme weather server"
+func normalizeWords(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// tokensPlausiblyMatch reports whether any word of a occurs in b or the other way around,
+// e.g. "weather" and "weatherapi", or whether one side's words joined together occur in
+// the other's, e.g. "foo bar" and "foobar"
+func tokensPlausiblyMatch(a, b []string) bool {
+	joinedA, joinedB := strings.Join(a, ""), strings.Join(b, "")
+	if joinedA != "" && joinedB != "" && (strings.Contains(joinedA, joinedB) || strings.Contains(joinedB, joinedA)) {
+		return true
+	}
+	for _, tokenA := range a {
+		for _, tokenB := range b {
+			if strings.Contains(tokenA, tokenB) || strings.Contains(tokenB, tokenA) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // HasNoSpaces checks if a string contains no spaces
 func HasNoSpaces(s string) bool {
 	return !strings.Contains(s, " ")
@@ -45,6 +160,28 @@ func extractTemplateVariables(url string) []string {
 	return variables
 }
 
+// argumentPlaceholderRe matches a {identifier} placeholder of an argument value or default,
+// or one left unclosed at the end of the string
+var argumentPlaceholderRe = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_-]*)(\}|$)`)
+
+// checkArgumentPlaceholders checks the {identifier} placeholders of an argument value or
+// default, which are replaced by the variables of the same name. It reports the first
+// placeholder that is not closed, or that is not declared in variables and so would be
+// passed through unchanged. Other braces, such as those of a JSON value, are literal text
+// and not checked.
+func checkArgumentPlaceholders(s string, variables map[string]model.Input) error {
+	for _, match := range argumentPlaceholderRe.FindAllStringSubmatchIndex(s, -1) {
+		identifier := s[match[2]:match[3]]
+		if match[4] == match[5] {
+			return fmt.Errorf("placeholder {%s at offset %d is not closed", identifier, match[0])
+		}
+		if _, ok := variables[identifier]; !ok {
+			return fmt.Errorf("placeholder {%s} is not declared in variables, so it is passed through unchanged", identifier)
+		}
+	}
+	return nil
+}
+
 // replaceTemplateVariables replaces template variables with placeholder values for URL validation
 func replaceTemplateVariables(rawURL string) string {
 	// Replace common template variables with valid placeholder values for parsing
@@ -131,6 +268,12 @@ func IsValidSubfolderPath(path string) bool {
 	return true
 }
 
+// isLocalhost reports whether hostname refers to the local machine
+func isLocalhost(hostname string) bool {
+	hostname = strings.ToLower(hostname)
+	return hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") || hostname == "127.0.0.1" || hostname == "::1"
+}
+
 // IsValidRemoteURL checks if a URL is valid for remotes (stricter than packages - no localhost allowed)
 func IsValidRemoteURL(rawURL string) bool {
 	// First check basic URL structure
@@ -1,6 +1,8 @@
 package validators_test
 
 import (
+	"encoding/json"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -8,9 +10,10 @@ import (
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/modelcontextprotocol/registry/pkg/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-const schemaPath = "schema"
+const schemaPath = "/$schema"
 
 func TestValidateServerJSON_CollectsAllErrors(t *testing.T) {
 	// Create a server JSON with multiple validation errors
@@ -80,16 +83,16 @@ func TestValidateServerJSON_CollectsAllErrors(t *testing.T) {
 
 	// Check for expected issue paths
 	expectedPaths := []string{
-		"name",
-		"version",
-		"repository.url",
-		"websiteUrl",
-		"packages[0].identifier",
-		"packages[0].version",
-		"packages[0].transport.url",
-		"packages[0].runtimeArguments[0].name",
-		"remotes[0].type",
-		"remotes[0].url",
+		"/name",
+		"/version",
+		"/repository/url",
+		"/websiteUrl",
+		"/packages/0/identifier",
+		"/packages/0/version",
+		"/packages/0/transport/url",
+		"/packages/0/runtimeArguments/0/name",
+		"/remotes/0/type",
+		"/remotes/0/url",
 	}
 
 	foundPaths := 0
@@ -102,6 +105,36 @@ func TestValidateServerJSON_CollectsAllErrors(t *testing.T) {
 	assert.Greater(t, foundPaths, 5, "Should have issues at multiple JSON paths")
 }
 
+func TestValidateServerJSONStream(t *testing.T) {
+	serverJSON := &apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "invalid-name",
+		Version:     "^1.0.0",
+		Description: "Test server",
+		WebsiteURL:  "ftp://invalid-scheme.com",
+		Remotes: []model.Transport{
+			{Type: model.TransportTypeStdio},
+		},
+	}
+
+	var streamed []validators.ValidationIssue
+	validators.ValidateServerJSONStream(serverJSON, validators.ValidationSchemaVersionAndSemantic, func(issue validators.ValidationIssue) {
+		streamed = append(streamed, issue)
+	})
+
+	paths := make([]string, 0, len(streamed))
+	for _, issue := range streamed {
+		paths = append(paths, issue.Path)
+	}
+	assert.Equal(t, []string{"/name", "/version", "/websiteUrl", "/remotes/0/type"}, paths,
+		"each issue should be streamed once, in validation order")
+
+	// ValidateServerJSON collects the same issues in the same order
+	result := validators.ValidateServerJSON(serverJSON, validators.ValidationSchemaVersionAndSemantic)
+	assert.Equal(t, result.Issues, streamed)
+	assert.False(t, result.Valid)
+}
+
 func TestValidateServerJSON_ValidServer(t *testing.T) {
 	// Create a valid server JSON
 	serverJSON := &apiv0.ServerJSON{
@@ -178,8 +211,84 @@ func TestValidateServerJSON_ContextPaths(t *testing.T) {
 	}
 
 	// Should have issues at specific nested paths
-	assert.True(t, issuePaths["packages[0].version"], "Should have issue at packages[0].version")
-	assert.True(t, issuePaths["packages[1].runtimeArguments[0].name"], "Should have issue at packages[1].runtimeArguments[0].name")
+	assert.True(t, issuePaths["/packages/0/version"], "Should have issue at /packages/0/version")
+	assert.True(t, issuePaths["/packages/1/runtimeArguments/0/name"], "Should have issue at /packages/1/runtimeArguments/0/name")
+}
+
+func TestValidateServerJSON_PathsAreJSONPointers(t *testing.T) {
+	// A document with schema, semantic and linter issues at many depths
+	serverJSON := &apiv0.ServerJSON{
+		Schema:      "https://static.modelcontextprotocol.io/schemas/2025-07-09/server.schema.json",
+		Name:        "invalid name",
+		Version:     "^1.0.0",
+		Description: "Test server",
+		WebsiteURL:  "http://example.com",
+		Repository:  &model.Repository{URL: "not a url", Source: "github"},
+		Packages: []model.Package{
+			{
+				RegistryType: model.RegistryTypeNPM,
+				Identifier:   "@globex/weather-mcp",
+				Version:      "latest",
+				Transport: model.Transport{
+					Type:    model.TransportTypeStdio,
+					Headers: []model.KeyValueInput{{Name: "Authorization"}},
+				},
+				RuntimeArguments: []model.Argument{
+					{Type: model.ArgumentTypeNamed, Name: "invalid name"},
+					{
+						Type:               model.ArgumentTypePositional,
+						InputWithVariables: model.InputWithVariables{Input: model.Input{Value: "{port"}},
+					},
+				},
+				EnvironmentVariables: []model.KeyValueInput{{Name: ""}},
+			},
+		},
+		Remotes: []model.Transport{{Type: "invalid", URL: "http://example.com/mcp"}},
+	}
+
+	result := validators.ValidateServerJSON(serverJSON, validators.ValidationAll)
+	require.Greater(t, len(result.Issues), 5)
+
+	data, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	var document any
+	require.NoError(t, json.Unmarshal(data, &document))
+
+	for _, issue := range result.Issues {
+		assert.True(t, resolvesJSONPointer(document, issue.Path),
+			"path %q of %s issue %q should be a JSON pointer into the document", issue.Path, issue.Type, issue.Message)
+	}
+}
+
+// resolvesJSONPointer reports whether pointer (RFC 6901) refers to a value in document
+func resolvesJSONPointer(document any, pointer string) bool {
+	if pointer == "" {
+		return true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return false
+	}
+	value := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch v := value.(type) {
+		case map[string]any:
+			child, ok := v[token]
+			if !ok {
+				return false
+			}
+			value = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return false
+			}
+			value = v[i]
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 func TestValidateServerJSON_RefResolution(t *testing.T) {
@@ -234,11 +343,11 @@ func TestValidateServerJSON_RefResolution(t *testing.T) {
 			assert.NotContains(t, issue.Reference, "[$ref]", "Found unresolved $ref segment in reference: %s", issue.Reference)
 
 			// Check for exact resolved paths we expect
-			if issue.Path == "repository.url" {
+			if issue.Path == "/repository/url" {
 				expectedRef := "#/definitions/Repository/properties/url/format from: [#/definitions/ServerDetail]/properties/repository/[#/definitions/Repository]/properties/url/format"
 				assert.Equal(t, expectedRef, issue.Reference, "Repository URL error should have exact resolved reference")
 			}
-			if issue.Path == "packages[0].packageArguments[0].format" {
+			if issue.Path == "/packages/0/packageArguments/0/format" {
 				// The schema uses anyOf for Argument types, so it could match either PositionalArgument or NamedArgument
 				// Just check that it contains the expected definitions
 				assert.Contains(t, issue.Reference, "#/definitions/Input/properties/format/enum", "Should reference the Input format enum")
@@ -256,8 +365,8 @@ func TestValidateServerJSON_RefResolution(t *testing.T) {
 	}
 
 	// Should have issues at specific paths that trigger $ref resolution
-	assert.True(t, issuePaths["repository.url"], "Should have issue at repository.url")
-	assert.True(t, issuePaths["packages[0].packageArguments[0].format"], "Should have issue at packages[0].packageArguments[0].format")
+	assert.True(t, issuePaths["/repository/url"], "Should have issue at /repository/url")
+	assert.True(t, issuePaths["/packages/0/packageArguments/0/format"], "Should have issue at /packages/0/packageArguments/0/format")
 }
 
 func TestValidateServerJSON_EmptySchema(t *testing.T) {
@@ -1,6 +1,11 @@
 package validators
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
 
 // Validation issue type with constrained values
 type ValidationIssueType string
@@ -10,6 +15,7 @@ const (
 	ValidationIssueTypeSchema   ValidationIssueType = "schema"
 	ValidationIssueTypeSemantic ValidationIssueType = "semantic"
 	ValidationIssueTypeLinter   ValidationIssueType = "linter"
+	ValidationIssueTypeFetch    ValidationIssueType = "fetch"
 )
 
 // Validation issue severity with constrained values
@@ -40,6 +46,13 @@ type ValidationOptions struct {
 	ValidateSchema         bool                // Perform full schema validation (implies ValidateSchemaVersion)
 	ValidateSemantic       bool                // Perform semantic validation
 	NonCurrentSchemaPolicy SchemaVersionPolicy // Policy for non-current schemas (only used when schema validation is performed)
+	Schema                 []byte              // Schema document to validate against instead of the embedded one for $schema's version; its $id is the current version
+	MaxPackages            int                 // Warn with too-many-packages above this many packages; 0 uses DefaultMaxPackages
+	MaxArguments           int                 // Fail with arguments-too-many above this many arguments in a package; 0 uses DefaultMaxArguments
+	MaxServerJSONSize      int                 // Fail with server-json-too-large above this many bytes of serialized server.json; 0 uses DefaultMaxServerJSONSize
+	MaxNameLength          int                 // Fail with name-too-long above this many characters of the name; 0 uses DefaultMaxNameLength
+	EnvNamePattern         *regexp.Regexp      // Warn with env-name-nonstandard about environment variable names it does not match; nil uses DefaultEnvNamePattern
+	SchemaRefDir           string              // Directory to resolve external $refs of the schema from; they are never fetched
 }
 
 // Common validation configurations
@@ -73,7 +86,7 @@ var (
 // ValidationIssue represents a single validation problem
 type ValidationIssue struct {
 	Type      ValidationIssueType     `json:"type"`
-	Path      string                  `json:"path"`    // JSON path like "packages[0].transport.url"
+	Path      string                  `json:"path"`    // RFC 6901 JSON pointer like "/packages/0/transport/url"; "" is the whole document
 	Message   string                  `json:"message"` // Error description (extracted from error.Error())
 	Severity  ValidationIssueSeverity `json:"severity"`
 	Reference string                  `json:"reference"` // Reference to validation trigger (schema rule path, named rule, etc.)
@@ -83,9 +96,12 @@ type ValidationIssue struct {
 type ValidationResult struct {
 	Valid  bool              `json:"valid"`
 	Issues []ValidationIssue `json:"issues"`
+	// Truncated is set when Issues was cut short, e.g. by the validate endpoint's maximum.
+	// Valid still reflects every issue.
+	Truncated bool `json:"truncated,omitempty"`
 }
 
-// ValidationContext tracks the current JSON path during validation
+// ValidationContext tracks the current JSON pointer during validation
 type ValidationContext struct {
 	path string
 }
@@ -120,6 +136,22 @@ func (vr *ValidationResult) AddIssue(issue ValidationIssue) {
 	}
 }
 
+// issueStream passes issues to emit as they are added or merged. It lets validation code
+// written against ValidationResult report issues without collecting them.
+type issueStream func(ValidationIssue)
+
+// AddIssue passes issue to the stream
+func (s issueStream) AddIssue(issue ValidationIssue) {
+	s(issue)
+}
+
+// Merge passes every issue of other to the stream in order
+func (s issueStream) Merge(other *ValidationResult) {
+	for _, issue := range other.Issues {
+		s(issue)
+	}
+}
+
 // Merge combines another validation result into this one
 func (vr *ValidationResult) Merge(other *ValidationResult) {
 	vr.Issues = append(vr.Issues, other.Issues...)
@@ -144,18 +176,25 @@ func (vr *ValidationResult) FirstError() error {
 
 // Field adds a field name to the context path
 func (ctx *ValidationContext) Field(name string) *ValidationContext {
-	if ctx.path == "" {
-		return &ValidationContext{path: name}
-	}
-	return &ValidationContext{path: ctx.path + "." + name}
+	return &ValidationContext{path: JSONPointerChild(ctx.path, name)}
 }
 
 // Index adds an array index to the context path
 func (ctx *ValidationContext) Index(i int) *ValidationContext {
-	return &ValidationContext{path: ctx.path + fmt.Sprintf("[%d]", i)}
+	return &ValidationContext{path: JSONPointerChild(ctx.path, strconv.Itoa(i))}
 }
 
-// String returns the current path as a string
+// String returns the current path as a JSON pointer
 func (ctx *ValidationContext) String() string {
 	return ctx.path
 }
+
+// jsonPointerEscaper escapes the characters with a special meaning in a JSON pointer
+// reference token
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JSONPointerChild returns the JSON pointer of the member or array element named token of
+// the value at pointer, e.g. "/packages" and "0" give "/packages/0"
+func JSONPointerChild(pointer, token string) string {
+	return pointer + "/" + jsonPointerEscaper.Replace(token)
+}
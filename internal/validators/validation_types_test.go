@@ -48,14 +48,14 @@ func TestValidationIssueSeverity(t *testing.T) {
 func TestNewValidationIssue(t *testing.T) {
 	issue := validators.NewValidationIssue(
 		validators.ValidationIssueTypeSemantic,
-		"repository.url",
+		"/repository/url",
 		"invalid repository URL",
 		validators.ValidationIssueSeverityError,
 		"invalid-repository-url",
 	)
 
 	assert.Equal(t, validators.ValidationIssueTypeSemantic, issue.Type)
-	assert.Equal(t, "repository.url", issue.Path)
+	assert.Equal(t, "/repository/url", issue.Path)
 	assert.Equal(t, "invalid repository URL", issue.Message)
 	assert.Equal(t, validators.ValidationIssueSeverityError, issue.Severity)
 	assert.Equal(t, "invalid-repository-url", issue.Reference)
@@ -65,13 +65,13 @@ func TestNewValidationIssueFromError(t *testing.T) {
 	err := errors.New("invalid repository URL: https://bad-url.com")
 	issue := validators.NewValidationIssueFromError(
 		validators.ValidationIssueTypeSemantic,
-		"repository.url",
+		"/repository/url",
 		err,
 		"invalid-repository-url",
 	)
 
 	assert.Equal(t, validators.ValidationIssueTypeSemantic, issue.Type)
-	assert.Equal(t, "repository.url", issue.Path)
+	assert.Equal(t, "/repository/url", issue.Path)
 	assert.Equal(t, "invalid repository URL: https://bad-url.com", issue.Message)
 	assert.Equal(t, validators.ValidationIssueSeverityError, issue.Severity)
 	assert.Equal(t, "invalid-repository-url", issue.Reference)
@@ -144,21 +144,26 @@ func TestValidationContext(t *testing.T) {
 
 	// Test field addition
 	ctx = ctx.Field("repository")
-	assert.Equal(t, "repository", ctx.String())
+	assert.Equal(t, "/repository", ctx.String())
 
 	// Test nested field
 	ctx = ctx.Field("url")
-	assert.Equal(t, "repository.url", ctx.String())
+	assert.Equal(t, "/repository/url", ctx.String())
 
 	// Test array index
 	ctx = &validators.ValidationContext{}
 	ctx = ctx.Field("packages").Index(0).Field("transport")
-	assert.Equal(t, "packages[0].transport", ctx.String())
+	assert.Equal(t, "/packages/0/transport", ctx.String())
 
 	// Test multiple array indices
 	ctx = &validators.ValidationContext{}
 	ctx = ctx.Field("packages").Index(0).Field("environmentVariables").Index(1).Field("name")
-	assert.Equal(t, "packages[0].environmentVariables[1].name", ctx.String())
+	assert.Equal(t, "/packages/0/environmentVariables/1/name", ctx.String())
+
+	// Test escaping of '~' and '/' in field names
+	ctx = &validators.ValidationContext{}
+	ctx = ctx.Field("_meta").Field("io.example/key~1")
+	assert.Equal(t, "/_meta/io.example~1key~01", ctx.String())
 }
 
 func TestValidationContextImmutability(t *testing.T) {
@@ -168,6 +173,6 @@ func TestValidationContextImmutability(t *testing.T) {
 	ctx3 := ctx2.Field("url")
 
 	assert.Equal(t, "", ctx1.String())
-	assert.Equal(t, "repository", ctx2.String())
-	assert.Equal(t, "repository.url", ctx3.String())
+	assert.Equal(t, "/repository", ctx2.String())
+	assert.Equal(t, "/repository/url", ctx3.String())
 }
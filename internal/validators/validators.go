@@ -4,13 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/modelcontextprotocol/registry/internal/config"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/modelcontextprotocol/registry/pkg/model"
+	"golang.org/x/mod/semver"
 )
 
 // Server name validation patterns
@@ -56,17 +61,26 @@ var (
 // Empty schema is always checked and always produces an error when schema validation is performed.
 func ValidateServerJSON(serverJSON *apiv0.ServerJSON, opts ValidationOptions) *ValidationResult {
 	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ValidateServerJSONStream(serverJSON, opts, result.AddIssue)
+	return result
+}
+
+// ValidateServerJSONStream performs the same validation as ValidateServerJSON but passes
+// each issue to emit as soon as the check that found it completes, instead of collecting
+// them. Issues are emitted once each, in the order ValidateServerJSON returns them.
+func ValidateServerJSONStream(serverJSON *apiv0.ServerJSON, opts ValidationOptions, emit func(ValidationIssue)) {
+	result := issueStream(emit)
 	ctx := &ValidationContext{}
 
 	// Schema validation (version check and/or full validation)
 	if opts.ValidateSchemaVersion || opts.ValidateSchema {
-		schemaResult := validateServerJSONSchema(serverJSON, opts.ValidateSchema, opts.NonCurrentSchemaPolicy)
+		schemaResult := validateServerJSONSchema(serverJSON, opts.ValidateSchema, opts.NonCurrentSchemaPolicy, opts.Schema, opts.SchemaRefDir)
 		result.Merge(schemaResult)
 	}
 
 	// Semantic validation (only if requested)
 	if !opts.ValidateSemantic {
-		return result
+		return
 	}
 
 	// Validate server name exists and format
@@ -80,6 +94,9 @@ func ValidateServerJSON(serverJSON *apiv0.ServerJSON, opts ValidationOptions) *V
 		result.AddIssue(issue)
 	}
 
+	// Reject names long enough to break listings, and warn about names close to the limit
+	result.Merge(validateNameLength(ctx.Field("name"), serverJSON.Name, opts.MaxNameLength))
+
 	// Validate top-level server version is a specific version (not a range) & not "latest"
 	versionResult := validateVersion(ctx.Field("version"), serverJSON.Version)
 	result.Merge(versionResult)
@@ -96,6 +113,9 @@ func ValidateServerJSON(serverJSON *apiv0.ServerJSON, opts ValidationOptions) *V
 	titleResult := validateTitle(ctx.Field("title"), serverJSON.Title)
 	result.Merge(titleResult)
 
+	// Warn if the description only repeats the name
+	result.Merge(validateDescription(ctx.Field("description"), serverJSON))
+
 	// Validate icons if provided
 	iconsResult := validateIcons(ctx.Field("icons"), serverJSON.Icons)
 	result.Merge(iconsResult)
@@ -105,14 +125,213 @@ func ValidateServerJSON(serverJSON *apiv0.ServerJSON, opts ValidationOptions) *V
 	for i, pkg := range serverJSON.Packages {
 		pkgResult := validatePackageField(ctx.Field("packages").Index(i), &pkg)
 		result.Merge(pkgResult)
+		result.Merge(validateSecureURL(ctx.Field("packages").Index(i).Field("registryBaseUrl"), pkg.RegistryBaseURL))
+		result.Merge(validateEnvNames(ctx.Field("packages").Index(i).Field("environmentVariables"), pkg.EnvironmentVariables, opts.EnvNamePattern))
 	}
 
+	// Warn if packages don't plausibly belong to the repository's owner
+	ownershipResult := validateRepositoryPackageOwnership(ctx, serverJSON.Repository, serverJSON.Packages)
+	result.Merge(ownershipResult)
+
+	// Warn if no package looks related to the server name
+	result.Merge(validateNamePackageConsistency(ctx, serverJSON))
+
 	// Validate all remotes
 	for i, remote := range serverJSON.Remotes {
 		remoteResult := validateRemoteTransport(ctx.Field("remotes").Index(i), &remote)
 		result.Merge(remoteResult)
 	}
 
+	// Warn about transports that are declared more than once
+	result.Merge(validateTransportDuplicates(ctx, serverJSON))
+
+	// Reject transport URLs whose scheme the declared transport type cannot use
+	result.Merge(validateTransportURLSchemes(ctx, serverJSON))
+
+	// Warn about a missing installation method or a suspicious number of packages
+	result.Merge(validatePackageCount(ctx, serverJSON, opts.MaxPackages))
+
+	// Reject argument lists and documents large enough to strain the registry and clients
+	result.Merge(validateSizeLimits(ctx, serverJSON, opts.MaxArguments, opts.MaxServerJSONSize))
+}
+
+// DefaultEnvNamePattern is the conventional UPPER_SNAKE_CASE form of environment variable
+// names, used when ValidationOptions.EnvNamePattern is not set
+var DefaultEnvNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// validateEnvNames warns about environment variable names that do not match pattern, such
+// as api-key or apiKey, which are often typos of the name the server actually reads
+func validateEnvNames(ctx *ValidationContext, envs []model.KeyValueInput, pattern *regexp.Regexp) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	if pattern == nil {
+		pattern = DefaultEnvNamePattern
+	}
+
+	for i, env := range envs {
+		if env.Name == "" || pattern.MatchString(env.Name) {
+			continue
+		}
+		message := fmt.Sprintf("environment variable name %q does not match the pattern %s", env.Name, pattern)
+		if suggestion := upperSnakeCase(env.Name); pattern.MatchString(suggestion) {
+			message += fmt.Sprintf("; did you mean %q?", suggestion)
+		}
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Index(i).Field("name").String(),
+			message,
+			ValidationIssueSeverityWarning,
+			"env-name-nonstandard",
+		))
+	}
+	return result
+}
+
+// DefaultMaxArguments is the number of runtime and package arguments of a package above
+// which arguments-too-many is reported when ValidationOptions.MaxArguments is not set
+const DefaultMaxArguments = 100
+
+// DefaultMaxServerJSONSize is the serialized size of server.json in bytes above which
+// server-json-too-large is reported when ValidationOptions.MaxServerJSONSize is not set
+const DefaultMaxServerJSONSize = 256 << 10
+
+// validateSizeLimits rejects packages with more than maxArguments runtime and package
+// arguments together, and a server.json whose JSON serialization is larger than maxSize
+// bytes. Such documents are more likely abuse or generated by mistake than real servers.
+func validateSizeLimits(ctx *ValidationContext, serverJSON *apiv0.ServerJSON, maxArguments, maxSize int) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	if maxArguments <= 0 {
+		maxArguments = DefaultMaxArguments
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxServerJSONSize
+	}
+
+	for i, pkg := range serverJSON.Packages {
+		if count := len(pkg.RuntimeArguments) + len(pkg.PackageArguments); count > maxArguments {
+			result.AddIssue(NewValidationIssue(
+				ValidationIssueTypeSemantic,
+				ctx.Field("packages").Index(i).String(),
+				fmt.Sprintf("package declares %d runtime and package arguments, more than the maximum of %d", count, maxArguments),
+				ValidationIssueSeverityError,
+				"arguments-too-many",
+			))
+		}
+	}
+
+	// The document was parsed from JSON, so it serializes unless a caller built it with
+	// values JSON cannot represent; schema validation reports that case
+	if data, err := json.Marshal(serverJSON); err == nil && len(data) > maxSize {
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.String(),
+			fmt.Sprintf("server.json is %d bytes when serialized, more than the maximum of %d", len(data), maxSize),
+			ValidationIssueSeverityError,
+			"server-json-too-large",
+		))
+	}
+	return result
+}
+
+// DefaultMaxNameLength is the number of characters of the server name above which
+// name-too-long is reported when ValidationOptions.MaxNameLength is not set
+const DefaultMaxNameLength = 200
+
+// nameLengthWarnPercent is the share of the maximum name length, in percent, above which
+// name-length-near-limit is reported
+const nameLengthWarnPercent = 90
+
+// validateNameLength rejects a name longer than maxLength characters, which breaks the
+// layout of listings and is more likely abuse than a real server, and warns about a name
+// close to that limit
+func validateNameLength(ctx *ValidationContext, name string, maxLength int) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	if maxLength <= 0 {
+		maxLength = DefaultMaxNameLength
+	}
+
+	length := utf8.RuneCountInString(name)
+	switch {
+	case length > maxLength:
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.String(),
+			fmt.Sprintf("server name is %d characters, more than the maximum of %d", length, maxLength),
+			ValidationIssueSeverityError,
+			"name-too-long",
+		))
+	case length*100 > maxLength*nameLengthWarnPercent:
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.String(),
+			fmt.Sprintf("server name is %d characters, close to the maximum of %d; consider a shorter name", length, maxLength),
+			ValidationIssueSeverityWarning,
+			"name-length-near-limit",
+		))
+	}
+	return result
+}
+
+// DefaultMaxPackages is the number of packages above which too-many-packages is reported
+// when ValidationOptions.MaxPackages is not set
+const DefaultMaxPackages = 50
+
+// validatePackageCount warns when the server declares neither packages nor remotes, so there
+// is no way to install or connect to it, and when it declares more than maxPackages packages
+func validatePackageCount(ctx *ValidationContext, serverJSON *apiv0.ServerJSON, maxPackages int) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	if maxPackages <= 0 {
+		maxPackages = DefaultMaxPackages
+	}
+
+	switch {
+	case len(serverJSON.Packages) == 0 && len(serverJSON.Remotes) == 0:
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.String(),
+			"server declares no packages and no remotes, so there is no way to install or connect to it",
+			ValidationIssueSeverityWarning,
+			"no-installation-method",
+		))
+	case len(serverJSON.Packages) > maxPackages:
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("packages").String(),
+			fmt.Sprintf("server declares %d packages, more than the %d expected; check for duplicated or generated entries", len(serverJSON.Packages), maxPackages),
+			ValidationIssueSeverityWarning,
+			"too-many-packages",
+		))
+	}
+
+	return result
+}
+
+// ValidatePackageVersions warns about each package whose version differs from the server
+// version, e.g. packages pinned to 1.1.0 in a server.json for version 1.2.0, which often
+// means one was bumped without the other. A leading "v" is ignored on either side, and
+// packages without a version (such as OCI images) are not checked. It is not part of
+// ValidateServerJSON because some servers version their packages independently, so
+// callers opt in.
+func ValidatePackageVersions(serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+	if serverJSON.Version == "" {
+		return result
+	}
+
+	serverVersion := strings.TrimPrefix(serverJSON.Version, "v")
+	for i, pkg := range serverJSON.Packages {
+		if pkg.Version == "" || strings.TrimPrefix(pkg.Version, "v") == serverVersion {
+			continue
+		}
+		result.AddIssue(NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("packages").Index(i).Field("version").String(),
+			fmt.Sprintf("package %s has version %s, but the server version is %s", pkg.Identifier, pkg.Version, serverJSON.Version),
+			ValidationIssueSeverityWarning,
+			"package-version-mismatch",
+		))
+	}
+
 	return result
 }
 
@@ -136,6 +355,9 @@ func validateRepository(ctx *ValidationContext, obj *model.Repository) *Validati
 		result.AddIssue(issue)
 	}
 
+	// Warn about plain http
+	result.Merge(validateSecureURL(ctx.Field("url"), obj.URL))
+
 	// validate subfolder if present
 	if obj.Subfolder != "" && !IsValidSubfolderPath(obj.Subfolder) {
 		issue := NewValidationIssueFromError(
@@ -150,6 +372,392 @@ func validateRepository(ctx *ValidationContext, obj *model.Repository) *Validati
 	return result
 }
 
+// validateRepositoryPackageOwnership compares the repository owner with the owner implied by
+// each package identifier (npm scope, ghcr.io namespace) and warns on gross mismatches.
+// This is a heuristic: packages without an identifiable owner are not checked.
+func validateRepositoryPackageOwnership(ctx *ValidationContext, repo *model.Repository, packages []model.Package) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	if repo == nil || !IsValidRepositoryURL(RepositorySource(repo.Source), repo.URL) {
+		return result
+	}
+	repoOwner := repositoryOwner(repo.URL)
+
+	for i, pkg := range packages {
+		pkgOwner := packageOwner(&pkg)
+		if pkgOwner == "" || ownersPlausiblyMatch(repoOwner, pkgOwner) {
+			continue
+		}
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("packages").Index(i).Field("identifier").String(),
+			fmt.Sprintf("package %s appears to be owned by '%s', but the repository %s is owned by '%s'", pkg.Identifier, pkgOwner, repo.URL, repoOwner),
+			ValidationIssueSeverityWarning,
+			"repository-package-mismatch",
+		)
+		result.AddIssue(issue)
+	}
+
+	return result
+}
+
+// validateNamePackageConsistency warns when none of the packages shares a word with the
+// server name, e.g. server com.acme/weather with only the package @globex/billing, which
+// suggests a package was copied from another server.json. Like the ownership check this is
+// a heuristic and errs towards silence: a single package that shares any word with either
+// part of the name is enough, and names or packages without distinctive words are skipped.
+func validateNamePackageConsistency(ctx *ValidationContext, serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	serverTokens := nameTokens(serverJSON.Name)
+	if len(serverTokens) == 0 || len(serverJSON.Packages) == 0 {
+		return result
+	}
+
+	identifiers := make([]string, 0, len(serverJSON.Packages))
+	for _, pkg := range serverJSON.Packages {
+		packageTokens := nameTokens(pkg.Identifier)
+		if len(packageTokens) == 0 || tokensPlausiblyMatch(serverTokens, packageTokens) {
+			return result
+		}
+		identifiers = append(identifiers, pkg.Identifier)
+	}
+
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.Field("packages").String(),
+		fmt.Sprintf("none of the packages (%s) shares a recognizable word with the server name %s; check that they belong to this server", strings.Join(identifiers, ", "), serverJSON.Name),
+		ValidationIssueSeverityWarning,
+		"name-package-inconsistent",
+	)
+	result.AddIssue(issue)
+	return result
+}
+
+// validateTransportDuplicates warns about transports declared more than once: a remote with
+// the same type and url as an earlier remote, or a package with the same registry type,
+// identifier, version and transport type as an earlier package. Offering a server over
+// several transport types is not a duplicate.
+func validateTransportDuplicates(ctx *ValidationContext, serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	seenPackages := map[[4]string]int{}
+	for i, pkg := range serverJSON.Packages {
+		key := [4]string{pkg.RegistryType, pkg.Identifier, pkg.Version, pkg.Transport.Type}
+		first, ok := seenPackages[key]
+		if !ok {
+			seenPackages[key] = i
+			continue
+		}
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("packages").Index(i).Field("transport").Field("type").String(),
+			fmt.Sprintf("package %s already declares a %s transport in %s", pkg.Identifier, pkg.Transport.Type, ctx.Field("packages").Index(first).String()),
+			ValidationIssueSeverityWarning,
+			"transport-duplicate",
+		)
+		result.AddIssue(issue)
+	}
+
+	seenRemotes := map[[2]string]int{}
+	for i, remote := range serverJSON.Remotes {
+		key := [2]string{remote.Type, remote.URL}
+		first, ok := seenRemotes[key]
+		if !ok {
+			seenRemotes[key] = i
+			continue
+		}
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.Field("remotes").Index(i).String(),
+			fmt.Sprintf("%s remote %s is already declared in %s", remote.Type, remote.URL, ctx.Field("remotes").Index(first).String()),
+			ValidationIssueSeverityWarning,
+			"transport-duplicate",
+		)
+		result.AddIssue(issue)
+	}
+
+	return result
+}
+
+// validateTransportURLSchemes reports streamable-http and sse transports, of packages or
+// remotes, whose url uses a scheme other than http or https, such as ws:// for a WebSocket
+// endpoint. URLs without a scheme or with a templated scheme are left to the URL checks.
+func validateTransportURLSchemes(ctx *ValidationContext, serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	for i, pkg := range serverJSON.Packages {
+		result.Merge(validateTransportURLScheme(ctx.Field("packages").Index(i).Field("transport"), &pkg.Transport))
+	}
+	for i, remote := range serverJSON.Remotes {
+		result.Merge(validateTransportURLScheme(ctx.Field("remotes").Index(i), &remote))
+	}
+
+	return result
+}
+
+// validateTransportURLScheme reports transport if its type needs an http or https url and
+// its url has another scheme
+func validateTransportURLScheme(ctx *ValidationContext, transport *model.Transport) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	if transport.Type != model.TransportTypeStreamableHTTP && transport.Type != model.TransportTypeSSE {
+		return result
+	}
+
+	scheme, _, found := strings.Cut(transport.URL, "://")
+	scheme = strings.ToLower(scheme)
+	if !found || scheme == "" || strings.Contains(scheme, "{") || scheme == "http" || scheme == "https" {
+		return result
+	}
+
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.Field("url").String(),
+		fmt.Sprintf("%s transport url %s uses the %s scheme; %s transports are served over http or https", transport.Type, transport.URL, scheme, transport.Type),
+		ValidationIssueSeverityError,
+		"transport-url-scheme-mismatch",
+	)
+	result.AddIssue(issue)
+	return result
+}
+
+// recommendedFieldPresent reports, for each field that can be recommended, whether a
+// server.json sets it
+var recommendedFieldPresent = map[string]func(serverJSON *apiv0.ServerJSON) bool{
+	"repository": func(s *apiv0.ServerJSON) bool { return s.Repository != nil && s.Repository.URL != "" },
+	"websiteUrl": func(s *apiv0.ServerJSON) bool { return s.WebsiteURL != "" },
+	"title":      func(s *apiv0.ServerJSON) bool { return strings.TrimSpace(s.Title) != "" },
+	"icons":      func(s *apiv0.ServerJSON) bool { return len(s.Icons) > 0 },
+}
+
+// DefaultRecommendedFields are the optional fields reported as missing unless configured otherwise
+var DefaultRecommendedFields = []string{"repository", "websiteUrl"}
+
+// RecommendedFieldMissingReference returns the reference of the warning for a missing
+// recommended field
+func RecommendedFieldMissingReference(field string) string {
+	return "recommended-field-missing:" + field
+}
+
+// ValidateRecommendedFields warns about each of the given optional-but-recommended fields
+// that serverJSON does not set. The warnings do not make the result invalid.
+func ValidateRecommendedFields(serverJSON *apiv0.ServerJSON, fields []string) (*ValidationResult, error) {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+
+	for _, field := range fields {
+		present, ok := recommendedFieldPresent[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRecommendedField, field)
+		}
+		if present(serverJSON) {
+			continue
+		}
+		issue := NewValidationIssue(
+			ValidationIssueTypeLinter,
+			ctx.Field(field).String(),
+			fmt.Sprintf("%s is not set; it is recommended for a good registry listing", field),
+			ValidationIssueSeverityWarning,
+			RecommendedFieldMissingReference(field),
+		)
+		result.AddIssue(issue)
+	}
+
+	return result, nil
+}
+
+// DefaultReservedNamespaces are namespaces that real servers must not be published under:
+// the registry's own namespace and the example.com documentation placeholder
+var DefaultReservedNamespaces = []string{"io.modelcontextprotocol", "com.example"}
+
+// ValidateReservedNamespace reports an error if serverJSON's name falls under one of the
+// given reserved namespaces, either the namespace itself or a sub-namespace of it
+// (e.g. com.example.api under com.example). It is not part of ValidateServerJSON, so
+// callers opt in with the list that applies to them.
+func ValidateReservedNamespace(serverJSON *apiv0.ServerJSON, namespaces []string) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+
+	namespace, _, ok := strings.Cut(serverJSON.Name, "/")
+	if !ok {
+		return result
+	}
+
+	for _, reserved := range namespaces {
+		if !strings.EqualFold(namespace, reserved) && !strings.HasPrefix(strings.ToLower(namespace), strings.ToLower(reserved)+".") {
+			continue
+		}
+		issue := NewValidationIssueFromError(
+			ValidationIssueTypeSemantic,
+			ctx.Field("name").String(),
+			fmt.Errorf("%w: %s/*", ErrReservedNamespace, reserved),
+			"namespace-reserved",
+		)
+		result.AddIssue(issue)
+		break
+	}
+
+	return result
+}
+
+// ValidatePrereleaseVersion reports an error if serverJSON's version is a semver pre-release
+// such as 1.0.0-rc.1. Versions that are not semver are not checked. It is not part of
+// ValidateServerJSON, so callers opt in where pre-releases are forbidden.
+func ValidatePrereleaseVersion(serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+
+	// x/mod/semver requires the "v" prefix
+	version := "v" + strings.TrimPrefix(serverJSON.Version, "v")
+	if !semver.IsValid(version) || semver.Prerelease(version) == "" {
+		return result
+	}
+
+	issue := NewValidationIssueFromError(
+		ValidationIssueTypeSemantic,
+		ctx.Field("version").String(),
+		fmt.Errorf("%w: %q has pre-release identifier %q", ErrPrereleaseVersion, serverJSON.Version, strings.TrimPrefix(semver.Prerelease(version), "-")),
+		"version-prerelease-forbidden",
+	)
+	result.AddIssue(issue)
+	return result
+}
+
+// ValidateVersionNotDowngrade reports an error if serverJSON's version is lower than
+// latestVersion, the latest published version of the server. Versions are only compared
+// when both are semver; otherwise there is no reliable order and nothing is reported. It
+// is not part of ValidateServerJSON, as it needs the registry's latest version.
+func ValidateVersionNotDowngrade(serverJSON *apiv0.ServerJSON, latestVersion string) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	ctx := &ValidationContext{}
+
+	// x/mod/semver requires the "v" prefix
+	version := "v" + strings.TrimPrefix(serverJSON.Version, "v")
+	latest := "v" + strings.TrimPrefix(latestVersion, "v")
+	if !semver.IsValid(version) || !semver.IsValid(latest) || semver.Compare(version, latest) >= 0 {
+		return result
+	}
+
+	issue := NewValidationIssueFromError(
+		ValidationIssueTypeSemantic,
+		ctx.Field("version").String(),
+		fmt.Errorf("%w: %q is lower than %q", ErrVersionDowngrade, serverJSON.Version, latestVersion),
+		"version-downgrade",
+	)
+	result.AddIssue(issue)
+	return result
+}
+
+// remoteURLLookupTimeout bounds each host name lookup of ValidateRemoteURLsPublic. A host
+// that does not resolve in time is treated as unresolvable.
+const remoteURLLookupTimeout = 5 * time.Second
+
+// HostResolver looks up the addresses of a host name. *net.Resolver implements it.
+type HostResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// ValidateRemoteURLsPublic reports an error for each remote whose URL host is localhost or
+// resolves to a loopback, private, link-local or unspecified address, as such a listing is
+// unreachable for everyone but its publisher. Hosts that contain template variables or
+// cannot be resolved are not reported, since their address is not known. It is not part
+// of ValidateServerJSON, as it needs DNS; the publisher runs it before publishing to a
+// production registry.
+func ValidateRemoteURLsPublic(ctx context.Context, serverJSON *apiv0.ServerJSON, resolver HostResolver) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+	vctx := &ValidationContext{}
+
+	for i, remote := range serverJSON.Remotes {
+		u, err := url.Parse(remote.URL)
+		if err != nil || u.Hostname() == "" || strings.Contains(u.Hostname(), "{") {
+			continue
+		}
+		reason := nonPublicHostReason(ctx, u.Hostname(), resolver)
+		if reason == "" {
+			continue
+		}
+		issue := NewValidationIssueFromError(
+			ValidationIssueTypeSemantic,
+			vctx.Field("remotes").Index(i).Field("url").String(),
+			fmt.Errorf("%w: %s %s; public registries only list servers that everyone can connect to", ErrPrivateRemoteURL, remote.URL, reason),
+			"remote-url-private",
+		)
+		result.AddIssue(issue)
+	}
+	return result
+}
+
+// nonPublicHostReason describes why host is not publicly reachable, or returns "" if it is
+// or its addresses cannot be resolved
+func nonPublicHostReason(ctx context.Context, host string, resolver HostResolver) string {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "points at localhost"
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if kind := nonPublicAddressKind(addr); kind != "" {
+			return "points at " + kind + " address " + addr.String()
+		}
+		return ""
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, remoteURLLookupTimeout)
+	defer cancel()
+	addrs, err := resolver.LookupIPAddr(lookupCtx, host)
+	if err != nil {
+		return ""
+	}
+	for _, ipAddr := range addrs {
+		addr, ok := netip.AddrFromSlice(ipAddr.IP)
+		if !ok {
+			continue
+		}
+		if kind := nonPublicAddressKind(addr.Unmap()); kind != "" {
+			return "resolves to " + kind + " address " + addr.Unmap().String()
+		}
+	}
+	return ""
+}
+
+// nonPublicAddressKind names the kind of address addr is if it is not publicly routable,
+// and returns "" otherwise
+func nonPublicAddressKind(addr netip.Addr) string {
+	addr = addr.Unmap()
+	switch {
+	case addr.IsLoopback():
+		return "the loopback"
+	case addr.IsPrivate():
+		return "the private"
+	case addr.IsLinkLocalUnicast(), addr.IsLinkLocalMulticast():
+		return "the link-local"
+	case addr.IsUnspecified():
+		return "the unspecified"
+	default:
+		return ""
+	}
+}
+
+// validateSecureURL warns when an externally-facing URL uses plain http. URLs on localhost
+// are exempt, as they never leave the machine. websiteUrl and remote URLs must already be
+// https, so they are not checked here.
+func validateSecureURL(ctx *ValidationContext, rawURL string) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(parsedURL.Scheme, "http") || isLocalhost(parsedURL.Hostname()) {
+		return result
+	}
+
+	issue := NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.String(),
+		fmt.Sprintf("%s uses the insecure http scheme; use https: %s", ctx.String(), rawURL),
+		ValidationIssueSeverityWarning,
+		"url-insecure-scheme",
+	)
+	result.AddIssue(issue)
+	return result
+}
+
 func validateWebsiteURL(ctx *ValidationContext, websiteURL string) *ValidationResult {
 	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
 
@@ -234,6 +842,31 @@ func validateTitle(ctx *ValidationContext, title string) *ValidationResult {
 	return result
 }
 
+// validateDescription warns when the description is just the server name, compared
+// case-insensitively and ignoring punctuation, e.g. "com.acme/weather" or "Weather" for
+// the server com.acme/weather. Such descriptions tell users nothing about the server.
+func validateDescription(ctx *ValidationContext, serverJSON *apiv0.ServerJSON) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	description := normalizeWords(serverJSON.Description)
+	if description == "" {
+		return result
+	}
+	_, namePart, _ := strings.Cut(serverJSON.Name, "/")
+	if description != normalizeWords(serverJSON.Name) && description != normalizeWords(namePart) {
+		return result
+	}
+
+	result.AddIssue(NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.String(),
+		fmt.Sprintf("description %q only repeats the server name; describe what the server does", serverJSON.Description),
+		ValidationIssueSeverityWarning,
+		"description-equals-name",
+	))
+	return result
+}
+
 func validateIcons(ctx *ValidationContext, icons []model.Icon) *ValidationResult {
 	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
 
@@ -330,6 +963,13 @@ func validatePackageField(ctx *ValidationContext, obj *model.Package) *Validatio
 	return result
 }
 
+// ValidateVersion checks that version is a specific version usable as a server version,
+// applying the same rules as the top-level version field
+func ValidateVersion(version string) *ValidationResult {
+	ctx := &ValidationContext{}
+	return validateVersion(ctx.Field("version"), version)
+}
+
 // validateVersion validates the version string.
 // NB: we decided that we would not enforce strict semver for version strings
 func validateVersion(ctx *ValidationContext, version string) *ValidationResult {
@@ -405,6 +1045,30 @@ func validateArgument(ctx *ValidationContext, obj *model.Argument) *ValidationRe
 		valueResult := validateArgumentValueFields(ctx, obj.Name, obj.Value, obj.Default)
 		result.Merge(valueResult)
 	}
+
+	// Check the {identifier} placeholders in value and default
+	result.Merge(validateArgumentTemplate(ctx.Field("value"), obj.Value, obj.Variables))
+	result.Merge(validateArgumentTemplate(ctx.Field("default"), obj.Default, obj.Variables))
+
+	return result
+}
+
+// validateArgumentTemplate warns about an unclosed or undeclared {identifier} placeholder in
+// an argument string
+func validateArgumentTemplate(ctx *ValidationContext, value string, variables map[string]model.Input) *ValidationResult {
+	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
+
+	if err := checkArgumentPlaceholders(value, variables); err != nil {
+		issue := NewValidationIssue(
+			ValidationIssueTypeSemantic,
+			ctx.String(),
+			fmt.Sprintf("%v: %s", err, value),
+			ValidationIssueSeverityWarning,
+			"argument-template-invalid",
+		)
+		result.AddIssue(issue)
+	}
+
 	return result
 }
 
@@ -531,6 +1195,13 @@ func validatePackageTransport(ctx *ValidationContext, transport *model.Transport
 			)
 			result.AddIssue(issue)
 		}
+		// Headers and variables only apply to HTTP-based transports
+		if len(transport.Headers) > 0 {
+			result.AddIssue(transportOptionConflict(ctx.Field("headers"), "headers", transport.Type))
+		}
+		if len(transport.Variables) > 0 {
+			result.AddIssue(transportOptionConflict(ctx.Field("variables"), "variables", transport.Type))
+		}
 	case model.TransportTypeStreamableHTTP, model.TransportTypeSSE:
 		// URL is required for streamable-http and sse
 		if transport.URL == "" {
@@ -574,6 +1245,17 @@ func validatePackageTransport(ctx *ValidationContext, transport *model.Transport
 	return result
 }
 
+// transportOptionConflict warns about an option that the transport type does not support
+func transportOptionConflict(ctx *ValidationContext, option, transportType string) ValidationIssue {
+	return NewValidationIssue(
+		ValidationIssueTypeSemantic,
+		ctx.String(),
+		fmt.Sprintf("%s should be empty for %s transport type; they only apply to streamable-http and sse transports", option, transportType),
+		ValidationIssueSeverityWarning,
+		"transport-options-conflict",
+	)
+}
+
 // validateRemoteTransport validates a remote transport with optional templating
 func validateRemoteTransport(ctx *ValidationContext, obj *model.Transport) *ValidationResult {
 	result := &ValidationResult{Valid: true, Issues: []ValidationIssue{}}
@@ -698,6 +1380,13 @@ func validatePublisherExtensions(req apiv0.ServerJSON) error {
 	return nil
 }
 
+// ValidateServerName checks that name is a valid server name in the dns-namespace/name
+// format, applying the same rules as the name field of server.json
+func ValidateServerName(name string) error {
+	_, err := parseServerName(apiv0.ServerJSON{Name: name})
+	return err
+}
+
 func parseServerName(serverJSON apiv0.ServerJSON) (string, error) {
 	name := serverJSON.Name
 	if name == "" {
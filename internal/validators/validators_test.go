@@ -2,10 +2,16 @@ package validators_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/validators"
@@ -1396,6 +1402,518 @@ func TestValidateArgument_ValidValueFields(t *testing.T) {
 	}
 }
 
+func TestValidateArgument_Templates(t *testing.T) {
+	variables := map[string]model.Input{
+		"source_path": {Description: "Host directory to mount"},
+		"target_path": {Description: "Container directory"},
+		"port":        {Default: "8080"},
+	}
+	valueArg := func(value string) model.Argument {
+		return model.Argument{
+			InputWithVariables: model.InputWithVariables{Input: model.Input{Value: value}, Variables: variables},
+			Type:               model.ArgumentTypePositional,
+		}
+	}
+	defaultArg := func(defaultValue string) model.Argument {
+		return model.Argument{
+			InputWithVariables: model.InputWithVariables{Input: model.Input{Default: defaultValue}, Variables: variables},
+			Type:               model.ArgumentTypeNamed,
+			Name:               "--config",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		arg           model.Argument
+		expectedPath  string
+		expectedIssue string
+	}{
+		{"no template", valueArg("plain value"), "", ""},
+		{"declared placeholder", valueArg("{source_path}"), "", ""},
+		{"multiple placeholders", valueArg("type=bind,src={source_path},dst={target_path}"), "", ""},
+		{"json value", valueArg(`--config={"a":1}`), "", ""},
+		{"nested json default", defaultArg(`{"server":{"port":8080}}`), "", ""},
+		{"braces around other text", valueArg("{port:-8080} or {} or {source path}"), "", ""},
+		{"unclosed placeholder", valueArg("--port={port"), "/packages/0/runtimeArguments/0/value", "placeholder {port at offset 7 is not closed"},
+		{"undeclared placeholder", valueArg("--host={host}"), "/packages/0/runtimeArguments/0/value", "placeholder {host} is not declared in variables"},
+		{"undeclared placeholder in default", defaultArg("{config_file}"), "/packages/0/runtimeArguments/0/default", "placeholder {config_file} is not declared"},
+		{"placeholder without variables", model.Argument{
+			InputWithVariables: model.InputWithVariables{Input: model.Input{Value: "{source_path}"}},
+			Type:               model.ArgumentTypePositional,
+		}, "/packages/0/runtimeArguments/0/value", "placeholder {source_path} is not declared"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createValidServerWithArgument(tt.arg)
+			result := validators.ValidateServerJSON(&server, validators.ValidationSchemaVersionAndSemantic)
+
+			var templateIssues []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "argument-template-invalid" {
+					templateIssues = append(templateIssues, issue)
+				}
+			}
+
+			// Placeholder problems are warnings and never make the server.json invalid
+			assert.True(t, result.Valid, "Expected valid argument %+v, got %v", tt.arg, result.Issues)
+			if tt.expectedIssue == "" {
+				assert.Empty(t, templateIssues)
+				return
+			}
+
+			require.Len(t, templateIssues, 1)
+			assert.Equal(t, tt.expectedPath, templateIssues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, templateIssues[0].Severity)
+			assert.Contains(t, templateIssues[0].Message, tt.expectedIssue)
+		})
+	}
+}
+
+func TestValidate_RepositoryPackageMismatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		repoURL      string
+		repoSource   string
+		registryType string
+		identifier   string
+		expectWarn   bool
+	}{
+		{"npm scope matches github org", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeNPM, "@acme/weather-mcp", false},
+		{"npm scope matches case-insensitively", "https://github.com/Acme/weather-mcp", "github", model.RegistryTypeNPM, "@acme/weather-mcp", false},
+		{"npm scope is a variant of org", "https://github.com/acme-labs/weather-mcp", "github", model.RegistryTypeNPM, "@acme/weather-mcp", false},
+		{"npm scope ignores separators", "https://github.com/acme_labs/weather-mcp", "github", model.RegistryTypeNPM, "@acme-labs/weather-mcp", false},
+		{"npm scope matches gitlab group", "https://gitlab.com/acme/weather-mcp", "gitlab", model.RegistryTypeNPM, "@acme/weather-mcp", false},
+		{"unscoped npm package is not checked", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeNPM, "weather-mcp", false},
+		{"pypi package is not checked", "https://github.com/acme/weather-mcp", "github", model.RegistryTypePyPI, "other-weather-mcp", false},
+		{"ghcr namespace matches org", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeOCI, "ghcr.io/acme/weather-mcp:1.0.0", false},
+		{"non-ghcr image is not checked", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeOCI, "docker.io/other/weather-mcp:1.0.0", false},
+		{"npm scope mismatches github org", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeNPM, "@globex/weather-mcp", true},
+		{"ghcr namespace mismatches github org", "https://github.com/acme/weather-mcp", "github", model.RegistryTypeOCI, "ghcr.io/globex/weather-mcp:1.0.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Repository: &model.Repository{
+					URL:    tt.repoURL,
+					Source: tt.repoSource,
+				},
+				Packages: []model.Package{
+					{
+						Identifier:   tt.identifier,
+						RegistryType: tt.registryType,
+						Version:      "1.0.0",
+						Transport:    model.Transport{Type: model.TransportTypeStdio},
+					},
+				},
+			}
+
+			result := validators.ValidateServerJSON(&server, validators.ValidationSchemaVersionAndSemantic)
+
+			var mismatches []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "repository-package-mismatch" {
+					mismatches = append(mismatches, issue)
+				}
+			}
+
+			assert.True(t, result.Valid, "mismatch must only be a warning, got %v", result.Issues)
+			if !tt.expectWarn {
+				assert.Empty(t, mismatches)
+				return
+			}
+			require.Len(t, mismatches, 1)
+			assert.Equal(t, "/packages/0/identifier", mismatches[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, mismatches[0].Severity)
+			assert.Contains(t, mismatches[0].Message, "globex")
+			assert.Contains(t, mismatches[0].Message, "acme")
+		})
+	}
+
+	t.Run("no repository", func(t *testing.T) {
+		server := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Packages: []model.Package{
+				{Identifier: "@globex/weather-mcp", RegistryType: model.RegistryTypeNPM, Version: "1.0.0", Transport: model.Transport{Type: model.TransportTypeStdio}},
+			},
+		}
+		result := validators.ValidateServerJSON(&server, validators.ValidationSchemaVersionAndSemantic)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Issues)
+	})
+}
+
+func TestValidate_NamePackageConsistency(t *testing.T) {
+	npm := func(identifier string) model.Package {
+		return model.Package{Identifier: identifier, RegistryType: model.RegistryTypeNPM, Version: "1.0.0", Transport: model.Transport{Type: model.TransportTypeStdio}}
+	}
+	oci := func(identifier string) model.Package {
+		return model.Package{Identifier: identifier, RegistryType: model.RegistryTypeOCI, Transport: model.Transport{Type: model.TransportTypeStdio}}
+	}
+
+	tests := []struct {
+		name       string
+		serverName string
+		packages   []model.Package
+		expectWarn bool
+	}{
+		{"shared namespace and name", "com.acme/foo", []model.Package{npm("@acme/mcp-foo")}, false},
+		{"shared namespace only", "com.acme/foo", []model.Package{npm("@acme/tools")}, false},
+		{"shared name only", "io.github.someone/weather", []model.Package{npm("weather-mcp")}, false},
+		{"name contained in a package word", "io.github.someone/weather", []model.Package{oci("ghcr.io/other/weatherapi:1.0.0")}, false},
+		{"joined words", "io.github.someone/open-weather", []model.Package{npm("openweather")}, false},
+		{"one of several packages aligned", "com.acme/weather", []model.Package{npm("@globex/billing"), oci("ghcr.io/acme/weather:1.0.0")}, false},
+		{"only generic package words", "com.acme/weather", []model.Package{npm("mcp-server")}, false},
+		{"no packages", "com.acme/weather", nil, false},
+		{"unrelated package", "com.acme/weather", []model.Package{npm("@globex/billing")}, true},
+		{"several unrelated packages", "com.acme/weather", []model.Package{npm("@globex/billing"), oci("ghcr.io/initech/tps-reports:1.0.0")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        tt.serverName,
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages:    tt.packages,
+			}
+
+			result := validators.ValidateServerJSON(&server, validators.ValidationSchemaVersionAndSemantic)
+
+			var inconsistencies []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "name-package-inconsistent" {
+					inconsistencies = append(inconsistencies, issue)
+				}
+			}
+
+			assert.True(t, result.Valid, "an inconsistent name must only be a warning, got %v", result.Issues)
+			if !tt.expectWarn {
+				assert.Empty(t, inconsistencies)
+				return
+			}
+			require.Len(t, inconsistencies, 1)
+			assert.Equal(t, "/packages", inconsistencies[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, inconsistencies[0].Severity)
+			assert.Contains(t, inconsistencies[0].Message, "@globex/billing")
+			assert.Contains(t, inconsistencies[0].Message, tt.serverName)
+		})
+	}
+}
+
+func TestValidateRecommendedFields(t *testing.T) {
+	minimal := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "com.example/test-server",
+		Description: "A test server",
+		Version:     "1.0.0",
+	}
+
+	t.Run("minimal document", func(t *testing.T) {
+		result, err := validators.ValidateRecommendedFields(&minimal, validators.DefaultRecommendedFields)
+		require.NoError(t, err)
+		assert.True(t, result.Valid, "recommended fields must not fail validation")
+
+		var references []string
+		for _, issue := range result.Issues {
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+			references = append(references, issue.Reference)
+		}
+		assert.Equal(t, []string{"recommended-field-missing:repository", "recommended-field-missing:websiteUrl"}, references)
+	})
+
+	t.Run("complete document", func(t *testing.T) {
+		complete := minimal
+		complete.Title = "Test Server"
+		complete.WebsiteURL = "https://example.com"
+		complete.Repository = &model.Repository{URL: "https://github.com/example/test-server", Source: "github"}
+		complete.Icons = []model.Icon{{Src: "https://example.com/icon.png"}}
+
+		result, err := validators.ValidateRecommendedFields(&complete, []string{"repository", "websiteUrl", "title", "icons"})
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("configured set", func(t *testing.T) {
+		result, err := validators.ValidateRecommendedFields(&minimal, []string{"title"})
+		require.NoError(t, err)
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "recommended-field-missing:title", result.Issues[0].Reference)
+		assert.Equal(t, "/title", result.Issues[0].Path)
+
+		result, err = validators.ValidateRecommendedFields(&minimal, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.Issues)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := validators.ValidateRecommendedFields(&minimal, []string{"license"})
+		require.ErrorIs(t, err, validators.ErrUnknownRecommendedField)
+	})
+}
+
+func TestValidateLicense(t *testing.T) {
+	tests := []struct {
+		name        string
+		serverJSON  string
+		wantMessage string
+	}{
+		{name: "valid SPDX identifier", serverJSON: `{"name": "com.example/server", "license": "Apache-2.0"}`},
+		{name: "deprecated SPDX identifier", serverJSON: `{"license": "GPL-2.0"}`},
+		{name: "unknown string", serverJSON: `{"license": "Proprietary-ish"}`, wantMessage: `license "Proprietary-ish" is not a recognized SPDX license identifier`},
+		{name: "wrong case", serverJSON: `{"license": "mit"}`, wantMessage: `must be spelled as the SPDX license identifier "MIT"`},
+		{name: "not a string", serverJSON: `{"license": {"id": "MIT"}}`, wantMessage: "license must be a string"},
+		{name: "missing license", serverJSON: `{"name": "com.example/server"}`, wantMessage: "license is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validators.ValidateLicense([]byte(tt.serverJSON))
+			if tt.wantMessage == "" {
+				assert.True(t, result.Valid)
+				assert.Empty(t, result.Issues)
+				return
+			}
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "license-invalid", result.Issues[0].Reference)
+			assert.Equal(t, "/license", result.Issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+			assert.Contains(t, result.Issues[0].Message, tt.wantMessage)
+		})
+	}
+}
+
+func TestValidateDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		serverJSON   string
+		wantPaths    []string
+		wantMessages []string
+	}{
+		{name: "no duplicates", serverJSON: `{"name": "com.example/server", "packages": [{"version": "1.0.0"}, {"version": "1.0.0"}]}`},
+		{
+			name:         "duplicated top-level key",
+			serverJSON:   `{"version": "1.0.0", "name": "com.example/server", "version": "2.0.0"}`,
+			wantPaths:    []string{"/version"},
+			wantMessages: []string{`key "version" appears more than once in the same object, at offsets 1 and 51`},
+		},
+		{
+			name:         "duplicated key in a nested object",
+			serverJSON:   "{\n  \"packages\": [\n    {\"identifier\": \"a\",\n     \"identifier\": \"b\"}\n  ]\n}",
+			wantPaths:    []string{"/packages/0/identifier"},
+			wantMessages: []string{"at offsets 23 and 47"},
+		},
+		{
+			name:       "key repeated three times",
+			serverJSON: `{"title": "a", "title": "b", "title": "c"}`,
+			wantPaths:  []string{"/title", "/title"},
+		},
+		{name: "same key in different objects", serverJSON: `{"repository": {"url": "x"}, "remotes": [{"url": "y"}]}`},
+		{name: "invalid JSON", serverJSON: `{"name": "a", "name"`, wantPaths: []string{"/name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validators.ValidateDuplicateKeys([]byte(tt.serverJSON))
+			assert.True(t, result.Valid, "duplicate keys are warnings")
+			paths := []string{}
+			for i, issue := range result.Issues {
+				paths = append(paths, issue.Path)
+				assert.Equal(t, "duplicate-json-key", issue.Reference)
+				assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+				if i < len(tt.wantMessages) {
+					assert.Contains(t, issue.Message, tt.wantMessages[i])
+				}
+			}
+			if tt.wantPaths == nil {
+				tt.wantPaths = []string{}
+			}
+			assert.Equal(t, tt.wantPaths, paths)
+		})
+	}
+}
+
+func TestLintServerJSON(t *testing.T) {
+	complete := `{
+		"name": "com.example/server",
+		"description": "Weather forecasts from OpenWeatherMap",
+		"version": "1.0.0",
+		"license": "MIT",
+		"repository": {"url": "https://github.com/example/server", "source": "github"},
+		"remotes": [{"type": "streamable-http", "url": "https://example.com/mcp"}]
+	}`
+	tests := []struct {
+		name           string
+		serverJSON     string
+		wantScore      int
+		wantReferences []string
+	}{
+		{name: "all best practices followed", serverJSON: complete, wantScore: 100},
+		{
+			name:           "only free of placeholders",
+			serverJSON:     `{"name": "com.example/server", "description": "A server", "version": "1.0.0"}`,
+			wantScore:      20,
+			wantReferences: []string{"listing-description-short", "listing-repository-missing", "listing-license-missing", "listing-install-method-missing"},
+		},
+		{
+			name:           "missing license and repository",
+			serverJSON:     `{"description": "Weather forecasts from OpenWeatherMap", "packages": [{"registryType": "npm", "identifier": "@example/server"}]}`,
+			wantScore:      60,
+			wantReferences: []string{"listing-repository-missing", "listing-license-missing"},
+		},
+		{
+			name: "placeholders count once",
+			serverJSON: `{
+				"name": "io.github.<your-username>/server",
+				"description": "An MCP server that provides [describe what your server does]",
+				"license": "MIT",
+				"repository": {"url": "https://github.com/example/server"},
+				"packages": [{"registryType": "npm", "identifier": "@your-org/your-package"}]
+			}`,
+			wantScore:      80,
+			wantReferences: []string{"listing-placeholder", "listing-placeholder", "listing-placeholder"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validators.LintServerJSON([]byte(tt.serverJSON))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantScore, result.Score)
+			references := []string{}
+			for _, issue := range result.Issues {
+				references = append(references, issue.Reference)
+				assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+			}
+			assert.ElementsMatch(t, tt.wantReferences, references)
+		})
+	}
+
+	t.Run("placeholder paths", func(t *testing.T) {
+		result, err := validators.LintServerJSON([]byte(`{"description": "TODO", "packages": [{"identifier": "@your-org/server"}]}`))
+		require.NoError(t, err)
+		var paths []string
+		for _, issue := range result.Issues {
+			if issue.Reference == "listing-placeholder" {
+				paths = append(paths, issue.Path)
+			}
+		}
+		assert.Equal(t, []string{"/description", "/packages/0/identifier"}, paths)
+	})
+
+	t.Run("not JSON", func(t *testing.T) {
+		_, err := validators.LintServerJSON([]byte(`{"name":`))
+		require.Error(t, err)
+	})
+}
+
+func TestValidatePackageVersions(t *testing.T) {
+	npm := func(version string) model.Package {
+		return model.Package{RegistryType: model.RegistryTypeNPM, Identifier: "@owner/server", Version: version}
+	}
+	oci := model.Package{RegistryType: model.RegistryTypeOCI, Identifier: "ghcr.io/owner/server:1.0.0"}
+
+	tests := []struct {
+		name          string
+		version       string
+		packages      []model.Package
+		expectedPaths []string
+	}{
+		{name: "matching versions", version: "1.2.0", packages: []model.Package{npm("1.2.0"), npm("1.2.0")}},
+		{name: "v prefix", version: "v1.2.0", packages: []model.Package{npm("1.2.0")}},
+		{name: "package without a version", version: "1.2.0", packages: []model.Package{oci}},
+		{name: "no server version", packages: []model.Package{npm("1.1.0")}},
+		{name: "mismatching version", version: "1.2.0", packages: []model.Package{npm("1.1.0")}, expectedPaths: []string{"/packages/0/version"}},
+		{name: "one of several mismatching", version: "1.2.0", packages: []model.Package{oci, npm("1.2.0"), npm("1.2.0-rc.1")}, expectedPaths: []string{"/packages/2/version"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validators.ValidatePackageVersions(&apiv0.ServerJSON{Version: tt.version, Packages: tt.packages})
+			assert.True(t, result.Valid, "package version mismatches are warnings")
+
+			var paths []string
+			for _, issue := range result.Issues {
+				assert.Equal(t, "package-version-mismatch", issue.Reference)
+				assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+				paths = append(paths, issue.Path)
+			}
+			assert.Equal(t, tt.expectedPaths, paths)
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		result := validators.ValidatePackageVersions(&apiv0.ServerJSON{Version: "1.2.0", Packages: []model.Package{npm("1.1.0")}})
+		require.Len(t, result.Issues, 1)
+		assert.Equal(t, "package @owner/server has version 1.1.0, but the server version is 1.2.0", result.Issues[0].Message)
+	})
+
+	t.Run("not part of ValidateServerJSON", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Schema:      model.CurrentSchemaURL,
+			Name:        "io.github.owner/server",
+			Description: "A test server",
+			Version:     "1.2.0",
+			Packages:    []model.Package{npm("1.1.0")},
+		}
+		for _, issue := range validators.ValidateServerJSON(&serverJSON, validators.ValidationSemanticOnly).Issues {
+			assert.NotEqual(t, "package-version-mismatch", issue.Reference)
+		}
+	})
+}
+
+func TestValidateReservedNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		reserved bool
+	}{
+		{"io.modelcontextprotocol/filesystem", true},
+		{"com.example/test-server", true},
+		{"com.example.api/test-server", true},
+		{"COM.Example/test-server", true},
+		{"io.github.octocat/test-server", false},
+		{"com.examples/test-server", false},
+		{"io.modelcontextprotocol", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{Name: tt.name}
+			result := validators.ValidateReservedNamespace(&serverJSON, validators.DefaultReservedNamespaces)
+
+			if !tt.reserved {
+				assert.True(t, result.Valid)
+				assert.Empty(t, result.Issues)
+				return
+			}
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "namespace-reserved", result.Issues[0].Reference)
+			assert.Equal(t, "/name", result.Issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+			assert.Contains(t, result.Issues[0].Message, validators.ErrReservedNamespace.Error())
+		})
+	}
+
+	t.Run("configured list", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{Name: "com.example/test-server"}
+		assert.True(t, validators.ValidateReservedNamespace(&serverJSON, nil).Valid)
+		assert.False(t, validators.ValidateReservedNamespace(&serverJSON, []string{"com.example"}).Valid)
+		assert.True(t, validators.ValidateReservedNamespace(&serverJSON, []string{"io.modelcontextprotocol"}).Valid)
+	})
+}
+
 // Helper function to create a valid server with a specific argument for testing
 func TestValidate_TransportValidation(t *testing.T) {
 	tests := []struct {
@@ -2307,3 +2825,723 @@ func TestValidateTitle(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestValidatePrereleaseVersion(t *testing.T) {
+	tests := []struct {
+		version    string
+		prerelease bool
+	}{
+		{"1.0.0", false},
+		{"v1.0.0", false},
+		{"1.0.0+build.5", false},
+		{"2025.10.1", false},
+		{"snapshot", false},
+		{"1.0.0-rc.1", true},
+		{"1.0.0-beta", true},
+		{"v2.1.0-alpha.3+build.7", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{Version: tt.version}
+			result := validators.ValidatePrereleaseVersion(&serverJSON)
+
+			if !tt.prerelease {
+				assert.True(t, result.Valid)
+				assert.Empty(t, result.Issues)
+				return
+			}
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "version-prerelease-forbidden", result.Issues[0].Reference)
+			assert.Equal(t, "/version", result.Issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+			assert.Contains(t, result.Issues[0].Message, validators.ErrPrereleaseVersion.Error())
+		})
+	}
+}
+
+func TestValidateVersionNotDowngrade(t *testing.T) {
+	tests := []struct {
+		version   string
+		latest    string
+		downgrade bool
+	}{
+		{"2.0.0", "1.0.0", false},
+		{"1.0.0", "1.0.0", false},
+		{"v1.2.0", "1.1.9", false},
+		{"1.0.0", "2.0.0", true},
+		{"1.9.9", "v2.0.0", true},
+		{"2.0.0-rc.1", "2.0.0", true},
+		{"2.0.0", "2.0.0-rc.1", false},
+		{"snapshot", "2.0.0", false},
+		{"1.0.0", "2024-nightly", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+" after "+tt.latest, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{Version: tt.version}
+			result := validators.ValidateVersionNotDowngrade(&serverJSON, tt.latest)
+
+			if !tt.downgrade {
+				assert.True(t, result.Valid)
+				assert.Empty(t, result.Issues)
+				return
+			}
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "version-downgrade", result.Issues[0].Reference)
+			assert.Equal(t, "/version", result.Issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+			assert.Contains(t, result.Issues[0].Message, validators.ErrVersionDowngrade.Error())
+		})
+	}
+}
+
+// stubResolver resolves host names from a fixed table; other hosts fail to resolve
+type stubResolver map[string][]string
+
+func (r stubResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	ipAddrs := make([]net.IPAddr, 0, len(addrs))
+	for _, addr := range addrs {
+		ipAddrs = append(ipAddrs, net.IPAddr{IP: net.ParseIP(addr)})
+	}
+	return ipAddrs, nil
+}
+
+func TestValidateRemoteURLsPublic(t *testing.T) {
+	resolver := stubResolver{
+		"mcp.example.com":      {"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"},
+		"internal.example.com": {"93.184.216.34", "10.1.2.3"},
+		"intranet.example.com": {"192.168.1.20"},
+		"metadata.example.com": {"169.254.169.254"},
+		"v6.example.com":       {"fd00::1"},
+	}
+
+	tests := []struct {
+		url             string
+		expectedMessage string
+	}{
+		{url: "https://mcp.example.com/mcp"},
+		{url: "https://93.184.216.34/mcp"},
+		{url: "https://unresolvable.example.com/mcp"},
+		{url: "https://{tenant}.example.com/mcp"},
+		{url: "https://localhost:8080/mcp", expectedMessage: "points at localhost"},
+		{url: "https://api.localhost/mcp", expectedMessage: "points at localhost"},
+		{url: "https://127.0.0.1/mcp", expectedMessage: "points at the loopback address 127.0.0.1"},
+		{url: "https://[::1]/mcp", expectedMessage: "points at the loopback address ::1"},
+		{url: "https://10.0.0.5/mcp", expectedMessage: "points at the private address 10.0.0.5"},
+		{url: "https://172.16.4.1/mcp", expectedMessage: "points at the private address 172.16.4.1"},
+		{url: "https://192.168.0.10/mcp", expectedMessage: "points at the private address 192.168.0.10"},
+		{url: "https://0.0.0.0/mcp", expectedMessage: "points at the unspecified address 0.0.0.0"},
+		{url: "https://internal.example.com/mcp", expectedMessage: "resolves to the private address 10.1.2.3"},
+		{url: "https://intranet.example.com/mcp", expectedMessage: "resolves to the private address 192.168.1.20"},
+		{url: "https://metadata.example.com/mcp", expectedMessage: "resolves to the link-local address 169.254.169.254"},
+		{url: "https://v6.example.com/mcp", expectedMessage: "resolves to the private address fd00::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{Remotes: []model.Transport{
+				{Type: model.TransportTypeStreamableHTTP, URL: "https://mcp.example.com/sse"},
+				{Type: model.TransportTypeStreamableHTTP, URL: tt.url},
+			}}
+			result := validators.ValidateRemoteURLsPublic(context.Background(), &serverJSON, resolver)
+
+			if tt.expectedMessage == "" {
+				assert.True(t, result.Valid)
+				assert.Empty(t, result.Issues)
+				return
+			}
+			assert.False(t, result.Valid)
+			require.Len(t, result.Issues, 1)
+			assert.Equal(t, "remote-url-private", result.Issues[0].Reference)
+			assert.Equal(t, "/remotes/1/url", result.Issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, result.Issues[0].Severity)
+			assert.Contains(t, result.Issues[0].Message, tt.expectedMessage)
+		})
+	}
+}
+
+// deadlineResolver records whether each lookup has a deadline and fails it
+type deadlineResolver struct {
+	deadlines []bool
+}
+
+func (r *deadlineResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	_, ok := ctx.Deadline()
+	r.deadlines = append(r.deadlines, ok)
+	return nil, &net.DNSError{Err: "i/o timeout", Name: host, IsTimeout: true}
+}
+
+func TestValidateRemoteURLsPublic_LookupTimeout(t *testing.T) {
+	resolver := &deadlineResolver{}
+	serverJSON := apiv0.ServerJSON{Remotes: []model.Transport{
+		{Type: model.TransportTypeStreamableHTTP, URL: "https://a.example.com/mcp"},
+		{Type: model.TransportTypeStreamableHTTP, URL: "https://b.example.com/mcp"},
+	}}
+
+	result := validators.ValidateRemoteURLsPublic(context.Background(), &serverJSON, resolver)
+	assert.True(t, result.Valid, "hosts that time out are treated as unresolvable")
+	assert.Equal(t, []bool{true, true}, resolver.deadlines, "every lookup should have a deadline")
+}
+
+func TestValidate_InsecureURLScheme(t *testing.T) {
+	tests := []struct {
+		name         string
+		serverJSON   apiv0.ServerJSON
+		expectedPath string
+	}{
+		{
+			name: "https repository",
+			serverJSON: apiv0.ServerJSON{
+				Repository: &model.Repository{URL: "https://github.com/owner/repo", Source: "github"},
+			},
+		},
+		{
+			name: "http repository",
+			serverJSON: apiv0.ServerJSON{
+				Repository: &model.Repository{URL: "http://github.com/owner/repo", Source: "github"},
+			},
+			expectedPath: "/repository/url",
+		},
+		{
+			name: "http package registry",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{{
+					RegistryType:    model.RegistryTypeNPM,
+					RegistryBaseURL: "http://registry.npmjs.org",
+					Identifier:      "@owner/server",
+					Version:         "1.0.0",
+					Transport:       model.Transport{Type: model.TransportTypeStdio},
+				}},
+			},
+			expectedPath: "/packages/0/registryBaseUrl",
+		},
+		{
+			name: "http package registry on localhost",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{{
+					RegistryType:    model.RegistryTypeNPM,
+					RegistryBaseURL: "http://localhost:4873",
+					Identifier:      "@owner/server",
+					Version:         "1.0.0",
+					Transport:       model.Transport{Type: model.TransportTypeStdio},
+				}},
+			},
+		},
+		{
+			name: "http package registry on 127.0.0.1",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{{
+					RegistryType:    model.RegistryTypeNPM,
+					RegistryBaseURL: "http://127.0.0.1:4873",
+					Identifier:      "@owner/server",
+					Version:         "1.0.0",
+					Transport:       model.Transport{Type: model.TransportTypeStdio},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.serverJSON.Schema = model.CurrentSchemaURL
+			tt.serverJSON.Name = "io.github.owner/server"
+			tt.serverJSON.Description = "A test server"
+			tt.serverJSON.Version = "1.0.0"
+
+			result := validators.ValidateServerJSON(&tt.serverJSON, validators.ValidationSemanticOnly)
+
+			var insecure []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "url-insecure-scheme" {
+					insecure = append(insecure, issue)
+				}
+			}
+			if tt.expectedPath == "" {
+				assert.Empty(t, insecure)
+				return
+			}
+			require.Len(t, insecure, 1)
+			assert.Equal(t, tt.expectedPath, insecure[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, insecure[0].Severity)
+		})
+	}
+}
+
+func TestValidate_TransportDuplicatesAndConflicts(t *testing.T) {
+	npmPackage := func(transport model.Transport) model.Package {
+		return model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "@owner/server",
+			Version:      "1.0.0",
+			Transport:    transport,
+		}
+	}
+	stdio := model.Transport{Type: model.TransportTypeStdio}
+	localHTTP := model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "http://localhost:8080/mcp"}
+
+	tests := []struct {
+		name           string
+		serverJSON     apiv0.ServerJSON
+		expectedIssues map[string]string // path -> reference
+	}{
+		{
+			name: "clean multi-transport declaration",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{npmPackage(stdio), npmPackage(localHTTP)},
+				Remotes: []model.Transport{
+					{Type: model.TransportTypeStreamableHTTP, URL: "https://mcp.example.com/mcp"},
+					{Type: model.TransportTypeSSE, URL: "https://mcp.example.com/sse"},
+					{Type: model.TransportTypeStreamableHTTP, URL: "https://eu.mcp.example.com/mcp"},
+				},
+			},
+			expectedIssues: map[string]string{},
+		},
+		{
+			name: "duplicate package transport",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{npmPackage(stdio), npmPackage(localHTTP), npmPackage(stdio)},
+			},
+			expectedIssues: map[string]string{"/packages/2/transport/type": "transport-duplicate"},
+		},
+		{
+			name: "duplicate remote",
+			serverJSON: apiv0.ServerJSON{
+				Remotes: []model.Transport{
+					{Type: model.TransportTypeSSE, URL: "https://mcp.example.com/sse"},
+					{Type: model.TransportTypeSSE, URL: "https://mcp.example.com/sse"},
+				},
+			},
+			expectedIssues: map[string]string{"/remotes/1": "transport-duplicate"},
+		},
+		{
+			name: "stdio transport with http options",
+			serverJSON: apiv0.ServerJSON{
+				Packages: []model.Package{npmPackage(model.Transport{
+					Type:      model.TransportTypeStdio,
+					Headers:   []model.KeyValueInput{{Name: "Authorization"}},
+					Variables: map[string]model.Input{"region": {}},
+				})},
+			},
+			expectedIssues: map[string]string{
+				"/packages/0/transport/headers":   "transport-options-conflict",
+				"/packages/0/transport/variables": "transport-options-conflict",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.serverJSON.Schema = model.CurrentSchemaURL
+			tt.serverJSON.Name = "io.github.owner/server"
+			tt.serverJSON.Description = "A test server"
+			tt.serverJSON.Version = "1.0.0"
+
+			result := validators.ValidateServerJSON(&tt.serverJSON, validators.ValidationSemanticOnly)
+
+			issues := map[string]string{}
+			for _, issue := range result.Issues {
+				if issue.Reference == "transport-duplicate" || issue.Reference == "transport-options-conflict" {
+					issues[issue.Path] = issue.Reference
+				}
+				if issue.Reference == "transport-options-conflict" {
+					// Leftover options are ignored by clients, so they do not make server.json invalid
+					assert.Equal(t, validators.ValidationIssueSeverityWarning, issue.Severity)
+				}
+			}
+			assert.Equal(t, tt.expectedIssues, issues)
+		})
+	}
+}
+
+func TestValidate_TransportURLSchemeMismatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		transport   model.Transport
+		remote      bool
+		expectIssue bool
+	}{
+		{name: "streamable-http package over http", transport: model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "http://localhost:8080/mcp"}},
+		{name: "streamable-http package over https", transport: model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "https://localhost:8443/mcp"}},
+		{name: "streamable-http package over ws", transport: model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "ws://localhost:8080/mcp"}, expectIssue: true},
+		{name: "sse package over http", transport: model.Transport{Type: model.TransportTypeSSE, URL: "http://localhost:8080/sse"}},
+		{name: "sse package over tcp", transport: model.Transport{Type: model.TransportTypeSSE, URL: "tcp://localhost:8080"}, expectIssue: true},
+		{name: "sse package with templated host", transport: model.Transport{Type: model.TransportTypeSSE, URL: "http://{host}/sse", Variables: map[string]model.Input{"host": {}}}},
+		{name: "stdio package", transport: model.Transport{Type: model.TransportTypeStdio}},
+		{name: "streamable-http remote over https", transport: model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "https://mcp.example.com/mcp"}, remote: true},
+		{name: "streamable-http remote over wss", transport: model.Transport{Type: model.TransportTypeStreamableHTTP, URL: "wss://mcp.example.com/mcp"}, remote: true, expectIssue: true},
+		{name: "sse remote over https", transport: model.Transport{Type: model.TransportTypeSSE, URL: "HTTPS://mcp.example.com/sse"}, remote: true},
+		{name: "sse remote over ftp", transport: model.Transport{Type: model.TransportTypeSSE, URL: "ftp://mcp.example.com/sse"}, remote: true, expectIssue: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "io.github.owner/server",
+				Description: "A test server",
+				Version:     "1.0.0",
+			}
+			path := "/packages/0/transport/url"
+			if tt.remote {
+				serverJSON.Remotes = []model.Transport{tt.transport}
+				path = "/remotes/0/url"
+			} else {
+				serverJSON.Packages = []model.Package{{
+					RegistryType: model.RegistryTypeNPM,
+					Identifier:   "@owner/server",
+					Version:      "1.0.0",
+					Transport:    tt.transport,
+				}}
+			}
+
+			result := validators.ValidateServerJSON(&serverJSON, validators.ValidationSemanticOnly)
+
+			var found *validators.ValidationIssue
+			for i, issue := range result.Issues {
+				if issue.Reference == "transport-url-scheme-mismatch" {
+					found = &result.Issues[i]
+				}
+			}
+			if !tt.expectIssue {
+				assert.Nil(t, found)
+				return
+			}
+			require.NotNil(t, found)
+			assert.Equal(t, path, found.Path)
+			assert.Equal(t, validators.ValidationIssueSeverityError, found.Severity)
+			assert.Contains(t, found.Message, tt.transport.URL)
+			assert.False(t, result.Valid)
+		})
+	}
+}
+
+func TestValidate_DescriptionEqualsName(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		expectIssue bool
+	}{
+		{name: "distinct description", description: "Current weather and forecasts for any city"},
+		{name: "description mentioning the name", description: "Weather server for acme"},
+		{name: "identical to the name", description: "com.acme/weather-server", expectIssue: true},
+		{name: "different case", description: "COM.ACME/Weather-Server", expectIssue: true},
+		{name: "name part only", description: "weather-server", expectIssue: true},
+		{name: "name part with punctuation", description: "Weather Server.", expectIssue: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "com.acme/weather-server",
+				Description: tt.description,
+				Version:     "1.0.0",
+				Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+			}
+
+			result := validators.ValidateServerJSON(&serverJSON, validators.ValidationSemanticOnly)
+
+			var issues []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "description-equals-name" {
+					issues = append(issues, issue)
+				}
+			}
+			if !tt.expectIssue {
+				assert.Empty(t, issues)
+				return
+			}
+			require.Len(t, issues, 1)
+			assert.Equal(t, "/description", issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, issues[0].Severity)
+			assert.Contains(t, issues[0].Message, "only repeats the server name")
+		})
+	}
+}
+
+// issueReferences returns the references of the issues in result, in order
+func issueReferences(result *validators.ValidationResult) []string {
+	references := make([]string, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		references = append(references, issue.Reference)
+	}
+	return references
+}
+
+func TestValidate_EnvNames(t *testing.T) {
+	tests := []struct {
+		name            string
+		envName         string
+		pattern         *regexp.Regexp
+		expectedMessage string
+	}{
+		{name: "upper snake case", envName: "API_KEY"},
+		{name: "with digits", envName: "OAUTH2_CLIENT_ID"},
+		{name: "single word", envName: "DEBUG"},
+		{name: "lowercase", envName: "api_key", expectedMessage: `did you mean "API_KEY"?`},
+		{name: "hyphenated", envName: "API-KEY", expectedMessage: `did you mean "API_KEY"?`},
+		{name: "camel case", envName: "apiKey", expectedMessage: `did you mean "API_KEY"?`},
+		{name: "leading digit", envName: "2FA_SECRET", expectedMessage: `"2FA_SECRET" does not match the pattern ^[A-Z][A-Z0-9_]*$`},
+		{name: "configured pattern", envName: "api_key", pattern: regexp.MustCompile(`^[a-z_]+$`)},
+		{name: "not matching a configured pattern", envName: "API_KEY", pattern: regexp.MustCompile(`^MYAPP_[A-Z_]+$`), expectedMessage: "does not match the pattern ^MYAPP_[A-Z_]+$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "io.github.owner/server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{{
+					RegistryType:         model.RegistryTypeNPM,
+					Identifier:           "@owner/server",
+					Version:              "1.0.0",
+					Transport:            model.Transport{Type: model.TransportTypeStdio},
+					EnvironmentVariables: []model.KeyValueInput{{Name: tt.envName}},
+				}},
+			}
+			opts := validators.ValidationSemanticOnly
+			opts.EnvNamePattern = tt.pattern
+
+			result := validators.ValidateServerJSON(&serverJSON, opts)
+
+			var issues []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "env-name-nonstandard" {
+					issues = append(issues, issue)
+				}
+			}
+			if tt.expectedMessage == "" {
+				assert.Empty(t, issues)
+				return
+			}
+			require.Len(t, issues, 1)
+			assert.Equal(t, "/packages/0/environmentVariables/0/name", issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, issues[0].Severity)
+			assert.Contains(t, issues[0].Message, tt.expectedMessage)
+			assert.True(t, result.Valid, "nonstandard names are warnings")
+		})
+	}
+}
+
+func TestValidate_ArgumentCount(t *testing.T) {
+	arguments := func(n int) []model.Argument {
+		args := make([]model.Argument, n)
+		for i := range args {
+			args[i] = model.Argument{Type: model.ArgumentTypePositional, ValueHint: fmt.Sprintf("arg_%d", i)}
+			args[i].Value = fmt.Sprintf("value-%d", i)
+		}
+		return args
+	}
+
+	tests := []struct {
+		name             string
+		runtimeArguments []model.Argument
+		packageArguments []model.Argument
+		maxArguments     int
+		expectIssue      bool
+	}{
+		{name: "no arguments"},
+		{name: "at the default maximum", packageArguments: arguments(validators.DefaultMaxArguments)},
+		{name: "over the default maximum", packageArguments: arguments(validators.DefaultMaxArguments + 1), expectIssue: true},
+		{name: "runtime and package arguments count together", runtimeArguments: arguments(2), packageArguments: arguments(2), maxArguments: 3, expectIssue: true},
+		{name: "at a configured maximum", runtimeArguments: arguments(1), packageArguments: arguments(2), maxArguments: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "io.github.owner/server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{{
+					RegistryType:     model.RegistryTypeNPM,
+					Identifier:       "@owner/server",
+					Version:          "1.0.0",
+					RunTimeHint:      "npx",
+					Transport:        model.Transport{Type: model.TransportTypeStdio},
+					RuntimeArguments: tt.runtimeArguments,
+					PackageArguments: tt.packageArguments,
+				}},
+			}
+			opts := validators.ValidationSemanticOnly
+			opts.MaxArguments = tt.maxArguments
+
+			result := validators.ValidateServerJSON(&serverJSON, opts)
+
+			references := issueReferences(result)
+			if !tt.expectIssue {
+				assert.NotContains(t, references, "arguments-too-many")
+				return
+			}
+			require.Contains(t, references, "arguments-too-many")
+			assert.False(t, result.Valid)
+			for _, issue := range result.Issues {
+				if issue.Reference == "arguments-too-many" {
+					assert.Equal(t, "/packages/0", issue.Path)
+					assert.Equal(t, validators.ValidationIssueSeverityError, issue.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_NameLength(t *testing.T) {
+	// name returns a valid server name of exactly length characters
+	name := func(length int) string {
+		const namespace = "io.github.owner/"
+		return namespace + strings.Repeat("a", length-len(namespace))
+	}
+
+	tests := []struct {
+		name          string
+		serverName    string
+		maxNameLength int
+		expectError   bool
+		expectWarning bool
+	}{
+		{name: "short name", serverName: "io.github.owner/server"},
+		{name: "at 90% of the default maximum", serverName: name(validators.DefaultMaxNameLength * 9 / 10)},
+		{name: "just over 90% of the default maximum", serverName: name(validators.DefaultMaxNameLength*9/10 + 1), expectWarning: true},
+		{name: "at the default maximum", serverName: name(validators.DefaultMaxNameLength), expectWarning: true},
+		{name: "one over the default maximum", serverName: name(validators.DefaultMaxNameLength + 1), expectError: true},
+		{name: "far over the default maximum", serverName: name(1000), expectError: true},
+		{name: "at a configured maximum", serverName: name(50), maxNameLength: 50, expectWarning: true},
+		{name: "over a configured maximum", serverName: name(51), maxNameLength: 50, expectError: true},
+		{name: "under a configured maximum above the default", serverName: name(250), maxNameLength: 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        tt.serverName,
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+			}
+			opts := validators.ValidationSemanticOnly
+			opts.MaxNameLength = tt.maxNameLength
+
+			result := validators.ValidateServerJSON(&serverJSON, opts)
+
+			references := issueReferences(result)
+			assert.Equal(t, tt.expectError, slices.Contains(references, "name-too-long"), "name-too-long in %v", references)
+			assert.Equal(t, tt.expectWarning, slices.Contains(references, "name-length-near-limit"), "name-length-near-limit in %v", references)
+			assert.Equal(t, !tt.expectError, result.Valid)
+			for _, issue := range result.Issues {
+				assert.Equal(t, "/name", issue.Path)
+			}
+		})
+	}
+}
+
+func TestValidate_ServerJSONSize(t *testing.T) {
+	serverJSON := apiv0.ServerJSON{
+		Schema:      model.CurrentSchemaURL,
+		Name:        "io.github.owner/server",
+		Description: "A test server",
+		Version:     "1.0.0",
+		Remotes:     []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}},
+	}
+	data, err := json.Marshal(serverJSON)
+	require.NoError(t, err)
+	size := len(data)
+
+	validate := func(maxSize int) *validators.ValidationResult {
+		opts := validators.ValidationSemanticOnly
+		opts.MaxServerJSONSize = maxSize
+		return validators.ValidateServerJSON(&serverJSON, opts)
+	}
+
+	result := validate(size)
+	assert.True(t, result.Valid, "a document at the configured maximum should be accepted: %v", result.Issues)
+
+	result = validate(size - 1)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "server-json-too-large", result.Issues[0].Reference)
+	assert.Empty(t, result.Issues[0].Path)
+	assert.Contains(t, result.Issues[0].Message, fmt.Sprintf("%d bytes", size))
+
+	// The default applies without a configured maximum
+	serverJSON.Description = strings.Repeat("x", validators.DefaultMaxServerJSONSize)
+	assert.Contains(t, issueReferences(validate(0)), "server-json-too-large")
+	serverJSON.Description = strings.Repeat("x", validators.DefaultMaxServerJSONSize-size)
+	assert.NotContains(t, issueReferences(validate(0)), "server-json-too-large")
+}
+
+func TestValidate_PackageCount(t *testing.T) {
+	packages := func(n int) []model.Package {
+		pkgs := make([]model.Package, n)
+		for i := range pkgs {
+			pkgs[i] = model.Package{
+				RegistryType: model.RegistryTypeNPM,
+				Identifier:   fmt.Sprintf("@owner/server-%d", i),
+				Version:      "1.0.0",
+				Transport:    model.Transport{Type: model.TransportTypeStdio},
+			}
+		}
+		return pkgs
+	}
+	remote := []model.Transport{{Type: model.TransportTypeStreamableHTTP, URL: "https://example.com/mcp"}}
+
+	tests := []struct {
+		name          string
+		packages      []model.Package
+		remotes       []model.Transport
+		maxPackages   int
+		expectedPath  string
+		expectedIssue string
+	}{
+		{name: "no packages or remotes", expectedPath: "", expectedIssue: "no-installation-method"},
+		{name: "remotes only", remotes: remote},
+		{name: "one package", packages: packages(1)},
+		{name: "at the default maximum", packages: packages(validators.DefaultMaxPackages)},
+		{name: "over the default maximum", packages: packages(validators.DefaultMaxPackages + 1), expectedPath: "/packages", expectedIssue: "too-many-packages"},
+		{name: "at a configured maximum", packages: packages(3), maxPackages: 3},
+		{name: "over a configured maximum", packages: packages(4), remotes: remote, maxPackages: 3, expectedPath: "/packages", expectedIssue: "too-many-packages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Schema:      model.CurrentSchemaURL,
+				Name:        "io.github.owner/server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages:    tt.packages,
+				Remotes:     tt.remotes,
+			}
+			opts := validators.ValidationSemanticOnly
+			opts.MaxPackages = tt.maxPackages
+
+			result := validators.ValidateServerJSON(&serverJSON, opts)
+
+			var issues []validators.ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Reference == "no-installation-method" || issue.Reference == "too-many-packages" {
+					issues = append(issues, issue)
+				}
+			}
+			if tt.expectedIssue == "" {
+				assert.Empty(t, issues)
+				return
+			}
+			require.Len(t, issues, 1)
+			assert.Equal(t, tt.expectedIssue, issues[0].Reference)
+			assert.Equal(t, tt.expectedPath, issues[0].Path)
+			assert.Equal(t, validators.ValidationIssueSeverityWarning, issues[0].Severity)
+			assert.True(t, result.Valid, "package count issues are warnings")
+		})
+	}
+}
@@ -10,6 +10,7 @@ type RegistryExtensions struct {
 	Status          model.Status `json:"status" enum:"active,deprecated,deleted" doc:"Server lifecycle status"`
 	StatusChangedAt time.Time    `json:"statusChangedAt" format:"date-time" doc:"Timestamp when the server status was last changed"`
 	StatusMessage   *string      `json:"statusMessage,omitempty" doc:"Optional message explaining status change (e.g., deprecation reason, migration guidance)"`
+	ReleaseNotes    *string      `json:"releaseNotes,omitempty" doc:"Optional release notes the publisher attached to this version when publishing it"`
 	PublishedAt     time.Time    `json:"publishedAt" format:"date-time" doc:"Timestamp when the server was first published to the registry"`
 	UpdatedAt       time.Time    `json:"updatedAt,omitempty" format:"date-time" doc:"Timestamp when the server entry was last updated"`
 	IsLatest        bool         `json:"isLatest" doc:"Whether this is the latest version of the server"`
@@ -47,6 +48,17 @@ type ServerJSON struct {
 	Meta        *ServerMeta       `json:"_meta,omitempty" doc:"Extension metadata using reverse DNS namespacing for vendor-specific data"`
 }
 
+// MaxReleaseNotesLength is the maximum length, in characters, of the release notes
+// attached to a publish request
+const MaxReleaseNotesLength = 5000
+
+// PublishRequest is the body of the publish endpoint: the server.json fields, with
+// publish-time fields alongside them that are not part of server.json
+type PublishRequest struct {
+	ServerJSON
+	ReleaseNotes string `json:"releaseNotes,omitempty" maxLength:"5000" doc:"Optional release notes describing the changes in this version" example:"Adds the forecast tool"`
+}
+
 type Metadata struct {
 	NextCursor string `json:"nextCursor,omitempty" doc:"Pagination cursor for retrieving the next page of results. Use this exact value in the cursor query parameter of your next request."`
 	Count      int    `json:"count" doc:"Number of items in current page"`